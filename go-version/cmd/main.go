@@ -2,43 +2,80 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"text/tabwriter"
 
 	"go-version/internal/api"
+	"go-version/internal/config"
 	"go-version/internal/dal"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/joho/godotenv"
 )
 
 func main() {
-	ctx := context.Background()
+	configFile := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML or TOML configuration file, overridden by .env and the environment")
+	skipMigrations := flag.Bool("skip-migrations", false, "do not apply pending migrations on startup")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage: api [-config file] [-skip-migrations] [migrate up|down|status]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	err := godotenv.Load("./.env")
+	cfg, err := config.Load(*configFile)
 	if err != nil {
-		fmt.Println("Error loading .env file. Continuing with system environment variables.")
+		fmt.Println("Error loading configuration:", err)
+		os.Exit(1)
 	}
 
 	done := make(chan bool, 1)
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
 
-	db, err := dal.NewDatabaseConn(ctx, "./database.sqlite")
+	db, err := dal.NewDatabaseConn(ctx, cfg.Database.Driver, cfg.Database.Location)
 	if err != nil {
 		panic(err)
 	}
 	defer db.Close()
 
+	migrator, err := dal.NewMigrator(db, cfg.Database.Driver)
+	if err != nil {
+		panic(err)
+	}
+
+	if flag.Arg(0) == "migrate" {
+		if err := runMigrate(ctx, migrator, flag.Args()[1:]); err != nil {
+			fmt.Println("Error migrating database:", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	if !*skipMigrations {
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("Applied migration %d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	router := chi.NewRouter()
-	apiService := api.NewService(db)
+	apiService := api.NewService(db, cfg)
 	apiService.RegisterRoutes(router)
+	apiService.StartWorkers(ctx)
 
 	server := &http.Server{
-		Addr:    fmt.Sprintf("%s:%s", os.Getenv("HOST"), os.Getenv("PORT")),
+		Addr:    cfg.Server.Addr(),
 		Handler: router,
 	}
 
@@ -51,12 +88,60 @@ func main() {
 
 	go func() {
 		sig := <-sigs
-		server.Shutdown(ctx)
+		cancel()
+		server.Shutdown(context.Background())
 		fmt.Printf("Received signal %v, shutting down...\n", sig)
 		done <- true
 	}()
 
-	fmt.Printf("Server is running on %s\n", fmt.Sprintf("%s:%s", os.Getenv("HOST"), os.Getenv("PORT")))
+	fmt.Printf("Server is running on %s\n", cfg.Server.Addr())
 	<-done
 	fmt.Println("Program exited")
 }
+
+// runMigrate runs the migrate subcommand. up applies every pending
+// migration, down rolls back the latest one and status lists them all.
+func runMigrate(ctx context.Context, migrator *dal.Migrator, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: api migrate up|down|status")
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("Applied migration %d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("No pending migrations")
+		}
+		return err
+	case "down":
+		migration, err := migrator.Down(ctx)
+		if err != nil {
+			return err
+		}
+		if migration == nil {
+			fmt.Println("No migrations to roll back")
+			return nil
+		}
+		fmt.Printf("Rolled back migration %d_%s\n", migration.Version, migration.Name)
+		return nil
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, status := range statuses {
+			state := "pending"
+			if status.Applied {
+				state = "applied"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", status.Version, status.Name, state)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down or status", args[0])
+	}
+}
@@ -1,31 +1,33 @@
 package auth
 
 import (
-	"os"
-
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func getJWTKey() []byte {
-	key := os.Getenv("JWT_SUPER_SECRET_SIGNING_KEY")
-	return []byte(key)
+// Tokens issues and verifies the API keys handed out to users.
+type Tokens struct {
+	signingKey []byte
+}
+
+func NewTokens(signingKey string) *Tokens {
+	return &Tokens{signingKey: []byte(signingKey)}
 }
 
-func GenerateToken(userId string) (string, error) {
+func (t *Tokens) GenerateToken(userId string) (string, error) {
 	claims := jwt.MapClaims{
 		"sub": userId,
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
-	return token.SignedString(getJWTKey())
+	return token.SignedString(t.signingKey)
 }
 
-func ParseToken(tokenString string) (*jwt.Token, error) {
+func (t *Tokens) ParseToken(tokenString string) (*jwt.Token, error) {
 	return jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, jwt.ErrSignatureInvalid
 		}
-		return getJWTKey(), nil
+		return t.signingKey, nil
 	})
 }
@@ -0,0 +1,73 @@
+// Package authz decides whether a user may act on data that belongs to an
+// organization or to one of its patients. Repositories ask it before
+// reading another user's data instead of trusting the user ID they were
+// given.
+package authz
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+)
+
+// ErrForbidden is returned when the user is not allowed to do what they
+// asked.
+type ErrForbidden struct {
+	Reason string
+}
+
+func (e *ErrForbidden) Error() string {
+	return "forbidden: " + e.Reason
+}
+
+type Authorizer struct {
+	organizationStore store.OrganizationStoreInterface
+}
+
+func NewAuthorizer(organizationStore store.OrganizationStoreInterface) (*Authorizer, error) {
+	return &Authorizer{organizationStore: organizationStore}, nil
+}
+
+// RequireRole returns the user's membership of the organization if they
+// hold one of the roles, or any role when none are given.
+func (a *Authorizer) RequireRole(ctx context.Context, userID, organizationID string, roles ...string) (*models.OrganizationMember, error) {
+	member, err := a.organizationStore.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		var notFoundErr *store.NoOrganizationMemberFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, &ErrForbidden{Reason: "you are not a member of this organization"}
+		}
+		return nil, err
+	}
+
+	if len(roles) > 0 && !slices.Contains(roles, member.Role) {
+		return nil, &ErrForbidden{Reason: "your role in this organization does not allow this"}
+	}
+	return member, nil
+}
+
+// RequirePatient allows members of the organization to read the data of a
+// patient whose enrollment in it is active, which means the patient has
+// consented.
+func (a *Authorizer) RequirePatient(ctx context.Context, userID, organizationID, patientID string) error {
+	if _, err := a.RequireRole(ctx, userID, organizationID); err != nil {
+		return err
+	}
+
+	enrollment, err := a.organizationStore.GetEnrollment(ctx, organizationID, patientID)
+	if err != nil {
+		var notEnrolledErr *store.PatientNotEnrolledError
+		if errors.As(err, &notEnrolledErr) {
+			return &ErrForbidden{Reason: "the patient is not enrolled in this organization"}
+		}
+		return err
+	}
+
+	if enrollment.Status != models.EnrollmentActive {
+		return &ErrForbidden{Reason: "the patient has not consented to this organization"}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/api/store/mocks"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestAuthorizer_RequireRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testCases := []struct {
+		name        string
+		member      *models.OrganizationMember
+		memberErr   error
+		roles       []string
+		wantForbids bool
+	}{
+		{
+			name:   "any member when no roles are given",
+			member: &models.OrganizationMember{UserId: "user-1", Role: models.OrganizationRoleClinician},
+		},
+		{
+			name:   "member holding one of the roles",
+			member: &models.OrganizationMember{UserId: "user-1", Role: models.OrganizationRoleClinician},
+			roles:  []string{models.OrganizationRoleAdmin, models.OrganizationRoleClinician},
+		},
+		{
+			name:        "member without the role",
+			member:      &models.OrganizationMember{UserId: "user-1", Role: models.OrganizationRoleClinician},
+			roles:       []string{models.OrganizationRoleAdmin},
+			wantForbids: true,
+		},
+		{
+			name:        "not a member",
+			memberErr:   &store.NoOrganizationMemberFoundError{OrganizationID: "org-1", UserID: "user-1"},
+			wantForbids: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			organizationStore := mocks.NewMockOrganizationStoreInterface(ctrl)
+			organizationStore.EXPECT().GetMember(gomock.Any(), "org-1", "user-1").Return(tc.member, tc.memberErr).Times(1)
+
+			authorizer, _ := NewAuthorizer(organizationStore)
+			member, err := authorizer.RequireRole(context.Background(), "user-1", "org-1", tc.roles...)
+
+			var forbiddenErr *ErrForbidden
+			if tc.wantForbids {
+				if !errors.As(err, &forbiddenErr) {
+					t.Fatalf("Expected ErrForbidden, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if member != tc.member {
+				t.Errorf("Expected the membership to be returned, got %+v", member)
+			}
+		})
+	}
+}
+
+func TestAuthorizer_RequirePatient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	member := &models.OrganizationMember{UserId: "clinician-1", Role: models.OrganizationRoleClinician}
+
+	testCases := []struct {
+		name          string
+		enrollment    *models.PatientEnrollment
+		enrollmentErr error
+		wantForbids   bool
+	}{
+		{
+			name:       "active enrollment",
+			enrollment: &models.PatientEnrollment{Status: models.EnrollmentActive},
+		},
+		{
+			name:        "pending enrollment",
+			enrollment:  &models.PatientEnrollment{Status: models.EnrollmentPending},
+			wantForbids: true,
+		},
+		{
+			name:          "not enrolled",
+			enrollmentErr: &store.PatientNotEnrolledError{OrganizationID: "org-1", PatientID: "patient-1"},
+			wantForbids:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			organizationStore := mocks.NewMockOrganizationStoreInterface(ctrl)
+			organizationStore.EXPECT().GetMember(gomock.Any(), "org-1", "clinician-1").Return(member, nil).Times(1)
+			organizationStore.EXPECT().GetEnrollment(gomock.Any(), "org-1", "patient-1").Return(tc.enrollment, tc.enrollmentErr).Times(1)
+
+			authorizer, _ := NewAuthorizer(organizationStore)
+			err := authorizer.RequirePatient(context.Background(), "clinician-1", "org-1", "patient-1")
+
+			var forbiddenErr *ErrForbidden
+			if tc.wantForbids != errors.As(err, &forbiddenErr) {
+				t.Errorf("Expected forbidden to be %v, got %v", tc.wantForbids, err)
+			}
+			if !tc.wantForbids && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+type CalendarFeedCreateDomain struct {
+	UserID string
+}
+
+type CalendarFeedDeleteDomain struct {
+	UserID string
+}
+
+type CalendarFeedGetDomain struct {
+	FeedToken string
+}
+
+// CalendarEntryDomain is one VEVENT or VTODO of an uploaded calendar.
+type CalendarEntryDomain struct {
+	Component  string
+	UID        string
+	Summary    string
+	Status     string
+	RRule      string
+	StartAt    *time.Time
+	ExDates    []time.Time
+	Overrides  bool
+	ParseError error
+}
+
+// CalendarObjectListDomain selects the calendar objects of a CalDAV
+// report. ReminderIDs, Start and End are optional filters.
+type CalendarObjectListDomain struct {
+	UserID      string
+	ReminderIDs []string
+	Start       *time.Time
+	End         *time.Time
+}
+
+type CalendarObjectGetDomain struct {
+	UserID     string
+	ReminderID string
+}
+
+type CalendarObjectPutDomain struct {
+	UserID      string
+	ReminderID  string
+	Entries     []CalendarEntryDomain
+	IfMatch     string
+	IfNoneMatch string
+}
+
+type CalendarObjectDeleteDomain struct {
+	UserID     string
+	ReminderID string
+	IfMatch    string
+}
@@ -0,0 +1,37 @@
+package domain
+
+// CaregiverInviteDomain shares the user's reminders with the user whose
+// email it is. A nil ReminderIDs shares every reminder.
+type CaregiverInviteDomain struct {
+	UserID      string
+	Email       string
+	Permission  string
+	ReminderIDs []string
+}
+
+type CaregiverListDomain struct {
+	UserID string
+}
+
+type CaregiverRevokeDomain struct {
+	UserID  string
+	GrantID string
+}
+
+type CaregiverActivityListDomain struct {
+	UserID string
+}
+
+type CaregiverInvitationListDomain struct {
+	UserID string
+}
+
+type CaregiverInvitationAcceptDomain struct {
+	UserID  string
+	GrantID string
+}
+
+type CaregiverInvitationDeclineDomain struct {
+	UserID  string
+	GrantID string
+}
@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+type CheckInQuestionDomain struct {
+	Key      string
+	Type     string
+	Prompt   string
+	Required bool
+	Min      *float64
+	Max      *float64
+	Unit     *string
+}
+
+type CheckInQuestionnaireSetDomain struct {
+	UserID     string
+	ReminderID string
+	Questions  []CheckInQuestionDomain
+}
+
+type CheckInQuestionnaireGetDomain struct {
+	UserID     string
+	ReminderID string
+}
+
+type CheckInQuestionnaireDeleteDomain struct {
+	UserID     string
+	ReminderID string
+}
+
+type CheckInResponseSubmitDomain struct {
+	UserID       string
+	ReminderID   string
+	OccurrenceAt time.Time
+	// Answers maps question keys to answers as decoded from JSON.
+	Answers map[string]any
+}
+
+type CheckInResponseListDomain struct {
+	UserID     string
+	ReminderID string
+	StartDate  *time.Time
+	EndDate    *time.Time
+}
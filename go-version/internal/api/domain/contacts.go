@@ -0,0 +1,16 @@
+package domain
+
+type ContactCreateDomain struct {
+	UserID string
+	Name   string
+	Email  string
+}
+
+type ContactListDomain struct {
+	UserID string
+}
+
+type ContactDeleteDomain struct {
+	UserID    string
+	ContactID string
+}
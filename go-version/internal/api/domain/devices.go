@@ -0,0 +1,17 @@
+package domain
+
+type DeviceCreateDomain struct {
+	UserID     string
+	Platform   string
+	Token      string
+	AppVersion *string
+}
+
+type DeviceListDomain struct {
+	UserID string
+}
+
+type DeviceDeleteDomain struct {
+	UserID   string
+	DeviceID string
+}
@@ -0,0 +1,18 @@
+package domain
+
+type DigestSubscriptionGetDomain struct {
+	UserID string
+}
+
+type DigestSubscriptionSetDomain struct {
+	UserID    string
+	Frequency string
+	SendTime  string
+	Weekday   string
+	TimeZone  string
+	Channel   string
+}
+
+type DigestSubscriptionDeleteDomain struct {
+	UserID string
+}
@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+type EscalationStepDomain struct {
+	DelayMinutes int
+	ContactID    string
+	Channel      string
+}
+
+type EscalationPolicySetDomain struct {
+	UserID     string
+	ReminderID string
+	Steps      []EscalationStepDomain
+}
+
+type EscalationPolicyGetDomain struct {
+	UserID     string
+	ReminderID string
+}
+
+type EscalationPolicyDeleteDomain struct {
+	UserID     string
+	ReminderID string
+}
+
+type EscalationEventListDomain struct {
+	UserID     string
+	ReminderID string
+}
+
+type ReminderAcknowledgeDomain struct {
+	UserID       string
+	ReminderID   string
+	OccurrenceAt time.Time
+}
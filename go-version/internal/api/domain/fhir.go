@@ -0,0 +1,22 @@
+package domain
+
+import "go-version/internal/fhir"
+
+type FHIRMedicationListDomain struct {
+	UserID string
+	// PatientID is the patient searched for, if the search names one.
+	PatientID string
+}
+
+type FHIRMedicationCreateDomain struct {
+	UserID  string
+	Entries []FHIRMedicationEntryDomain
+}
+
+// FHIRMedicationEntryDomain is one resource of a request body.
+// ParseError is set, and Resource nil, when the entry is not a
+// MedicationRequest.
+type FHIRMedicationEntryDomain struct {
+	Resource   *fhir.MedicationRequest
+	ParseError error
+}
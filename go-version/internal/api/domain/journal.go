@@ -0,0 +1,52 @@
+package domain
+
+import "time"
+
+type JournalMeasurementDomain struct {
+	Name  string
+	Value float64
+	Unit  *string
+}
+
+type JournalEntryCreateDomain struct {
+	UserID       string
+	OccurredAt   time.Time
+	Text         string
+	Tags         []string
+	Measurements []JournalMeasurementDomain
+}
+
+type JournalEntryGetDomain struct {
+	UserID  string
+	EntryID string
+}
+
+// JournalEntryUpdateDomain changes the fields that are set. Tags and
+// Measurements replace the entry's when they are not nil.
+type JournalEntryUpdateDomain struct {
+	UserID       string
+	EntryID      string
+	OccurredAt   *time.Time
+	Text         *string
+	Tags         []string
+	Measurements []JournalMeasurementDomain
+}
+
+type JournalEntryDeleteDomain struct {
+	UserID  string
+	EntryID string
+}
+
+type JournalEntryListDomain struct {
+	UserID    string
+	Search    *string
+	Tag       *string
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type TimelineDomain struct {
+	UserID    string
+	StartDate time.Time
+	EndDate   time.Time
+}
@@ -0,0 +1,77 @@
+package domain
+
+import "time"
+
+type OrganizationCreateDomain struct {
+	UserID string
+	Name   string
+}
+
+type OrganizationListDomain struct {
+	UserID string
+}
+
+type OrganizationMemberListDomain struct {
+	UserID         string
+	OrganizationID string
+}
+
+// OrganizationMemberSetDomain adds the user whose email it is to the
+// organization, or changes their role.
+type OrganizationMemberSetDomain struct {
+	UserID         string
+	OrganizationID string
+	Email          string
+	Role           string
+}
+
+type OrganizationMemberDeleteDomain struct {
+	UserID         string
+	OrganizationID string
+	MemberID       string
+}
+
+type PatientEnrollDomain struct {
+	UserID         string
+	OrganizationID string
+	Email          string
+}
+
+type PatientListDomain struct {
+	UserID         string
+	OrganizationID string
+}
+
+type PatientUnenrollDomain struct {
+	UserID         string
+	OrganizationID string
+	PatientID      string
+}
+
+type PatientReminderListDomain struct {
+	UserID         string
+	OrganizationID string
+	PatientID      string
+}
+
+type PatientAdherenceDomain struct {
+	UserID         string
+	OrganizationID string
+	PatientID      string
+	StartDate      time.Time
+	EndDate        time.Time
+}
+
+type EnrollmentListDomain struct {
+	UserID string
+}
+
+type EnrollmentConsentDomain struct {
+	UserID       string
+	EnrollmentID string
+}
+
+type EnrollmentDeleteDomain struct {
+	UserID       string
+	EnrollmentID string
+}
@@ -0,0 +1,18 @@
+package domain
+
+type PushSubscriptionCreateDomain struct {
+	UserID    string
+	Endpoint  string
+	P256dh    string
+	Auth      string
+	UserAgent *string
+}
+
+type PushSubscriptionListDomain struct {
+	UserID string
+}
+
+type PushSubscriptionDeleteDomain struct {
+	UserID         string
+	SubscriptionID string
+}
@@ -0,0 +1,16 @@
+package domain
+
+type QuietHoursGetDomain struct {
+	UserID string
+}
+
+type QuietHoursSetDomain struct {
+	UserID    string
+	StartTime string
+	EndTime   string
+	TimeZone  string
+}
+
+type QuietHoursDeleteDomain struct {
+	UserID string
+}
@@ -3,10 +3,15 @@ package domain
 import "time"
 
 type ReminderCreateDomain struct {
-	UserID      string
+	UserID string
+	// ReminderID is set when the client names the reminder, as CalDAV
+	// clients do. Otherwise an id is generated.
+	ReminderID  string
 	RRule       string
 	Description *string
 	StartAt     time.Time
+	Critical    bool
+	Medication  *MedicationDomain
 }
 
 type ReminderUpdateDomain struct {
@@ -15,6 +20,14 @@ type ReminderUpdateDomain struct {
 	RRule       *string
 	Description *string
 	StartAt     *time.Time
+	Critical    *bool
+	// Medication replaces the reminder's medication when set, and
+	// RemoveMedication removes it.
+	Medication       *MedicationDomain
+	RemoveMedication bool
+	// Version, when set, only lets the update apply if the reminder is
+	// still at that version, as CalDAV's If-Match needs.
+	Version int
 }
 
 type ReminderListDomain struct {
@@ -22,9 +35,73 @@ type ReminderListDomain struct {
 	StartDate *time.Time
 	EndDate   *time.Time
 	Search    *string
+	// Medication matches reminders by medication name.
+	Medication *string
+	// IncludeShared adds the reminders shared with the user as a caregiver.
+	IncludeShared bool
+	// Sort is one of models.ReminderSorts, by start_at when empty.
+	Sort       string
+	Descending bool
+	// Limit caps the reminders returned, and Cursor is the next_cursor of
+	// the previous page.
+	Limit  int
+	Cursor *string
+	// AsOf is when reminders' next and previous occurrences are found from,
+	// now when nil.
+	AsOf *time.Time
+}
+
+type ReminderGetDomain struct {
+	UserID     string
+	ReminderID string
+	// AsOf is when the next and previous occurrences are found from, now
+	// when nil.
+	AsOf *time.Time
+}
+
+// ReminderUpcomingDomain lists the soonest occurrences of the user's
+// reminders from AsOf, or now when nil.
+type ReminderUpcomingDomain struct {
+	UserID        string
+	AsOf          *time.Time
+	IncludeShared bool
+	Limit         int
+}
+
+// AgendaListDomain lists the occurrences of the user's reminders within
+// [StartDate, EndDate], grouped by day in a time zone.
+type AgendaListDomain struct {
+	UserID    string
+	StartDate time.Time
+	EndDate   time.Time
+	// TimeZone is an IANA name. When empty, the zone of the user's quiet
+	// hours is used, or UTC without them.
+	TimeZone      string
+	IncludeShared bool
+	// Limit caps the occurrences returned, and Cursor is the next_cursor of
+	// the previous page.
+	Limit  int
+	Cursor *string
 }
 
 type ReminderDeleteDomain struct {
 	UserID     string
 	ReminderID string
+	// Version makes the delete conditional, as on ReminderUpdateDomain.
+	Version int
+}
+
+type ReminderImportDomain struct {
+	UserID  string
+	DryRun  bool
+	Entries []CalendarEntryDomain
+}
+
+type MedicationDomain struct {
+	Name         string
+	Strength     *string
+	DoseQuantity *float64
+	DoseUnit     *string
+	Route        *string
+	Instructions *string
 }
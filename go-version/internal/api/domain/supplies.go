@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+type ReminderSupplySetDomain struct {
+	UserID              string
+	ReminderID          string
+	Quantity            float64
+	DosePerOccurrence   float64
+	RefillThresholdDays int
+}
+
+type ReminderSupplyGetDomain struct {
+	UserID     string
+	ReminderID string
+}
+
+type ReminderSupplyDeleteDomain struct {
+	UserID     string
+	ReminderID string
+}
+
+type ReminderDoseLogDomain struct {
+	UserID     string
+	ReminderID string
+	// Quantity defaults to the supply's dose per occurrence.
+	Quantity *float64
+	TakenAt  time.Time
+}
@@ -0,0 +1,395 @@
+package handlers
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+	"go-version/internal/caldav"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// CalDAV resources, as seen by clients. The API is mounted under /api.
+const (
+	caldavRootPath       = "/api/caldav/"
+	caldavPrincipalPath  = caldavRootPath + "principal/"
+	caldavHomePath       = caldavRootPath + "calendars/"
+	caldavCollectionPath = caldavHomePath + "reminders/"
+
+	calendarObjectContentType = "text/calendar; charset=utf-8; component=vevent"
+)
+
+func init() {
+	chi.RegisterMethod("PROPFIND")
+	chi.RegisterMethod("REPORT")
+}
+
+// CalDAVHandler serves each user's reminders as a single calendar
+// collection that calendar apps can read and edit.
+type CalDAVHandler struct {
+	repo   *repository.CalendarRepository
+	tokens *auth.Tokens
+}
+
+func NewCalDAVHandler(repo *repository.CalendarRepository, tokens *auth.Tokens) (*CalDAVHandler, error) {
+	return &CalDAVHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *CalDAVHandler) RegisterRoutes(router chi.Router) {
+	// Calendar apps only support Basic authentication.
+	authMw, err := middleware.BasicAuthMiddleware(context.Background(), h.tokens, "Folia CalDAV")
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *CalDAVHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for CalDAV
+}
+
+func (h *CalDAVHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/caldav", func(r chi.Router) {
+		r.Use(authMw)
+		r.Options("/*", h.handleOptions)
+
+		r.Method("PROPFIND", "/", http.HandlerFunc(h.handlePropfindRoot))
+		for _, p := range []string{"/principal", "/principal/"} {
+			r.Method("PROPFIND", p, http.HandlerFunc(h.handlePropfindPrincipal))
+		}
+		for _, p := range []string{"/calendars", "/calendars/"} {
+			r.Method("PROPFIND", p, http.HandlerFunc(h.handlePropfindHome))
+		}
+		for _, p := range []string{"/calendars/reminders", "/calendars/reminders/"} {
+			r.Method("PROPFIND", p, http.HandlerFunc(h.handlePropfindCollection))
+			r.Method("REPORT", p, http.HandlerFunc(h.handleReport))
+		}
+
+		r.Method("PROPFIND", "/calendars/reminders/{reminderId}.ics", http.HandlerFunc(h.handlePropfindObject))
+		r.Get("/calendars/reminders/{reminderId}.ics", h.handleGetObject)
+		r.Put("/calendars/reminders/{reminderId}.ics", h.handlePutObject)
+		r.Delete("/calendars/reminders/{reminderId}.ics", h.handleDeleteObject)
+	})
+}
+
+func (h *CalDAVHandler) handleOptions(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("DAV", "1, 3, calendar-access")
+	w.Header().Set("Allow", "OPTIONS, GET, PUT, DELETE, PROPFIND, REPORT")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *CalDAVHandler) handlePropfindRoot(w http.ResponseWriter, r *http.Request) {
+	var req transport.CalendarPropfindRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeMultistatus(w, []caldav.Response{
+		newDAVResponse(caldavRootPath, req.Props, []caldav.Prop{
+			{Name: caldav.PropResourceType, Value: caldav.Element(xml.Name{Space: caldav.NamespaceDAV, Local: "collection"})},
+			{Name: caldav.PropCurrentUserPrincipal, Value: caldav.Href(caldavPrincipalPath)},
+		}),
+	})
+}
+
+func (h *CalDAVHandler) handlePropfindPrincipal(w http.ResponseWriter, r *http.Request) {
+	var req transport.CalendarPropfindRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeMultistatus(w, []caldav.Response{
+		newDAVResponse(caldavPrincipalPath, req.Props, []caldav.Prop{
+			{Name: caldav.PropResourceType, Value: caldav.Element(xml.Name{Space: caldav.NamespaceDAV, Local: "collection"}) +
+				caldav.Element(xml.Name{Space: caldav.NamespaceDAV, Local: "principal"})},
+			{Name: caldav.PropCurrentUserPrincipal, Value: caldav.Href(caldavPrincipalPath)},
+			{Name: caldav.PropPrincipalURL, Value: caldav.Href(caldavPrincipalPath)},
+			{Name: caldav.PropCalendarHomeSet, Value: caldav.Href(caldavHomePath)},
+		}),
+	})
+}
+
+func (h *CalDAVHandler) handlePropfindHome(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarPropfindRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	responses := []caldav.Response{
+		newDAVResponse(caldavHomePath, req.Props, []caldav.Prop{
+			{Name: caldav.PropResourceType, Value: caldav.Element(xml.Name{Space: caldav.NamespaceDAV, Local: "collection"})},
+			{Name: caldav.PropCurrentUserPrincipal, Value: caldav.Href(caldavPrincipalPath)},
+		}),
+	}
+	if req.IncludeChildren() {
+		objects, err := h.repo.ListCalendarObjects(ctx, req.ToDomain())
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+			return
+		}
+		responses = append(responses, newDAVResponse(caldavCollectionPath, req.Props, collectionProps(objects.CTag)))
+	}
+
+	writeMultistatus(w, responses)
+}
+
+func (h *CalDAVHandler) handlePropfindCollection(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarPropfindRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	objects, err := h.repo.ListCalendarObjects(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	responses := []caldav.Response{
+		newDAVResponse(caldavCollectionPath, req.Props, collectionProps(objects.CTag)),
+	}
+	if req.IncludeChildren() {
+		for i := range objects.Objects {
+			object := &objects.Objects[i]
+			responses = append(responses, newDAVResponse(calendarObjectPath(object.ReminderID), req.Props, objectProps(object, false)))
+		}
+	}
+
+	writeMultistatus(w, responses)
+}
+
+func (h *CalDAVHandler) handlePropfindObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarPropfindRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var getReq transport.CalendarObjectGetRequest
+	if err := transport.ParseRequest(r, &getReq); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	object, err := h.repo.GetCalendarObject(ctx, getReq.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	writeMultistatus(w, []caldav.Response{
+		newDAVResponse(calendarObjectPath(object.ReminderID), req.Props, objectProps(object, false)),
+	})
+}
+
+func (h *CalDAVHandler) handleReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarReportRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// The collection only holds events.
+	if req.Report.Component != "" && req.Report.Component != "VEVENT" {
+		writeMultistatus(w, nil)
+		return
+	}
+
+	objects, err := h.repo.ListCalendarObjects(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	var responses []caldav.Response
+	found := make(map[string]bool, len(objects.Objects))
+	for i := range objects.Objects {
+		object := &objects.Objects[i]
+		found[object.ReminderID] = true
+		responses = append(responses, newDAVResponse(calendarObjectPath(object.ReminderID), req.Report.Props, objectProps(object, true)))
+	}
+
+	// A multiget reports every requested resource, even missing ones.
+	if req.Report.Kind == caldav.ReportCalendarMultiget {
+		for _, href := range req.Report.Hrefs {
+			if !found[transport.CalendarObjectName(href)] {
+				responses = append(responses, caldav.Response{Href: href, NotFound: req.Report.Props})
+			}
+		}
+	}
+
+	writeMultistatus(w, responses)
+}
+
+func (h *CalDAVHandler) handleGetObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarObjectGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	object, err := h.repo.GetCalendarObject(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("ETag", object.ETag)
+	if etagMatches(r.Header.Get("If-None-Match"), object.ETag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", calendarObjectContentType)
+	w.Write(object.Body)
+}
+
+// handlePutObject creates or replaces a reminder. No ETag is returned: the
+// stored reminder is rendered differently from the uploaded body, so
+// clients must fetch it again, as RFC 4791 section 5.3.4 requires.
+func (h *CalDAVHandler) handlePutObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarObjectPutRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.repo.PutCalendarObject(ctx, req.ToDomain())
+	if err != nil {
+		writeCalendarObjectError(w, err)
+		return
+	}
+
+	if result.Created {
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CalDAVHandler) handleDeleteObject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarObjectDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteCalendarObject(ctx, req.ToDomain())
+	if err != nil {
+		writeCalendarObjectError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeCalendarObjectError(w http.ResponseWriter, err error) {
+	var noResourceErr *repository.NoResourceFoundError
+	if errors.As(err, &noResourceErr) {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	var preconditionErr *repository.ErrPreconditionFailed
+	if errors.As(err, &preconditionErr) {
+		writeJSONError(w, http.StatusPreconditionFailed, err.Error())
+		return
+	}
+	var conflictErr *repository.ErrCalendarObjectConflict
+	if errors.As(err, &conflictErr) {
+		caldav.WriteError(w, http.StatusConflict, xml.Name{Space: caldav.NamespaceCalDAV, Local: "no-uid-conflict"}, err.Error())
+		return
+	}
+	var invalidEntryErr *repository.ErrInvalidCalendarEntry
+	var invalidRRuleErr *repository.ErrInvalidRRule
+	if errors.As(err, &invalidEntryErr) || errors.As(err, &invalidRRuleErr) {
+		caldav.WriteError(w, http.StatusForbidden, xml.Name{Space: caldav.NamespaceCalDAV, Local: "valid-calendar-object-resource"}, err.Error())
+		return
+	}
+	writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+}
+
+func collectionProps(ctag string) []caldav.Prop {
+	privileges := ""
+	for _, privilege := range []string{"read", "write", "write-content", "bind", "unbind"} {
+		privileges += "<D:privilege>" + caldav.Element(xml.Name{Space: caldav.NamespaceDAV, Local: privilege}) + "</D:privilege>"
+	}
+	reports := ""
+	for _, report := range []string{caldav.ReportCalendarQuery, caldav.ReportCalendarMultiget} {
+		reports += "<D:supported-report><D:report>" + caldav.Element(xml.Name{Space: caldav.NamespaceCalDAV, Local: report}) + "</D:report></D:supported-report>"
+	}
+
+	return []caldav.Prop{
+		{Name: caldav.PropResourceType, Value: caldav.Element(xml.Name{Space: caldav.NamespaceDAV, Local: "collection"}) +
+			caldav.Element(xml.Name{Space: caldav.NamespaceCalDAV, Local: "calendar"})},
+		{Name: caldav.PropDisplayName, Value: caldav.Text("Reminders")},
+		{Name: caldav.PropCurrentUserPrincipal, Value: caldav.Href(caldavPrincipalPath)},
+		{Name: caldav.PropCurrentUserPrivilegeSet, Value: privileges},
+		{Name: caldav.PropSupportedReportSet, Value: reports},
+		{Name: caldav.PropSupportedCalendarComponentSet, Value: `<C:comp name="VEVENT"/>`},
+		{Name: caldav.PropGetCTag, Value: caldav.Text(ctag)},
+	}
+}
+
+// objectProps returns the properties of a calendar object. Calendar data is
+// only reported when asked for, as PROPFIND allprop must not include it.
+func objectProps(object *repository.CalendarObjectResult, withData bool) []caldav.Prop {
+	props := []caldav.Prop{
+		{Name: caldav.PropResourceType, Value: ""},
+		{Name: caldav.PropGetETag, Value: caldav.Text(object.ETag)},
+		{Name: caldav.PropGetContentType, Value: caldav.Text(calendarObjectContentType)},
+	}
+	if withData {
+		props = append(props, caldav.Prop{Name: caldav.PropCalendarData, Value: caldav.Text(string(object.Body))})
+	}
+	return props
+}
+
+func newDAVResponse(href string, requested []xml.Name, props []caldav.Prop) caldav.Response {
+	found, notFound := caldav.Select(props, requested)
+	return caldav.Response{Href: href, Found: found, NotFound: notFound}
+}
+
+func calendarObjectPath(reminderID string) string {
+	return caldavCollectionPath + reminderID + ".ics"
+}
+
+func writeMultistatus(w http.ResponseWriter, responses []caldav.Response) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(http.StatusMultiStatus)
+	caldav.WriteMultistatus(w, responses)
+}
@@ -0,0 +1,134 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CalendarHandler struct {
+	repo   *repository.CalendarRepository
+	tokens *auth.Tokens
+}
+
+func NewCalendarHandler(repo *repository.CalendarRepository, tokens *auth.Tokens) (*CalendarHandler, error) {
+	return &CalendarHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *CalendarHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *CalendarHandler) registerPublicRoutes(router chi.Router) {
+	// Calendar clients authenticate with the feed token in the URL.
+	router.Get("/calendar/{feedToken}.ics", h.handleGetCalendarFeed)
+}
+
+func (h *CalendarHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.With(authMw).Post("/calendar/feed", h.handleCreateCalendarFeed)
+	router.With(authMw).Delete("/calendar/feed", h.handleDeleteCalendarFeed)
+}
+
+func (h *CalendarHandler) handleGetCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarFeedGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	feed, err := h.repo.RenderFeed(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, "calendar feed not found")
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("ETag", feed.ETag)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if etagMatches(r.Header.Get("If-None-Match"), feed.ETag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Write(feed.Body)
+}
+
+func (h *CalendarHandler) handleCreateCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarFeedCreateRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	feed, err := h.repo.CreateFeed(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(feed)
+}
+
+func (h *CalendarHandler) handleDeleteCalendarFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CalendarFeedDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteFeed(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// etagMatches reports whether an If-None-Match header lists etag, using the
+// weak comparison RFC 9110 requires for this header.
+func etagMatches(header, etag string) bool {
+	if header == "" {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CaregiverHandler struct {
+	repo   *repository.CaregiverRepository
+	tokens *auth.Tokens
+}
+
+func NewCaregiverHandler(repo *repository.CaregiverRepository, tokens *auth.Tokens) (*CaregiverHandler, error) {
+	return &CaregiverHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *CaregiverHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *CaregiverHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for caregivers
+}
+
+func (h *CaregiverHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	// The caregivers the user has invited
+	router.Route("/caregivers", func(r chi.Router) {
+		r.Use(authMw)
+		r.Post("/", h.handleInviteCaregiver)
+		r.Get("/", h.handleListCaregivers)
+		r.Get("/activity", h.handleListActivity)
+		r.Delete("/{grantId}", h.handleRevokeCaregiver)
+	})
+	// The invitations the user has received as a caregiver
+	router.Route("/caregiving", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleListInvitations)
+		r.Post("/{grantId}/accept", h.handleAcceptInvitation)
+		r.Delete("/{grantId}", h.handleDeclineInvitation)
+	})
+}
+
+func (h *CaregiverHandler) handleInviteCaregiver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CaregiverInviteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	grant, err := h.repo.InviteCaregiver(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		var invalidErr *repository.ErrInvalidCaregiverInvitation
+		switch {
+		case errors.As(err, &noResourceErr):
+			writeJSONError(w, http.StatusNotFound, err.Error())
+		case errors.As(err, &invalidErr):
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+		default:
+			writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(grant)
+}
+
+func (h *CaregiverHandler) handleListCaregivers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CaregiverListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	caregivers, err := h.repo.ListCaregivers(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch caregivers")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(caregivers)
+}
+
+func (h *CaregiverHandler) handleListActivity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CaregiverActivityListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	activity, err := h.repo.ListActivity(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch caregiver activity")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(activity)
+}
+
+func (h *CaregiverHandler) handleRevokeCaregiver(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CaregiverRevokeRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.RevokeCaregiver(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CaregiverHandler) handleListInvitations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CaregiverInvitationListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	invitations, err := h.repo.ListInvitations(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch invitations")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(invitations)
+}
+
+func (h *CaregiverHandler) handleAcceptInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CaregiverInvitationAcceptRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	grant, err := h.repo.AcceptInvitation(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(grant)
+}
+
+func (h *CaregiverHandler) handleDeclineInvitation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CaregiverInvitationDeclineRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeclineInvitation(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CheckInHandler struct {
+	repo   *repository.CheckInRepository
+	tokens *auth.Tokens
+}
+
+func NewCheckInHandler(repo *repository.CheckInRepository, tokens *auth.Tokens) (*CheckInHandler, error) {
+	return &CheckInHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *CheckInHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *CheckInHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for check-ins
+}
+
+func (h *CheckInHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/reminders/{reminderId}/check-in", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleGetQuestionnaire)
+		r.Put("/", h.handleSetQuestionnaire)
+		r.Delete("/", h.handleDeleteQuestionnaire)
+	})
+	router.Route("/reminders/{reminderId}/check-ins", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleListResponses)
+		r.Post("/", h.handleSubmitResponse)
+	})
+}
+
+func (h *CheckInHandler) handleGetQuestionnaire(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CheckInQuestionnaireGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	questionnaire, err := h.repo.GetQuestionnaire(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(questionnaire)
+}
+
+func (h *CheckInHandler) handleSetQuestionnaire(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CheckInQuestionnaireSetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	questionnaire, err := h.repo.SetQuestionnaire(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(questionnaire)
+}
+
+func (h *CheckInHandler) handleDeleteQuestionnaire(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CheckInQuestionnaireDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteQuestionnaire(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CheckInHandler) handleSubmitResponse(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CheckInResponseSubmitRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response, err := h.repo.SubmitResponse(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		var invalidOccurrenceErr *repository.ErrInvalidOccurrence
+		if errors.As(err, &invalidOccurrenceErr) {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var invalidAnswersErr *repository.ErrInvalidCheckInAnswers
+		if errors.As(err, &invalidAnswersErr) {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+func (h *CheckInHandler) handleListResponses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.CheckInResponseListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	responses, err := h.repo.ListResponses(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch check-ins")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(responses)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ContactHandler struct {
+	repo   *repository.ContactRepository
+	tokens *auth.Tokens
+}
+
+func NewContactHandler(repo *repository.ContactRepository, tokens *auth.Tokens) (*ContactHandler, error) {
+	return &ContactHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *ContactHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *ContactHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for contacts
+}
+
+func (h *ContactHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/contacts", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleListContacts)
+		r.Post("/", h.handleCreateContact)
+		r.Delete("/{contactId}", h.handleDeleteContact)
+	})
+}
+
+func (h *ContactHandler) handleListContacts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ContactListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	contacts, err := h.repo.ListContacts(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch contacts")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(contacts)
+}
+
+func (h *ContactHandler) handleCreateContact(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ContactCreateRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	contact, err := h.repo.CreateContact(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(contact)
+}
+
+func (h *ContactHandler) handleDeleteContact(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ContactDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteContact(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type DeviceHandler struct {
+	repo   *repository.DeviceRepository
+	tokens *auth.Tokens
+}
+
+func NewDeviceHandler(repo *repository.DeviceRepository, tokens *auth.Tokens) (*DeviceHandler, error) {
+	return &DeviceHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *DeviceHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *DeviceHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for devices
+}
+
+func (h *DeviceHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/devices", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleListDevices)
+		r.Post("/", h.handleRegisterDevice)
+		r.Delete("/{deviceId}", h.handleDeleteDevice)
+	})
+}
+
+func (h *DeviceHandler) handleListDevices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.DeviceListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	devices, err := h.repo.ListDevices(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch devices")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(devices)
+}
+
+func (h *DeviceHandler) handleRegisterDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.DeviceCreateRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	device, err := h.repo.RegisterDevice(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(device)
+}
+
+func (h *DeviceHandler) handleDeleteDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.DeviceDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteDevice(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
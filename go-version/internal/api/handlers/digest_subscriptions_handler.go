@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type DigestSubscriptionHandler struct {
+	repo   *repository.DigestSubscriptionRepository
+	tokens *auth.Tokens
+}
+
+func NewDigestSubscriptionHandler(repo *repository.DigestSubscriptionRepository, tokens *auth.Tokens) (*DigestSubscriptionHandler, error) {
+	return &DigestSubscriptionHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *DigestSubscriptionHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *DigestSubscriptionHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for digest subscriptions
+}
+
+func (h *DigestSubscriptionHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.With(authMw).Get("/users/digest", h.handleGetDigestSubscription)
+	router.With(authMw).Put("/users/digest", h.handleSetDigestSubscription)
+	router.With(authMw).Delete("/users/digest", h.handleDeleteDigestSubscription)
+}
+
+func (h *DigestSubscriptionHandler) handleGetDigestSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.DigestSubscriptionGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	subscription, err := h.repo.GetDigestSubscription(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(subscription)
+}
+
+func (h *DigestSubscriptionHandler) handleSetDigestSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.DigestSubscriptionSetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	subscription, err := h.repo.SetDigestSubscription(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(subscription)
+}
+
+func (h *DigestSubscriptionHandler) handleDeleteDigestSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.DigestSubscriptionDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteDigestSubscription(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
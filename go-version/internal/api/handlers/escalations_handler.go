@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type EscalationHandler struct {
+	repo   *repository.EscalationRepository
+	tokens *auth.Tokens
+}
+
+func NewEscalationHandler(repo *repository.EscalationRepository, tokens *auth.Tokens) (*EscalationHandler, error) {
+	return &EscalationHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *EscalationHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *EscalationHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for escalations
+}
+
+func (h *EscalationHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/reminders/{reminderId}/escalation-policy", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleGetPolicy)
+		r.Put("/", h.handleSetPolicy)
+		r.Delete("/", h.handleDeletePolicy)
+	})
+	router.Route("/reminders/{reminderId}/escalations", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleListEvents)
+	})
+	router.Route("/reminders/{reminderId}/acknowledgements", func(r chi.Router) {
+		r.Use(authMw)
+		r.Post("/", h.handleAcknowledgeOccurrence)
+	})
+}
+
+func (h *EscalationHandler) handleGetPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.EscalationPolicyGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	policy, err := h.repo.GetPolicy(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(policy)
+}
+
+func (h *EscalationHandler) handleSetPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.EscalationPolicySetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	policy, err := h.repo.SetPolicy(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		var invalidContactErr *repository.ErrInvalidContact
+		if errors.As(err, &invalidContactErr) {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(policy)
+}
+
+func (h *EscalationHandler) handleDeletePolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.EscalationPolicyDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeletePolicy(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *EscalationHandler) handleListEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.EscalationEventListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	events, err := h.repo.ListEvents(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch escalations")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(events)
+}
+
+func (h *EscalationHandler) handleAcknowledgeOccurrence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ReminderAcknowledgeRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ack, err := h.repo.AcknowledgeOccurrence(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		var invalidOccurrenceErr *repository.ErrInvalidOccurrence
+		if errors.As(err, &invalidOccurrenceErr) {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(ack)
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+	"go-version/internal/fhir"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type FHIRHandler struct {
+	repo   *repository.FHIRRepository
+	tokens *auth.Tokens
+}
+
+func NewFHIRHandler(repo *repository.FHIRRepository, tokens *auth.Tokens) (*FHIRHandler, error) {
+	return &FHIRHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *FHIRHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *FHIRHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for FHIR
+}
+
+func (h *FHIRHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.With(authMw).Get("/fhir/MedicationRequest", h.handleListMedicationRequests)
+	router.With(authMw).Post("/fhir/MedicationRequest", h.handleCreateMedicationRequests)
+}
+
+func (h *FHIRHandler) handleListMedicationRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.FHIRMedicationListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeFHIRError(w, http.StatusBadRequest, fhir.IssueCodeInvalid, err.Error())
+		return
+	}
+
+	bundle, err := h.repo.ListMedicationRequests(ctx, req.ToDomain())
+	if err != nil {
+		var accessDeniedErr *repository.ErrPatientAccessDenied
+		if errors.As(err, &accessDeniedErr) {
+			writeFHIRError(w, http.StatusForbidden, fhir.IssueCodeForbidden, accessDeniedErr.Error())
+			return
+		}
+		writeFHIRError(w, http.StatusInternalServerError, fhir.IssueCodeException, "Unexpected error occurred")
+		return
+	}
+
+	writeFHIR(w, http.StatusOK, bundle)
+}
+
+func (h *FHIRHandler) handleCreateMedicationRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.FHIRMedicationCreateRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeFHIRError(w, http.StatusBadRequest, fhir.IssueCodeInvalid, err.Error())
+		return
+	}
+
+	bundle, err := h.repo.CreateMedicationRequests(ctx, req.ToDomain())
+	if err != nil {
+		writeFHIRError(w, http.StatusInternalServerError, fhir.IssueCodeException, "Unexpected error occurred")
+		return
+	}
+
+	// Each resource's own status is reported in its bundle entry.
+	writeFHIR(w, http.StatusOK, bundle)
+}
+
+func writeFHIR(w http.ResponseWriter, status int, resource any) {
+	w.Header().Set("Content-Type", fhir.MediaType)
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(resource)
+}
+
+// writeFHIRError reports an error as an OperationOutcome, as FHIR clients
+// expect.
+func writeFHIRError(w http.ResponseWriter, status int, code, message string) {
+	writeFHIR(w, status, fhir.NewOperationOutcome(fhir.OperationOutcomeIssue{
+		Severity:    fhir.IssueSeverityError,
+		Code:        code,
+		Diagnostics: message,
+	}))
+}
@@ -2,7 +2,13 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"mime"
 	"net/http"
+	"strconv"
+	"strings"
+
+	"go-version/internal/api/repository"
 
 	"github.com/go-chi/chi/v5"
 )
@@ -16,3 +22,72 @@ func writeJSONError(w http.ResponseWriter, status int, message string) {
 	w.WriteHeader(status)
 	json.NewEncoder(w).Encode(map[string]string{"error": message})
 }
+
+// ndjsonContentType is the media type of newline-delimited JSON.
+const ndjsonContentType = "application/x-ndjson"
+
+// ndjsonFlushEvery is how many lines are written between flushes, so that
+// clients see lines as they come without a write for every one.
+const ndjsonFlushEvery = 64
+
+// acceptsNDJSON reports whether the client asked for an NDJSON stream, and
+// prefers it to JSON if it accepts both.
+func acceptsNDJSON(r *http.Request) bool {
+	var ndjsonQuality, jsonQuality float64
+	for _, accept := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(accept))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case ndjsonContentType:
+			ndjsonQuality = max(ndjsonQuality, quality)
+		case "application/json":
+			jsonQuality = max(jsonQuality, quality)
+		}
+	}
+	return ndjsonQuality > 0 && ndjsonQuality >= jsonQuality
+}
+
+// ndjsonStream writes a response a JSON line at a time. The header goes out
+// with the first line, so that a request failing before then can still be
+// answered with an error status.
+type ndjsonStream struct {
+	w       http.ResponseWriter
+	encoder *json.Encoder
+	lines   int
+}
+
+func newNDJSONStream(w http.ResponseWriter) *ndjsonStream {
+	return &ndjsonStream{w: w, encoder: json.NewEncoder(w)}
+}
+
+func (s *ndjsonStream) emit(line *repository.StreamLineResult) error {
+	if s.lines == 0 {
+		s.w.Header().Set("Content-Type", ndjsonContentType)
+		s.w.WriteHeader(http.StatusOK)
+	}
+	if err := s.encoder.Encode(line); err != nil {
+		return err
+	}
+	s.lines++
+
+	if s.lines == 1 || s.lines%ndjsonFlushEvery == 0 || line.Type == repository.StreamLineEnd || line.Type == repository.StreamLineTruncated {
+		if err := http.NewResponseController(s.w).Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+	}
+	return nil
+}
+
+// started reports whether any line has been written, after which errors
+// can only end the stream early.
+func (s *ndjsonStream) started() bool {
+	return s.lines > 0
+}
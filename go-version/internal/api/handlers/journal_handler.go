@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type JournalHandler struct {
+	repo   *repository.JournalRepository
+	tokens *auth.Tokens
+}
+
+func NewJournalHandler(repo *repository.JournalRepository, tokens *auth.Tokens) (*JournalHandler, error) {
+	return &JournalHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *JournalHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *JournalHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for the journal
+}
+
+func (h *JournalHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/journal", func(r chi.Router) {
+		r.Use(authMw)
+		r.Post("/", h.handleCreateEntry)
+		r.Get("/", h.handleListEntries)
+		r.Get("/{entryId}", h.handleGetEntry)
+		r.Patch("/{entryId}", h.handleUpdateEntry)
+		r.Delete("/{entryId}", h.handleDeleteEntry)
+	})
+	router.Route("/timeline", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleGetTimeline)
+	})
+}
+
+func (h *JournalHandler) handleListEntries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.JournalEntryListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entries, err := h.repo.ListEntries(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch journal entries")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(entries)
+}
+
+func (h *JournalHandler) handleGetEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.JournalEntryGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.repo.GetEntry(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(entry)
+}
+
+func (h *JournalHandler) handleCreateEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.JournalEntryCreateRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.repo.CreateEntry(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(entry)
+}
+
+func (h *JournalHandler) handleUpdateEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.JournalEntryUpdateRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	entry, err := h.repo.UpdateEntry(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(entry)
+}
+
+func (h *JournalHandler) handleDeleteEntry(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.JournalEntryDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteEntry(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *JournalHandler) handleGetTimeline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.TimelineGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	timeline, err := h.repo.Timeline(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch timeline")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(timeline)
+}
@@ -0,0 +1,324 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/authz"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type OrganizationHandler struct {
+	repo   *repository.OrganizationRepository
+	tokens *auth.Tokens
+}
+
+func NewOrganizationHandler(repo *repository.OrganizationRepository, tokens *auth.Tokens) (*OrganizationHandler, error) {
+	return &OrganizationHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *OrganizationHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *OrganizationHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for organizations
+}
+
+func (h *OrganizationHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/organizations", func(r chi.Router) {
+		r.Use(authMw)
+		r.Post("/", h.handleCreateOrganization)
+		r.Get("/", h.handleListOrganizations)
+		r.Get("/{organizationId}/members", h.handleListMembers)
+		r.Post("/{organizationId}/members", h.handleSetMember)
+		r.Delete("/{organizationId}/members/{memberId}", h.handleDeleteMember)
+		r.Get("/{organizationId}/patients", h.handleListPatients)
+		r.Post("/{organizationId}/patients", h.handleEnrollPatient)
+		r.Delete("/{organizationId}/patients/{patientId}", h.handleUnenrollPatient)
+		r.Get("/{organizationId}/patients/{patientId}/reminders", h.handleListPatientReminders)
+		r.Get("/{organizationId}/patients/{patientId}/adherence", h.handleGetPatientAdherence)
+	})
+	// The organizations that have enrolled the user as a patient
+	router.Route("/enrollments", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleListEnrollments)
+		r.Post("/{enrollmentId}/consent", h.handleConsentEnrollment)
+		r.Delete("/{enrollmentId}", h.handleDeleteEnrollment)
+	})
+}
+
+func (h *OrganizationHandler) handleCreateOrganization(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.OrganizationCreateRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	organization, err := h.repo.CreateOrganization(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(organization)
+}
+
+func (h *OrganizationHandler) handleListOrganizations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.OrganizationListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	organizations, err := h.repo.ListOrganizations(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch organizations")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(organizations)
+}
+
+func (h *OrganizationHandler) handleListMembers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.OrganizationMemberListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	members, err := h.repo.ListMembers(ctx, req.ToDomain())
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(members)
+}
+
+func (h *OrganizationHandler) handleSetMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.OrganizationMemberSetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	member, err := h.repo.SetMember(ctx, req.ToDomain())
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(member)
+}
+
+func (h *OrganizationHandler) handleDeleteMember(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.OrganizationMemberDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.repo.DeleteMember(ctx, req.ToDomain()); err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *OrganizationHandler) handleListPatients(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.PatientListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	patients, err := h.repo.ListPatients(ctx, req.ToDomain())
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(patients)
+}
+
+func (h *OrganizationHandler) handleEnrollPatient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.PatientEnrollRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	enrollment, err := h.repo.EnrollPatient(ctx, req.ToDomain())
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(enrollment)
+}
+
+func (h *OrganizationHandler) handleUnenrollPatient(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.PatientUnenrollRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.repo.UnenrollPatient(ctx, req.ToDomain()); err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *OrganizationHandler) handleListPatientReminders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.PatientReminderListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	reminders, err := h.repo.ListPatientReminders(ctx, req.ToDomain())
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reminders)
+}
+
+func (h *OrganizationHandler) handleGetPatientAdherence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.PatientAdherenceGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	adherence, err := h.repo.GetPatientAdherence(ctx, req.ToDomain())
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(adherence)
+}
+
+func (h *OrganizationHandler) handleListEnrollments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.EnrollmentListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	enrollments, err := h.repo.ListEnrollments(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch enrollments")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(enrollments)
+}
+
+func (h *OrganizationHandler) handleConsentEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.EnrollmentConsentRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	enrollment, err := h.repo.ConsentEnrollment(ctx, req.ToDomain())
+	if err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(enrollment)
+}
+
+func (h *OrganizationHandler) handleDeleteEnrollment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.EnrollmentDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.repo.DeleteEnrollment(ctx, req.ToDomain()); err != nil {
+		writeOrganizationError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeOrganizationError(w http.ResponseWriter, err error) {
+	var forbiddenErr *authz.ErrForbidden
+	if errors.As(err, &forbiddenErr) {
+		writeJSONError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	var noResourceErr *repository.NoResourceFoundError
+	if errors.As(err, &noResourceErr) {
+		writeJSONError(w, http.StatusNotFound, err.Error())
+		return
+	}
+	var invalidMemberErr *repository.ErrInvalidOrganizationMember
+	if errors.As(err, &invalidMemberErr) {
+		writeJSONError(w, http.StatusConflict, err.Error())
+		return
+	}
+	writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+}
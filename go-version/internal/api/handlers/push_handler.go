@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type PushHandler struct {
+	repo   *repository.PushSubscriptionRepository
+	tokens *auth.Tokens
+}
+
+func NewPushHandler(repo *repository.PushSubscriptionRepository, tokens *auth.Tokens) (*PushHandler, error) {
+	return &PushHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *PushHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *PushHandler) registerPublicRoutes(router chi.Router) {
+	router.Get("/push/vapid-public-key", h.handleGetVapidPublicKey)
+}
+
+func (h *PushHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/push/subscriptions", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleListSubscriptions)
+		r.Post("/", h.handleCreateSubscription)
+		r.Delete("/{subscriptionId}", h.handleDeleteSubscription)
+	})
+}
+
+func (h *PushHandler) handleGetVapidPublicKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	key, err := h.repo.GetVapidPublicKey(ctx)
+	if err != nil {
+		writeJSONError(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(key)
+}
+
+func (h *PushHandler) handleListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.PushSubscriptionListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	subscriptions, err := h.repo.ListSubscriptions(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch push subscriptions")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(subscriptions)
+}
+
+func (h *PushHandler) handleCreateSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.PushSubscriptionCreateRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	subscription, err := h.repo.CreateSubscription(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(subscription)
+}
+
+func (h *PushHandler) handleDeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.PushSubscriptionDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteSubscription(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type QuietHoursHandler struct {
+	repo   *repository.QuietHoursRepository
+	tokens *auth.Tokens
+}
+
+func NewQuietHoursHandler(repo *repository.QuietHoursRepository, tokens *auth.Tokens) (*QuietHoursHandler, error) {
+	return &QuietHoursHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *QuietHoursHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *QuietHoursHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for quiet hours
+}
+
+func (h *QuietHoursHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.With(authMw).Get("/users/quiet-hours", h.handleGetQuietHours)
+	router.With(authMw).Put("/users/quiet-hours", h.handleSetQuietHours)
+	router.With(authMw).Delete("/users/quiet-hours", h.handleDeleteQuietHours)
+}
+
+func (h *QuietHoursHandler) handleGetQuietHours(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.QuietHoursGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	quietHours, err := h.repo.GetQuietHours(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(quietHours)
+}
+
+func (h *QuietHoursHandler) handleSetQuietHours(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.QuietHoursSetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	quietHours, err := h.repo.SetQuietHours(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(quietHours)
+}
+
+func (h *QuietHoursHandler) handleDeleteQuietHours(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.QuietHoursDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteQuietHours(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
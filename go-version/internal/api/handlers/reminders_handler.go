@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 
+	"go-version/internal/api/auth"
 	"go-version/internal/api/middleware"
 	"go-version/internal/api/repository"
 	"go-version/internal/api/transport"
@@ -14,15 +15,16 @@ import (
 )
 
 type ReminderHandler struct {
-	repo *repository.ReminderRepository
+	repo   *repository.ReminderRepository
+	tokens *auth.Tokens
 }
 
-func NewReminderHandler(repo *repository.ReminderRepository) (*ReminderHandler, error) {
-	return &ReminderHandler{repo: repo}, nil
+func NewReminderHandler(repo *repository.ReminderRepository, tokens *auth.Tokens) (*ReminderHandler, error) {
+	return &ReminderHandler{repo: repo, tokens: tokens}, nil
 }
 
 func (h *ReminderHandler) RegisterRoutes(router chi.Router) {
-	authMw, err := middleware.AuthMiddleware(context.Background())
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
 	if err != nil {
 		panic(err)
 	}
@@ -40,9 +42,16 @@ func (h *ReminderHandler) registerProtectedRoutes(router chi.Router, authMw func
 		r.Use(authMw)
 		r.Post("/", h.handleCreateReminder)
 		r.Get("/", h.handleListReminders)
+		r.Post("/import", h.handleImportReminders)
+		r.Get("/upcoming", h.handleListUpcoming)
+		r.Get("/{reminderId}", h.handleGetReminder)
 		r.Patch("/{reminderId}", h.handleUpdateReminder)
 		r.Delete("/{reminderId}", h.handleDeleteReminder)
 	})
+	router.Route("/agenda", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleListAgenda)
+	})
 }
 
 func (h *ReminderHandler) handleListReminders(w http.ResponseWriter, r *http.Request) {
@@ -54,8 +63,24 @@ func (h *ReminderHandler) handleListReminders(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	reminders, err := h.repo.ListReminders(ctx, reminderListRequest.ToDomain())
+	var reminders *repository.ReminderListResult
+	var err error
+	if acceptsNDJSON(r) {
+		stream := newNDJSONStream(w)
+		err = h.repo.StreamReminders(ctx, reminderListRequest.ToDomain(), stream.emit)
+		if err == nil || stream.started() {
+			return
+		}
+	} else {
+		reminders, err = h.repo.ListReminders(ctx, reminderListRequest.ToDomain())
+	}
 	if err != nil {
+		var invalidCursorErr *repository.ErrInvalidCursor
+		if errors.As(err, &invalidCursorErr) {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
 		http.Error(w, "Failed to fetch reminders", http.StatusInternalServerError)
 		return
 	}
@@ -64,6 +89,84 @@ func (h *ReminderHandler) handleListReminders(w http.ResponseWriter, r *http.Req
 
 }
 
+func (h *ReminderHandler) handleGetReminder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ReminderGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	reminder, err := h.repo.GetReminder(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reminder)
+}
+
+func (h *ReminderHandler) handleListUpcoming(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ReminderUpcomingRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	upcoming, err := h.repo.ListUpcoming(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch upcoming reminders")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(upcoming)
+}
+
+func (h *ReminderHandler) handleListAgenda(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.AgendaListRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var agenda *repository.AgendaResult
+	var err error
+	if acceptsNDJSON(r) {
+		stream := newNDJSONStream(w)
+		err = h.repo.StreamAgenda(ctx, req.ToDomain(), stream.emit)
+		if err == nil || stream.started() {
+			return
+		}
+	} else {
+		agenda, err = h.repo.ListAgenda(ctx, req.ToDomain())
+	}
+	if err != nil {
+		var invalidCursorErr *repository.ErrInvalidCursor
+		if errors.As(err, &invalidCursorErr) {
+			writeJSONError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		writeJSONError(w, http.StatusInternalServerError, "Failed to fetch agenda")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(agenda)
+}
+
 func (h *ReminderHandler) handleCreateReminder(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -89,6 +192,25 @@ func (h *ReminderHandler) handleCreateReminder(w http.ResponseWriter, r *http.Re
 	json.NewEncoder(w).Encode(reminder)
 }
 
+func (h *ReminderHandler) handleImportReminders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ReminderImportRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	report, err := h.repo.ImportReminders(ctx, req.ToDomain())
+	if err != nil {
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(report)
+}
+
 func (h *ReminderHandler) handleUpdateReminder(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/api/middleware"
+	"go-version/internal/api/repository"
+	"go-version/internal/api/transport"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type SupplyHandler struct {
+	repo   *repository.SupplyRepository
+	tokens *auth.Tokens
+}
+
+func NewSupplyHandler(repo *repository.SupplyRepository, tokens *auth.Tokens) (*SupplyHandler, error) {
+	return &SupplyHandler{repo: repo, tokens: tokens}, nil
+}
+
+func (h *SupplyHandler) RegisterRoutes(router chi.Router) {
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
+	if err != nil {
+		panic(err)
+	}
+
+	h.registerPublicRoutes(router)
+	h.registerProtectedRoutes(router, authMw)
+}
+
+func (h *SupplyHandler) registerPublicRoutes(router chi.Router) {
+	// No public routes for supplies
+}
+
+func (h *SupplyHandler) registerProtectedRoutes(router chi.Router, authMw func(http.Handler) http.Handler) {
+	router.Route("/reminders/{reminderId}/supply", func(r chi.Router) {
+		r.Use(authMw)
+		r.Get("/", h.handleGetSupply)
+		r.Put("/", h.handleSetSupply)
+		r.Delete("/", h.handleDeleteSupply)
+	})
+	router.Route("/reminders/{reminderId}/doses", func(r chi.Router) {
+		r.Use(authMw)
+		r.Post("/", h.handleLogDose)
+	})
+}
+
+func (h *SupplyHandler) handleGetSupply(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ReminderSupplyGetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	supply, err := h.repo.GetSupply(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(supply)
+}
+
+func (h *SupplyHandler) handleSetSupply(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ReminderSupplySetRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	supply, err := h.repo.SetSupply(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(supply)
+}
+
+func (h *SupplyHandler) handleDeleteSupply(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ReminderSupplyDeleteRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	err := h.repo.DeleteSupply(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *SupplyHandler) handleLogDose(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var req transport.ReminderDoseLogRequest
+	if err := transport.ParseRequest(r, &req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dose, err := h.repo.LogDose(ctx, req.ToDomain())
+	if err != nil {
+		var noResourceErr *repository.NoResourceFoundError
+		if errors.As(err, &noResourceErr) {
+			writeJSONError(w, http.StatusNotFound, err.Error())
+			return
+		}
+		writeJSONError(w, http.StatusInternalServerError, "Unexpected error occurred")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(dose)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"go-version/internal/api/auth"
 	"go-version/internal/api/middleware"
 	"go-version/internal/api/repository"
 	"go-version/internal/api/transport"
@@ -13,15 +14,16 @@ import (
 )
 
 type UserHandler struct {
-	repo *repository.UserRepository
+	repo   *repository.UserRepository
+	tokens *auth.Tokens
 }
 
-func NewUserHandler(repo *repository.UserRepository) (*UserHandler, error) {
-	return &UserHandler{repo: repo}, nil
+func NewUserHandler(repo *repository.UserRepository, tokens *auth.Tokens) (*UserHandler, error) {
+	return &UserHandler{repo: repo, tokens: tokens}, nil
 }
 
 func (h *UserHandler) RegisterRoutes(router chi.Router) {
-	authMw, err := middleware.AuthMiddleware(context.Background())
+	authMw, err := middleware.AuthMiddleware(context.Background(), h.tokens)
 	if err != nil {
 		panic(err)
 	}
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -12,7 +13,7 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func AuthMiddleware(ctx context.Context) (func(http.Handler) http.Handler, error) {
+func AuthMiddleware(ctx context.Context, tokens *auth.Tokens) (func(http.Handler) http.Handler, error) {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			// Get token from Authorization header
@@ -23,29 +24,67 @@ func AuthMiddleware(ctx context.Context) (func(http.Handler) http.Handler, error
 			}
 			tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 
-			token, err := auth.ParseToken(tokenString)
-			if err != nil || !token.Valid {
-				fmt.Println("Token parse error:", err)
-				http.Error(w, "invalid or expired token", http.StatusUnauthorized)
+			sub, err := userIDFromToken(tokens, tokenString)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
 				return
 			}
 
-			// Extract `sub` claim (userId)
-			claims, ok := token.Claims.(jwt.MapClaims)
-			if !ok {
-				http.Error(w, "invalid token claims", http.StatusUnauthorized)
-				return
+			// Attach userID to request context
+			ctx := context.WithValue(r.Context(), contextkeys.UserIDKey, sub)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}, nil
+}
+
+// BasicAuthMiddleware authenticates clients that only support HTTP Basic
+// authentication, such as calendar apps. The password is the user's API
+// key and the username is ignored. Bearer tokens are accepted as well.
+func BasicAuthMiddleware(ctx context.Context, tokens *auth.Tokens, realm string) (func(http.Handler) http.Handler, error) {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenString, ok := "", false
+			if authHeader := r.Header.Get("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+				tokenString, ok = strings.TrimPrefix(authHeader, "Bearer "), true
+			} else {
+				_, tokenString, ok = r.BasicAuth()
 			}
 
-			sub, ok := claims["sub"].(string)
-			if !ok || sub == "" {
-				http.Error(w, "missing sub claim", http.StatusUnauthorized)
+			var sub string
+			var err error
+			if ok {
+				sub, err = userIDFromToken(tokens, tokenString)
+			}
+			if !ok || err != nil {
+				w.Header().Set("WWW-Authenticate", `Basic realm="`+realm+`", charset="UTF-8"`)
+				http.Error(w, "missing or invalid credentials", http.StatusUnauthorized)
 				return
 			}
 
-			// Attach userID to request context
 			ctx := context.WithValue(r.Context(), contextkeys.UserIDKey, sub)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}, nil
 }
+
+// userIDFromToken validates an API key and returns its `sub` claim.
+func userIDFromToken(tokens *auth.Tokens, tokenString string) (string, error) {
+	token, err := tokens.ParseToken(tokenString)
+	if err != nil || !token.Valid {
+		fmt.Println("Token parse error:", err)
+		return "", errors.New("invalid or expired token")
+	}
+
+	// Extract `sub` claim (userId)
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return "", errors.New("invalid token claims")
+	}
+
+	sub, ok := claims["sub"].(string)
+	if !ok || sub == "" {
+		return "", errors.New("missing sub claim")
+	}
+
+	return sub, nil
+}
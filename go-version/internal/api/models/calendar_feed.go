@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// CalendarFeed grants read access to a user's reminders as an iCalendar
+// feed. Only a hash of the feed token is stored; the token itself is shown
+// once when the feed is created.
+type CalendarFeed struct {
+	UserId    string     `db:"user_id" json:"-"`
+	TokenHash string     `db:"token_hash" json:"-"`
+	CreatedAt *time.Time `db:"created_at" json:"created_at"`
+}
@@ -0,0 +1,52 @@
+package models
+
+import "time"
+
+// Caregiver permissions. A manage grant includes everything a view grant
+// allows.
+const (
+	CaregiverPermissionView   = "view"
+	CaregiverPermissionManage = "manage"
+)
+
+const (
+	CaregiverGrantPending  = "pending"
+	CaregiverGrantAccepted = "accepted"
+)
+
+// Caregiver actions recorded against the owner of a shared reminder.
+const (
+	CaregiverActionReminderUpdated = "reminder.updated"
+	CaregiverActionReminderDeleted = "reminder.deleted"
+)
+
+// CaregiverGrant lets the user invited by Email act on the owner's
+// reminders once they accept. The grant covers every reminder when
+// AllReminders is set and only ReminderIds otherwise.
+type CaregiverGrant struct {
+	Id           string     `db:"id" json:"id"`
+	OwnerId      string     `db:"owner_id" json:"owner_id"`
+	Email        string     `db:"email" json:"email"`
+	CaregiverId  *string    `db:"caregiver_id" json:"caregiver_id"`
+	Permission   string     `db:"permission" json:"permission"`
+	AllReminders bool       `db:"all_reminders" json:"all_reminders"`
+	ReminderIds  []string   `db:"-" json:"reminder_ids"`
+	Status       string     `db:"status" json:"status"`
+	CreatedAt    *time.Time `db:"created_at" json:"created_at"`
+	AcceptedAt   *time.Time `db:"accepted_at" json:"accepted_at"`
+}
+
+// CaregiverAction records a change a caregiver made to a reminder they do
+// not own.
+type CaregiverAction struct {
+	Id          string     `db:"id" json:"id"`
+	OwnerId     string     `db:"owner_id" json:"owner_id"`
+	CaregiverId string     `db:"caregiver_id" json:"caregiver_id"`
+	ReminderId  string     `db:"reminder_id" json:"reminder_id"`
+	Action      string     `db:"action" json:"action"`
+	CreatedAt   *time.Time `db:"created_at" json:"created_at"`
+}
+
+func IsValidCaregiverPermission(permission string) bool {
+	return permission == CaregiverPermissionView || permission == CaregiverPermissionManage
+}
@@ -0,0 +1,11 @@
+package models
+
+// Channels through which a notification can reach a user.
+const (
+	ChannelWebPush    = "web_push"
+	ChannelMobilePush = "mobile_push"
+)
+
+func IsValidChannel(channel string) bool {
+	return channel == ChannelWebPush || channel == ChannelMobilePush
+}
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+	"unicode/utf8"
+)
+
+// Types of check-in question, by the answer they take.
+const (
+	CheckInQuestionScale  = "scale"
+	CheckInQuestionYesNo  = "yes_no"
+	CheckInQuestionText   = "text"
+	CheckInQuestionNumber = "number"
+)
+
+// Bounds of a scale question that does not set its own.
+const (
+	DefaultCheckInScaleMin = 0
+	DefaultCheckInScaleMax = 10
+)
+
+// MaxCheckInTextLength is the longest free text answer, in characters.
+const MaxCheckInTextLength = 2000
+
+func IsValidCheckInQuestionType(questionType string) bool {
+	switch questionType {
+	case CheckInQuestionScale, CheckInQuestionYesNo, CheckInQuestionText, CheckInQuestionNumber:
+		return true
+	}
+	return false
+}
+
+// CheckInQuestionnaire is asked at every occurrence of a check-in reminder.
+type CheckInQuestionnaire struct {
+	Id         string            `db:"id" json:"id"`
+	ReminderId string            `db:"reminder_id" json:"reminder_id"`
+	UserId     string            `db:"user_id" json:"-"`
+	Questions  []CheckInQuestion `db:"-" json:"questions"`
+	CreatedAt  *time.Time        `db:"created_at" json:"-"`
+	UpdatedAt  *time.Time        `db:"updated_at" json:"-"`
+}
+
+// CheckInQuestion is one question of a questionnaire. Min and Max bound
+// scale and number answers, and Unit is what a number is measured in.
+type CheckInQuestion struct {
+	Position int      `db:"position" json:"position"`
+	Key      string   `db:"key" json:"key"`
+	Type     string   `db:"type" json:"type"`
+	Prompt   string   `db:"prompt" json:"prompt"`
+	Required bool     `db:"required" json:"required"`
+	Min      *float64 `db:"min" json:"min,omitempty"`
+	Max      *float64 `db:"max" json:"max,omitempty"`
+	Unit     *string  `db:"unit" json:"unit,omitempty"`
+}
+
+// CheckInResponse holds the answers given for one occurrence of a check-in
+// reminder.
+type CheckInResponse struct {
+	Id           string          `db:"id" json:"id"`
+	ReminderId   string          `db:"reminder_id" json:"reminder_id"`
+	UserId       string          `db:"user_id" json:"-"`
+	OccurrenceAt time.Time       `db:"occurrence_at" json:"occurrence_at"`
+	Answers      []CheckInAnswer `db:"-" json:"answers"`
+	SubmittedAt  *time.Time      `db:"submitted_at" json:"submitted_at"`
+}
+
+// CheckInAnswer is the answer to the question with QuestionKey. Value is a
+// float64 for scale and number questions, a bool for yes/no questions and a
+// string for text questions.
+type CheckInAnswer struct {
+	QuestionKey string `db:"question_key" json:"question_key"`
+	Value       any    `db:"-" json:"value"`
+}
+
+// Question returns the questionnaire's question with the given key.
+func (q *CheckInQuestionnaire) Question(key string) *CheckInQuestion {
+	for i := range q.Questions {
+		if q.Questions[i].Key == key {
+			return &q.Questions[i]
+		}
+	}
+	return nil
+}
+
+// CheckAnswer reports why value, as decoded from JSON, does not answer the
+// question. A nil value is no answer.
+func (q *CheckInQuestion) CheckAnswer(value any) error {
+	if value == nil {
+		if q.Required {
+			return fmt.Errorf("%s is required", q.Key)
+		}
+		return nil
+	}
+
+	switch q.Type {
+	case CheckInQuestionYesNo:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be true or false", q.Key)
+		}
+	case CheckInQuestionText:
+		text, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s must be text", q.Key)
+		}
+		if q.Required && text == "" {
+			return fmt.Errorf("%s is required", q.Key)
+		}
+		if utf8.RuneCountInString(text) > MaxCheckInTextLength {
+			return fmt.Errorf("%s must be at most %d characters", q.Key, MaxCheckInTextLength)
+		}
+	case CheckInQuestionScale:
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return fmt.Errorf("%s must be a whole number", q.Key)
+		}
+		lowest, highest := q.ScaleBounds()
+		if number < lowest || number > highest {
+			return fmt.Errorf("%s must be between %g and %g", q.Key, lowest, highest)
+		}
+	case CheckInQuestionNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("%s must be a number", q.Key)
+		}
+		if q.Min != nil && number < *q.Min {
+			return fmt.Errorf("%s must be at least %g", q.Key, *q.Min)
+		}
+		if q.Max != nil && number > *q.Max {
+			return fmt.Errorf("%s must be at most %g", q.Key, *q.Max)
+		}
+	default:
+		return fmt.Errorf("%s has unknown type %s", q.Key, q.Type)
+	}
+	return nil
+}
+
+// ScaleBounds returns the lowest and highest answers of a scale question.
+func (q *CheckInQuestion) ScaleBounds() (float64, float64) {
+	lowest, highest := float64(DefaultCheckInScaleMin), float64(DefaultCheckInScaleMax)
+	if q.Min != nil {
+		lowest = *q.Min
+	}
+	if q.Max != nil {
+		highest = *q.Max
+	}
+	return lowest, highest
+}
@@ -0,0 +1,42 @@
+package models
+
+import "testing"
+
+func TestCheckInQuestion_CheckAnswer(t *testing.T) {
+	scale := &CheckInQuestion{Key: "pain", Type: CheckInQuestionScale, Required: true}
+	number := &CheckInQuestion{Key: "weight", Type: CheckInQuestionNumber, Min: ptr(0.0)}
+	yesNo := &CheckInQuestion{Key: "slept", Type: CheckInQuestionYesNo}
+	text := &CheckInQuestion{Key: "notes", Type: CheckInQuestionText, Required: true}
+
+	testCases := []struct {
+		name     string
+		question *CheckInQuestion
+		value    any
+		valid    bool
+	}{
+		{name: "scale within bounds", question: scale, value: 10.0, valid: true},
+		{name: "scale above bounds", question: scale, value: 11.0, valid: false},
+		{name: "scale fraction", question: scale, value: 2.5, valid: false},
+		{name: "required missing", question: scale, value: nil, valid: false},
+		{name: "number", question: number, value: 70.5, valid: true},
+		{name: "number below min", question: number, value: -1.0, valid: false},
+		{name: "optional missing", question: number, value: nil, valid: true},
+		{name: "yes", question: yesNo, value: true, valid: true},
+		{name: "yes as text", question: yesNo, value: "yes", valid: false},
+		{name: "text", question: text, value: "Slept badly", valid: true},
+		{name: "required text empty", question: text, value: "", valid: false},
+		{name: "text as number", question: text, value: 3.0, valid: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.question.CheckAnswer(tc.value)
+			if tc.valid && err != nil {
+				t.Errorf("Expected %v to be valid, got %v", tc.value, err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("Expected %v to be invalid", tc.value)
+			}
+		})
+	}
+}
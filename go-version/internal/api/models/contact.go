@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// Contact is someone a user wants notified on their behalf, such as a family
+// member. Contacts are reached through the Folia account with the same email.
+type Contact struct {
+	Id        string     `db:"id" json:"id"`
+	UserId    string     `db:"user_id" json:"user_id"`
+	Name      string     `db:"name" json:"name"`
+	Email     string     `db:"email" json:"email"`
+	CreatedAt *time.Time `db:"created_at" json:"-"`
+	UpdatedAt *time.Time `db:"updated_at" json:"-"`
+}
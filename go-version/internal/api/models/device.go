@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type Device struct {
+	Id         string     `db:"id" json:"id"`
+	UserId     string     `db:"user_id" json:"user_id"`
+	Platform   string     `db:"platform" json:"platform"`
+	Token      string     `db:"token" json:"-"`
+	AppVersion *string    `db:"app_version" json:"app_version"`
+	CreatedAt  *time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt  *time.Time `db:"updated_at" json:"-"`
+}
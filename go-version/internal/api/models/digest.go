@@ -0,0 +1,73 @@
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	DigestFrequencyDaily  = "daily"
+	DigestFrequencyWeekly = "weekly"
+)
+
+// DigestSubscription asks for a single message summarising the reminders of
+// the coming day or week, sent at SendTime in the user's time zone. Weekly
+// digests go out on Weekday and cover the seven days starting then.
+type DigestSubscription struct {
+	UserId     string     `db:"user_id" json:"user_id"`
+	Frequency  string     `db:"frequency" json:"frequency"`
+	SendTime   string     `db:"send_time" json:"send_time"`
+	Weekday    string     `db:"weekday" json:"weekday"`
+	TimeZone   string     `db:"time_zone" json:"time_zone"`
+	Channel    string     `db:"channel" json:"channel"`
+	LastSentAt *time.Time `db:"last_sent_at" json:"last_sent_at"`
+	CreatedAt  *time.Time `db:"created_at" json:"-"`
+	UpdatedAt  *time.Time `db:"updated_at" json:"-"`
+}
+
+func IsValidDigestFrequency(frequency string) bool {
+	return frequency == DigestFrequencyDaily || frequency == DigestFrequencyWeekly
+}
+
+// ParseWeekday parses a lowercase English day name such as "monday".
+func ParseWeekday(s string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.ToLower(day.String()) == s {
+			return day, true
+		}
+	}
+	return time.Sunday, false
+}
+
+// CurrentPeriod returns the most recent digest period whose send time is at
+// or before now: the period covers [start, end) and its digest is due at
+// dueAt.
+func (d *DigestSubscription) CurrentPeriod(now time.Time) (start, end, dueAt time.Time, err error) {
+	loc, err := time.LoadLocation(d.TimeZone)
+	if err != nil {
+		return start, end, dueAt, err
+	}
+	sendTime, err := time.Parse(ClockLayout, d.SendTime)
+	if err != nil {
+		return start, end, dueAt, err
+	}
+
+	days := 1
+	local := now.In(loc)
+	y, m, day := local.Date()
+	if d.Frequency == DigestFrequencyWeekly {
+		days = 7
+		weekday, _ := ParseWeekday(d.Weekday)
+		day -= (int(local.Weekday()) - int(weekday) + 7) % 7
+	}
+
+	dueAt = time.Date(y, m, day, sendTime.Hour(), sendTime.Minute(), 0, 0, loc)
+	if dueAt.After(now) {
+		day -= days
+		dueAt = time.Date(y, m, day, sendTime.Hour(), sendTime.Minute(), 0, 0, loc)
+	}
+
+	start = time.Date(y, m, day, 0, 0, 0, 0, loc)
+	end = time.Date(y, m, day+days, 0, 0, 0, 0, loc)
+	return start, end, dueAt, nil
+}
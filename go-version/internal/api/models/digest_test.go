@@ -0,0 +1,84 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDigestSubscription_CurrentPeriod(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("loading time zone: %v", err)
+	}
+
+	daily := &DigestSubscription{Frequency: DigestFrequencyDaily, SendTime: "07:30", TimeZone: "America/New_York"}
+	weekly := &DigestSubscription{Frequency: DigestFrequencyWeekly, SendTime: "07:30", Weekday: "monday", TimeZone: "America/New_York"}
+
+	testCases := []struct {
+		name          string
+		subscription  *DigestSubscription
+		now           time.Time
+		expectedStart time.Time
+		expectedDays  int
+		expectedDueAt time.Time
+	}{
+		{
+			name:          "daily after send time",
+			subscription:  daily,
+			now:           time.Date(2023, 10, 4, 9, 0, 0, 0, newYork),
+			expectedStart: time.Date(2023, 10, 4, 0, 0, 0, 0, newYork),
+			expectedDays:  1,
+			expectedDueAt: time.Date(2023, 10, 4, 7, 30, 0, 0, newYork),
+		},
+		{
+			name:          "daily before send time is still yesterday",
+			subscription:  daily,
+			now:           time.Date(2023, 10, 4, 7, 29, 0, 0, newYork),
+			expectedStart: time.Date(2023, 10, 3, 0, 0, 0, 0, newYork),
+			expectedDays:  1,
+			expectedDueAt: time.Date(2023, 10, 3, 7, 30, 0, 0, newYork),
+		},
+		{
+			name:          "daily uses the subscriber's time zone",
+			subscription:  daily,
+			now:           time.Date(2023, 10, 5, 2, 0, 0, 0, time.UTC),
+			expectedStart: time.Date(2023, 10, 4, 0, 0, 0, 0, newYork),
+			expectedDays:  1,
+			expectedDueAt: time.Date(2023, 10, 4, 7, 30, 0, 0, newYork),
+		},
+		{
+			name:          "weekly midweek",
+			subscription:  weekly,
+			now:           time.Date(2023, 10, 4, 9, 0, 0, 0, newYork),
+			expectedStart: time.Date(2023, 10, 2, 0, 0, 0, 0, newYork),
+			expectedDays:  7,
+			expectedDueAt: time.Date(2023, 10, 2, 7, 30, 0, 0, newYork),
+		},
+		{
+			name:          "weekly before send time on the day",
+			subscription:  weekly,
+			now:           time.Date(2023, 10, 9, 6, 0, 0, 0, newYork),
+			expectedStart: time.Date(2023, 10, 2, 0, 0, 0, 0, newYork),
+			expectedDays:  7,
+			expectedDueAt: time.Date(2023, 10, 2, 7, 30, 0, 0, newYork),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, dueAt, err := tc.subscription.CurrentPeriod(tc.now)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !start.Equal(tc.expectedStart) {
+				t.Errorf("start = %v; want %v", start, tc.expectedStart)
+			}
+			if want := tc.expectedStart.AddDate(0, 0, tc.expectedDays); !end.Equal(want) {
+				t.Errorf("end = %v; want %v", end, want)
+			}
+			if !dueAt.Equal(tc.expectedDueAt) {
+				t.Errorf("dueAt = %v; want %v", dueAt, tc.expectedDueAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package models
+
+import "time"
+
+const (
+	EscalationStatusSent   = "sent"
+	EscalationStatusFailed = "failed"
+)
+
+type EscalationPolicy struct {
+	Id         string           `db:"id" json:"id"`
+	ReminderId string           `db:"reminder_id" json:"reminder_id"`
+	UserId     string           `db:"user_id" json:"user_id"`
+	Steps      []EscalationStep `db:"-" json:"steps"`
+	CreatedAt  *time.Time       `db:"created_at" json:"-"`
+	UpdatedAt  *time.Time       `db:"updated_at" json:"-"`
+}
+
+// EscalationStep notifies a contact through a channel once an occurrence has
+// gone unacknowledged for Delay after it was due.
+type EscalationStep struct {
+	Position     int    `db:"position" json:"position"`
+	DelayMinutes int    `db:"delay_minutes" json:"delay_minutes"`
+	ContactId    string `db:"contact_id" json:"contact_id"`
+	Channel      string `db:"channel" json:"channel"`
+}
+
+func (s *EscalationStep) Delay() time.Duration {
+	return time.Duration(s.DelayMinutes) * time.Minute
+}
+
+// EscalationEvent is the audit record of one escalation attempt.
+type EscalationEvent struct {
+	Id           string     `db:"id" json:"id"`
+	PolicyId     string     `db:"policy_id" json:"policy_id"`
+	ReminderId   string     `db:"reminder_id" json:"reminder_id"`
+	UserId       string     `db:"user_id" json:"-"`
+	StepPosition int        `db:"step_position" json:"step_position"`
+	ContactId    string     `db:"contact_id" json:"contact_id"`
+	Channel      string     `db:"channel" json:"channel"`
+	OccurrenceAt time.Time  `db:"occurrence_at" json:"occurrence_at"`
+	Status       string     `db:"status" json:"status"`
+	Error        *string    `db:"error" json:"error,omitempty"`
+	CreatedAt    *time.Time `db:"created_at" json:"created_at"`
+}
+
+type ReminderAcknowledgement struct {
+	Id             string     `db:"id" json:"id"`
+	ReminderId     string     `db:"reminder_id" json:"reminder_id"`
+	UserId         string     `db:"user_id" json:"-"`
+	OccurrenceAt   time.Time  `db:"occurrence_at" json:"occurrence_at"`
+	AcknowledgedAt *time.Time `db:"acknowledged_at" json:"acknowledged_at"`
+}
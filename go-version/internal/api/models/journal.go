@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// JournalEntry is something a user noted down, such as a symptom starting,
+// independent of any reminder.
+type JournalEntry struct {
+	Id           string               `db:"id" json:"id"`
+	UserId       string               `db:"user_id" json:"-"`
+	OccurredAt   time.Time            `db:"occurred_at" json:"occurred_at"`
+	Text         string               `db:"text" json:"text"`
+	Tags         []string             `db:"-" json:"tags"`
+	Measurements []JournalMeasurement `db:"-" json:"measurements"`
+	CreatedAt    *time.Time           `db:"created_at" json:"created_at"`
+	UpdatedAt    *time.Time           `db:"updated_at" json:"updated_at"`
+}
+
+// JournalMeasurement is a number recorded with a journal entry, such as a
+// temperature or a pain score.
+type JournalMeasurement struct {
+	Name  string  `db:"name" json:"name"`
+	Value float64 `db:"value" json:"value"`
+	Unit  *string `db:"unit" json:"unit,omitempty"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import "slices"
+
+// Medication describes the medication a reminder is for. Every field but
+// the name is optional.
+type Medication struct {
+	ReminderId   string   `db:"reminder_id" json:"-"`
+	Name         string   `db:"name" json:"name"`
+	Strength     *string  `db:"strength" json:"strength,omitempty"`
+	DoseQuantity *float64 `db:"dose_quantity" json:"dose_quantity,omitempty"`
+	DoseUnit     *string  `db:"dose_unit" json:"dose_unit,omitempty"`
+	Route        *string  `db:"route" json:"route,omitempty"`
+	Instructions *string  `db:"instructions" json:"instructions,omitempty"`
+}
+
+// Units a dose may be measured in.
+var DoseUnits = []string{
+	"mg", "mcg", "g", "ml", "l", "iu", "unit",
+	"tablet", "capsule", "drop", "puff", "spray", "patch", "sachet", "suppository",
+}
+
+// Routes by which a medication may be taken.
+var MedicationRoutes = []string{
+	"oral", "sublingual", "buccal", "topical", "transdermal", "inhaled", "nasal",
+	"ophthalmic", "otic", "rectal", "vaginal", "subcutaneous", "intramuscular", "intravenous",
+}
+
+func IsValidDoseUnit(unit string) bool {
+	return slices.Contains(DoseUnits, unit)
+}
+
+func IsValidMedicationRoute(route string) bool {
+	return slices.Contains(MedicationRoutes, route)
+}
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// Organization roles. Admins manage the members and can do everything a
+// clinician can.
+const (
+	OrganizationRoleAdmin     = "admin"
+	OrganizationRoleClinician = "clinician"
+)
+
+const (
+	EnrollmentPending = "pending"
+	EnrollmentActive  = "active"
+)
+
+// Organization is a clinic whose clinicians follow the patients enrolled
+// in it.
+type Organization struct {
+	Id        string     `db:"id" json:"id"`
+	Name      string     `db:"name" json:"name"`
+	CreatedAt *time.Time `db:"created_at" json:"created_at"`
+	// Role is the requesting user's role when organizations are listed for
+	// them.
+	Role string `db:"-" json:"role,omitempty"`
+}
+
+type OrganizationMember struct {
+	OrganizationId string     `db:"organization_id" json:"organization_id"`
+	UserId         string     `db:"user_id" json:"user_id"`
+	Name           string     `db:"name" json:"name"`
+	Email          string     `db:"email" json:"email"`
+	Role           string     `db:"role" json:"role"`
+	CreatedAt      *time.Time `db:"created_at" json:"created_at"`
+}
+
+// PatientEnrollment adds a patient to an organization's roster. Clinicians
+// see the patient's reminders only once the patient has consented, which
+// makes the enrollment active.
+type PatientEnrollment struct {
+	Id               string     `db:"id" json:"id"`
+	OrganizationId   string     `db:"organization_id" json:"organization_id"`
+	OrganizationName string     `db:"-" json:"organization_name"`
+	PatientId        string     `db:"patient_id" json:"patient_id"`
+	PatientName      string     `db:"-" json:"patient_name"`
+	PatientEmail     string     `db:"-" json:"patient_email"`
+	Status           string     `db:"status" json:"status"`
+	EnrolledBy       string     `db:"enrolled_by" json:"enrolled_by"`
+	CreatedAt        *time.Time `db:"created_at" json:"created_at"`
+	ConsentedAt      *time.Time `db:"consented_at" json:"consented_at"`
+}
+
+func IsValidOrganizationRole(role string) bool {
+	return role == OrganizationRoleAdmin || role == OrganizationRoleClinician
+}
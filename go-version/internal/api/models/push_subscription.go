@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+type PushSubscription struct {
+	Id        string     `db:"id" json:"id"`
+	UserId    string     `db:"user_id" json:"user_id"`
+	Endpoint  string     `db:"endpoint" json:"endpoint"`
+	P256dh    string     `db:"p256dh" json:"-"`
+	Auth      string     `db:"auth" json:"-"`
+	UserAgent *string    `db:"user_agent" json:"user_agent"`
+	CreatedAt *time.Time `db:"created_at" json:"-"`
+	UpdatedAt *time.Time `db:"updated_at" json:"-"`
+}
+
+type VapidKeys struct {
+	PublicKey  string     `db:"public_key" json:"public_key"`
+	PrivateKey string     `db:"private_key" json:"-"`
+	CreatedAt  *time.Time `db:"created_at" json:"-"`
+}
@@ -0,0 +1,60 @@
+package models
+
+import "time"
+
+const (
+	// ClockLayout is the format of QuietHours.StartTime and EndTime.
+	ClockLayout = "15:04"
+
+	// MaxQuietHoursDeferral bounds how long quiet hours can hold back an
+	// occurrence: a window is shorter than a day, plus at most an hour when
+	// it spans a daylight saving change.
+	MaxQuietHoursDeferral = 25 * time.Hour
+)
+
+// QuietHours is a daily window, in the user's time zone, during which
+// non-critical reminders are held back. A window whose end is earlier than
+// its start runs past midnight (e.g. 22:00 to 07:00).
+type QuietHours struct {
+	UserId    string     `db:"user_id" json:"user_id"`
+	StartTime string     `db:"start_time" json:"start_time"`
+	EndTime   string     `db:"end_time" json:"end_time"`
+	TimeZone  string     `db:"time_zone" json:"time_zone"`
+	CreatedAt *time.Time `db:"created_at" json:"-"`
+	UpdatedAt *time.Time `db:"updated_at" json:"-"`
+}
+
+// Defer returns t if it falls outside the quiet window, or the end of the
+// window containing t otherwise. Settings that cannot be parsed are treated
+// as having no quiet window.
+func (q *QuietHours) Defer(t time.Time) time.Time {
+	loc, err := time.LoadLocation(q.TimeZone)
+	if err != nil {
+		return t
+	}
+	start, err := time.Parse(ClockLayout, q.StartTime)
+	if err != nil {
+		return t
+	}
+	end, err := time.Parse(ClockLayout, q.EndTime)
+	if err != nil {
+		return t
+	}
+
+	// The window containing t, if any, opened on the same local day or, for
+	// windows past midnight, the day before.
+	local := t.In(loc)
+	for _, offset := range []int{0, -1} {
+		y, m, d := local.AddDate(0, 0, offset).Date()
+		windowStart := time.Date(y, m, d, start.Hour(), start.Minute(), 0, 0, loc)
+		windowEnd := time.Date(y, m, d, end.Hour(), end.Minute(), 0, 0, loc)
+		if !windowEnd.After(windowStart) {
+			windowEnd = time.Date(y, m, d+1, end.Hour(), end.Minute(), 0, 0, loc)
+		}
+		if !t.Before(windowStart) && t.Before(windowEnd) {
+			return windowEnd.In(t.Location())
+		}
+	}
+
+	return t
+}
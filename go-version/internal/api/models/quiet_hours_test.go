@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuietHours_Defer(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Fatalf("loading time zone: %v", err)
+	}
+
+	overnight := &QuietHours{StartTime: "22:00", EndTime: "07:00", TimeZone: "Europe/Amsterdam"}
+	afternoon := &QuietHours{StartTime: "13:00", EndTime: "15:00", TimeZone: "Europe/Amsterdam"}
+
+	testCases := []struct {
+		name       string
+		quietHours *QuietHours
+		at         time.Time
+		expected   time.Time
+	}{
+		{
+			name:       "before window",
+			quietHours: overnight,
+			at:         time.Date(2023, 10, 4, 21, 59, 0, 0, amsterdam),
+			expected:   time.Date(2023, 10, 4, 21, 59, 0, 0, amsterdam),
+		},
+		{
+			name:       "window start is quiet",
+			quietHours: overnight,
+			at:         time.Date(2023, 10, 4, 22, 0, 0, 0, amsterdam),
+			expected:   time.Date(2023, 10, 5, 7, 0, 0, 0, amsterdam),
+		},
+		{
+			name:       "after midnight",
+			quietHours: overnight,
+			at:         time.Date(2023, 10, 5, 3, 0, 0, 0, amsterdam),
+			expected:   time.Date(2023, 10, 5, 7, 0, 0, 0, amsterdam),
+		},
+		{
+			name:       "window end is not quiet",
+			quietHours: overnight,
+			at:         time.Date(2023, 10, 5, 7, 0, 0, 0, amsterdam),
+			expected:   time.Date(2023, 10, 5, 7, 0, 0, 0, amsterdam),
+		},
+		{
+			name:       "given in another time zone",
+			quietHours: overnight,
+			at:         time.Date(2023, 10, 5, 1, 0, 0, 0, time.UTC),
+			expected:   time.Date(2023, 10, 5, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "across daylight saving change",
+			quietHours: overnight,
+			at:         time.Date(2023, 10, 29, 2, 30, 0, 0, amsterdam),
+			expected:   time.Date(2023, 10, 29, 7, 0, 0, 0, amsterdam),
+		},
+		{
+			name:       "same day window",
+			quietHours: afternoon,
+			at:         time.Date(2023, 10, 4, 14, 0, 0, 0, amsterdam),
+			expected:   time.Date(2023, 10, 4, 15, 0, 0, 0, amsterdam),
+		},
+		{
+			name:       "outside same day window",
+			quietHours: afternoon,
+			at:         time.Date(2023, 10, 4, 3, 0, 0, 0, amsterdam),
+			expected:   time.Date(2023, 10, 4, 3, 0, 0, 0, amsterdam),
+		},
+		{
+			name:       "unknown time zone",
+			quietHours: &QuietHours{StartTime: "22:00", EndTime: "07:00", TimeZone: "Nowhere/Special"},
+			at:         time.Date(2023, 10, 5, 3, 0, 0, 0, time.UTC),
+			expected:   time.Date(2023, 10, 5, 3, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.quietHours.Defer(tc.at); !got.Equal(tc.expected) {
+				t.Errorf("Defer(%v) = %v; want %v", tc.at, got, tc.expected)
+			}
+		})
+	}
+}
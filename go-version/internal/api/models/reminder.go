@@ -1,38 +1,152 @@
 package models
 
 import (
+	"slices"
 	"time"
 
-	"github.com/teambition/rrule-go"
+	"go-version/internal/recurrence"
 )
 
+// Orders reminders may be listed in. Reminders that sort equally are
+// ordered by id.
+const (
+	ReminderSortStartAt        = "start_at"
+	ReminderSortCreatedAt      = "created_at"
+	ReminderSortDescription    = "description"
+	ReminderSortNextOccurrence = "next_occurrence"
+	// ReminderSortRelevance orders the results of a search, best first.
+	ReminderSortRelevance = "relevance"
+)
+
+var ReminderSorts = []string{
+	ReminderSortStartAt, ReminderSortCreatedAt, ReminderSortDescription, ReminderSortNextOccurrence, ReminderSortRelevance,
+}
+
+func IsValidReminderSort(sort string) bool {
+	return slices.Contains(ReminderSorts, sort)
+}
+
 type Reminder struct {
-	Id          string      `db:"id" json:"id"`
-	UserId      string      `db:"user_id" json:"user_id"`
-	RRule       string      `db:"rrule" json:"rrule"`
-	Description *string     `db:"description" json:"description"`
-	StartAt     time.Time   `db:"start_at" json:"start_at"`
-	CreatedAt   *time.Time  `db:"created_at" json:"-"`
-	UpdatedAt   *time.Time  `db:"updated_at" json:"-"`
-	Occurrences []time.Time `db:"-" json:"occurrences,omitempty"`
+	Id          string     `db:"id" json:"id"`
+	UserId      string     `db:"user_id" json:"user_id"`
+	RRule       string     `db:"rrule" json:"rrule"`
+	Description *string    `db:"description" json:"description"`
+	StartAt     time.Time  `db:"start_at" json:"start_at"`
+	Critical    bool       `db:"critical" json:"critical"`
+	CreatedAt   *time.Time `db:"created_at" json:"-"`
+	UpdatedAt   *time.Time `db:"updated_at" json:"-"`
+	// Version counts the updates made to the reminder. Setting it on a
+	// reminder to update makes the update conditional on it.
+	Version int `db:"version" json:"-"`
+	// FirstOccurrenceAt and LastOccurrenceAt bound the series so that range
+	// queries can skip it without expanding the rule. LastOccurrenceAt is
+	// nil when the series never ends.
+	FirstOccurrenceAt *time.Time  `db:"first_occurrence_at" json:"-"`
+	LastOccurrenceAt  *time.Time  `db:"last_occurrence_at" json:"-"`
+	Medication        *Medication `db:"-" json:"medication,omitempty"`
+	Occurrences       []time.Time `db:"-" json:"occurrences,omitempty"`
+	// DeliveryTimes holds, index for index with Occurrences, when each
+	// occurrence will actually be delivered once quiet hours are applied.
+	DeliveryTimes []time.Time `db:"-" json:"delivery_times,omitempty"`
+	// SearchRank and Snippet are set on the results of a search. Lower
+	// ranks are better matches, and the snippet is HTML with the matching
+	// words in <mark> tags.
+	SearchRank *float64 `db:"-" json:"-"`
+	Snippet    *string  `db:"-" json:"snippet,omitempty"`
+	// NextOccurrenceAt and PreviousOccurrenceAt are the occurrences around
+	// the time the reminder was listed or fetched as of.
+	NextOccurrenceAt     *time.Time `db:"-" json:"next_occurrence_at,omitempty"`
+	PreviousOccurrenceAt *time.Time `db:"-" json:"previous_occurrence_at,omitempty"`
 }
 
-func (r *Reminder) PopulateMetadataFields(start, end *time.Time) {
+func (r *Reminder) PopulateMetadataFields(start, end *time.Time, limits recurrence.Limits) {
 	if start != nil && end != nil {
-		occurrences, err := r.generateOccurrences(*start, *end)
+		occurrences, err := r.generateOccurrences(*start, *end, limits)
 		if err == nil {
 			r.Occurrences = occurrences
 		}
 	}
 }
 
-func (r *Reminder) generateOccurrences(startDate, endDate time.Time) ([]time.Time, error) {
-	rruleObj, err := rrule.StrToRRule(r.RRule)
+// PopulateOccurrencesAround sets the next occurrence at or after asOf and
+// the previous one before it.
+func (r *Reminder) PopulateOccurrencesAround(asOf time.Time) {
+	r.NextOccurrenceAt, _ = r.NextOccurrence(asOf)
+	r.PreviousOccurrenceAt, _ = r.PreviousOccurrence(asOf)
+}
+
+// PopulateDeliveryTimes previews the delivery time of each populated
+// occurrence under the user's quiet hours, which may be nil.
+func (r *Reminder) PopulateDeliveryTimes(quietHours *QuietHours) {
+	if r.Occurrences == nil {
+		return
+	}
+	r.DeliveryTimes = make([]time.Time, len(r.Occurrences))
+	for i, occurrence := range r.Occurrences {
+		r.DeliveryTimes[i] = r.DeliveryTime(occurrence, quietHours)
+	}
+}
+
+// DeliveryTime returns when the occurrence should be delivered. Critical
+// reminders ignore quiet hours.
+func (r *Reminder) DeliveryTime(occurrence time.Time, quietHours *QuietHours) time.Time {
+	if r.Critical || quietHours == nil {
+		return occurrence
+	}
+	return quietHours.Defer(occurrence)
+}
+
+// OccurrencesBetween returns the occurrences falling within [start, end],
+// no more than the limits allow a series.
+func (r *Reminder) OccurrencesBetween(start, end time.Time, limits recurrence.Limits) ([]time.Time, error) {
+	return r.generateOccurrences(start, end, limits)
+}
+
+// OccurrenceBounds returns the first and last occurrences of the series.
+// last is nil when the series never ends, and both are nil when it has no
+// occurrences at all.
+func (r *Reminder) OccurrenceBounds() (first, last *time.Time, err error) {
+	return recurrence.Bounds(r.RRule, r.StartAt)
+}
+
+// NextOccurrence returns the first occurrence at or after t, or nil when the
+// series has ended by then.
+func (r *Reminder) NextOccurrence(t time.Time) (*time.Time, error) {
+	if r.LastOccurrenceAt != nil && r.LastOccurrenceAt.Before(t) {
+		return nil, nil
+	}
+	return recurrence.After(r.RRule, r.StartAt, t)
+}
+
+// PreviousOccurrence returns the last occurrence before t, or nil when the
+// series has not started by then.
+func (r *Reminder) PreviousOccurrence(t time.Time) (*time.Time, error) {
+	if r.FirstOccurrenceAt != nil && !r.FirstOccurrenceAt.Before(t) {
+		return nil, nil
+	}
+	if r.LastOccurrenceAt != nil && r.LastOccurrenceAt.Before(t) {
+		last := *r.LastOccurrenceAt
+		return &last, nil
+	}
+	return recurrence.Before(r.RRule, r.StartAt, t)
+}
+
+// generateOccurrences returns the occurrences within [startDate, endDate].
+// A series need not end, so no more are returned than the limits allow a
+// series that ends.
+func (r *Reminder) generateOccurrences(startDate, endDate time.Time, limits recurrence.Limits) ([]time.Time, error) {
+	next, err := recurrence.From(r.RRule, r.StartAt, startDate)
 	if err != nil {
 		return nil, err
 	}
 
-	rruleObj.DTStart(r.StartAt)
-
-	return rruleObj.Between(startDate, endDate, true), nil
+	var occurrences []time.Time
+	maxOccurrences := limits.OrDefault().MaxOccurrences
+	for occurrence, ok := next(); ok && !occurrence.After(endDate); occurrence, ok = next() {
+		if len(occurrences) == maxOccurrences {
+			break
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences, nil
 }
@@ -0,0 +1,193 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"go-version/internal/recurrence"
+)
+
+func TestReminder_OccurrenceBounds(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		rrule         string
+		expectedFirst *time.Time
+		expectedLast  *time.Time
+	}{
+		{
+			name:          "count",
+			rrule:         "FREQ=DAILY;COUNT=10",
+			expectedFirst: ptr(start),
+			expectedLast:  ptr(time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "until",
+			rrule:         "FREQ=WEEKLY;UNTIL=20240201T000000Z",
+			expectedFirst: ptr(start),
+			expectedLast:  ptr(time.Date(2024, 1, 29, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "first occurrence after the start",
+			rrule:         "FREQ=DAILY;BYHOUR=20;COUNT=2",
+			expectedFirst: ptr(time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)),
+			expectedLast:  ptr(time.Date(2024, 1, 2, 20, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "never ends",
+			rrule:         "FREQ=HOURLY",
+			expectedFirst: ptr(start),
+			expectedLast:  nil,
+		},
+		{
+			name:          "no occurrences",
+			rrule:         "FREQ=DAILY;UNTIL=20231231T000000Z",
+			expectedFirst: nil,
+			expectedLast:  nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reminder := &Reminder{RRule: tc.rrule, StartAt: start}
+
+			first, last, err := reminder.OccurrenceBounds()
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !equalTimes(first, tc.expectedFirst) {
+				t.Errorf("Expected first occurrence %v, got %v", tc.expectedFirst, first)
+			}
+			if !equalTimes(last, tc.expectedLast) {
+				t.Errorf("Expected last occurrence %v, got %v", tc.expectedLast, last)
+			}
+		})
+	}
+}
+
+func TestReminder_OccurrencesBetweenCapsAtTheLimits(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	reminder := Reminder{RRule: "FREQ=HOURLY", StartAt: start}
+	end := start.AddDate(2, 0, 0)
+
+	limits := recurrence.DefaultLimits()
+	limits.MaxOccurrences = 24
+	occurrences, err := reminder.OccurrencesBetween(start, end, limits)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(occurrences) != limits.MaxOccurrences {
+		t.Errorf("Expected %d occurrences, got %d", limits.MaxOccurrences, len(occurrences))
+	}
+
+	occurrences, err = reminder.OccurrencesBetween(start, end, recurrence.Limits{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(occurrences) != recurrence.DefaultLimits().MaxOccurrences {
+		t.Errorf("Expected %d occurrences without limits, got %d", recurrence.DefaultLimits().MaxOccurrences, len(occurrences))
+	}
+}
+
+func TestReminder_NextOccurrence(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	last := start.AddDate(0, 0, 2)
+
+	testCases := []struct {
+		name     string
+		reminder Reminder
+		after    time.Time
+		expected *time.Time
+	}{
+		{
+			name:     "before the start",
+			reminder: Reminder{RRule: "FREQ=DAILY;COUNT=3", StartAt: start},
+			after:    start.Add(-time.Hour),
+			expected: ptr(start),
+		},
+		{
+			name:     "between occurrences",
+			reminder: Reminder{RRule: "FREQ=DAILY;COUNT=3", StartAt: start},
+			after:    start.Add(time.Hour),
+			expected: ptr(start.AddDate(0, 0, 1)),
+		},
+		{
+			name:     "ended",
+			reminder: Reminder{RRule: "FREQ=DAILY;COUNT=3", StartAt: start},
+			after:    last.Add(time.Second),
+		},
+		{
+			name:     "ended by its stored bounds",
+			reminder: Reminder{RRule: "FREQ=DAILY", StartAt: start, LastOccurrenceAt: &last},
+			after:    last.Add(time.Second),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, err := tc.reminder.NextOccurrence(tc.after)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !equalTimes(next, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, next)
+			}
+		})
+	}
+}
+
+func TestReminder_PreviousOccurrence(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	last := start.AddDate(0, 0, 2)
+
+	testCases := []struct {
+		name     string
+		reminder Reminder
+		before   time.Time
+		expected *time.Time
+	}{
+		{
+			name:     "not started",
+			reminder: Reminder{RRule: "FREQ=DAILY;COUNT=3", StartAt: start},
+			before:   start,
+		},
+		{
+			name:     "between occurrences",
+			reminder: Reminder{RRule: "FREQ=DAILY;COUNT=3", StartAt: start},
+			before:   start.Add(25 * time.Hour),
+			expected: ptr(start.AddDate(0, 0, 1)),
+		},
+		{
+			name:     "ended",
+			reminder: Reminder{RRule: "FREQ=DAILY;COUNT=3", StartAt: start},
+			before:   last.AddDate(1, 0, 0),
+			expected: ptr(last),
+		},
+		{
+			name:     "ended by its stored bounds",
+			reminder: Reminder{RRule: "FREQ=DAILY;COUNT=3", StartAt: start, FirstOccurrenceAt: &start, LastOccurrenceAt: &last},
+			before:   last.AddDate(1, 0, 0),
+			expected: ptr(last),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			previous, err := tc.reminder.PreviousOccurrence(tc.before)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !equalTimes(previous, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, previous)
+			}
+		})
+	}
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
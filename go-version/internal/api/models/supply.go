@@ -0,0 +1,93 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"go-version/internal/recurrence"
+)
+
+// DefaultRefillThresholdDays is how many days of supply are left when a
+// refill reminder is due, unless the supply says otherwise.
+const DefaultRefillThresholdDays = 7
+
+// supplyEpsilon absorbs rounding when fractional doses are subtracted.
+const supplyEpsilon = 1e-9
+
+// ReminderSupply is how much of a reminder's medication is left. Each
+// occurrence of the reminder takes DosePerOccurrence of it.
+type ReminderSupply struct {
+	ReminderId          string     `db:"reminder_id" json:"reminder_id"`
+	UserId              string     `db:"user_id" json:"-"`
+	Quantity            float64    `db:"quantity" json:"quantity"`
+	DosePerOccurrence   float64    `db:"dose_per_occurrence" json:"dose_per_occurrence"`
+	RefillThresholdDays int        `db:"refill_threshold_days" json:"refill_threshold_days"`
+	RefillReminderId    *string    `db:"refill_reminder_id" json:"refill_reminder_id"`
+	UpdatedAt           *time.Time `db:"updated_at" json:"updated_at"`
+}
+
+// DoseLog records a dose taken from a supply.
+type DoseLog struct {
+	Id         string    `db:"id" json:"id"`
+	ReminderId string    `db:"reminder_id" json:"reminder_id"`
+	UserId     string    `db:"user_id" json:"-"`
+	Quantity   float64   `db:"quantity" json:"quantity"`
+	TakenAt    time.Time `db:"taken_at" json:"taken_at"`
+}
+
+// DosesRemaining is the number of whole doses the supply covers.
+func (s *ReminderSupply) DosesRemaining() int {
+	if s.DosePerOccurrence <= 0 {
+		return 0
+	}
+	doses := s.Quantity/s.DosePerOccurrence + supplyEpsilon
+	if doses >= math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int(doses)
+}
+
+// RunOutAt walks the reminder's occurrences after now, taking a dose at
+// each, and returns the first occurrence the supply cannot cover. It
+// returns nil when the supply lasts until the reminder ends, or past the
+// limits' occurrences or horizon, as there is no run-out to plan for
+// within them.
+func (s *ReminderSupply) RunOutAt(reminder *Reminder, now time.Time, limits recurrence.Limits) (*time.Time, error) {
+	next, err := recurrence.From(reminder.RRule, reminder.StartAt, now)
+	if err != nil {
+		return nil, err
+	}
+
+	limits = limits.OrDefault()
+	horizon := now.Add(limits.Horizon)
+	remaining := s.DosesRemaining()
+	for walked := 0; ; {
+		occurrence, ok := next()
+		if !ok || occurrence.After(horizon) {
+			return nil, nil
+		}
+		if !occurrence.After(now) {
+			continue
+		}
+		if walked++; walked > limits.MaxOccurrences {
+			return nil, nil
+		}
+		if remaining == 0 {
+			return &occurrence, nil
+		}
+		remaining--
+	}
+}
+
+// RefillAt returns when a refill is due: RefillThresholdDays before the
+// supply runs out. It is in the past when the supply is already running
+// low, and nil when no refill is needed.
+func (s *ReminderSupply) RefillAt(reminder *Reminder, now time.Time, limits recurrence.Limits) (*time.Time, error) {
+	runOutAt, err := s.RunOutAt(reminder, now, limits)
+	if err != nil || runOutAt == nil {
+		return nil, err
+	}
+
+	refillAt := runOutAt.AddDate(0, 0, -s.RefillThresholdDays)
+	return &refillAt, nil
+}
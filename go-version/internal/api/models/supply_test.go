@@ -0,0 +1,144 @@
+package models
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"go-version/internal/recurrence"
+)
+
+func TestReminderSupply_RunOutAt(t *testing.T) {
+	reminder := &Reminder{
+		RRule:   "FREQ=DAILY;BYHOUR=8,20;COUNT=60",
+		StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		supply   *ReminderSupply
+		expected *time.Time
+	}{
+		{
+			name:     "empty",
+			supply:   &ReminderSupply{Quantity: 0, DosePerOccurrence: 1},
+			expected: ptr(time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "ten doses",
+			supply:   &ReminderSupply{Quantity: 10, DosePerOccurrence: 1},
+			expected: ptr(time.Date(2024, 1, 6, 20, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "half a dose short",
+			supply:   &ReminderSupply{Quantity: 4.5, DosePerOccurrence: 1.5},
+			expected: ptr(time.Date(2024, 1, 3, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "lasts until the end",
+			supply:   &ReminderSupply{Quantity: 100, DosePerOccurrence: 1},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runOutAt, err := tc.supply.RunOutAt(reminder, now, recurrence.DefaultLimits())
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.expected == nil {
+				if runOutAt != nil {
+					t.Errorf("Expected the supply to last, got %v", runOutAt)
+				}
+				return
+			}
+			if runOutAt == nil || !runOutAt.Equal(*tc.expected) {
+				t.Errorf("Expected run out at %v, got %v", tc.expected, runOutAt)
+			}
+		})
+	}
+}
+
+func TestReminderSupply_RunOutAtRejectsUnexpandableRules(t *testing.T) {
+	supply := &ReminderSupply{Quantity: 10, DosePerOccurrence: 1}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, rule := range []string{
+		"FREQ=HOURLY;INTERVAL=2;BYHOUR=3",
+		"FREQ=MONTHLY;BYDAY=11FR",
+	} {
+		reminder := &Reminder{RRule: rule, StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)}
+		if _, err := supply.RunOutAt(reminder, now, recurrence.DefaultLimits()); err == nil {
+			t.Errorf("%s: expected an error", rule)
+		}
+	}
+}
+
+func TestReminderSupply_RunOutAtStopsAtLimits(t *testing.T) {
+	reminder := &Reminder{
+		RRule:   "FREQ=DAILY;BYHOUR=8",
+		StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	supply := &ReminderSupply{Quantity: 1e6, DosePerOccurrence: 1e-6}
+
+	testCases := []struct {
+		name   string
+		limits recurrence.Limits
+	}{
+		{name: "occurrences", limits: recurrence.Limits{MaxOccurrences: 100, Horizon: 1000 * 24 * time.Hour}},
+		{name: "horizon", limits: recurrence.Limits{MaxOccurrences: 10000, Horizon: 30 * 24 * time.Hour}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			runOutAt, err := supply.RunOutAt(reminder, now, tc.limits)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if runOutAt != nil {
+				t.Errorf("Expected no run-out within the limits, got %v", runOutAt)
+			}
+		})
+	}
+
+	supply = &ReminderSupply{Quantity: 10, DosePerOccurrence: 1}
+	runOutAt, err := supply.RunOutAt(reminder, now, recurrence.Limits{MaxOccurrences: 100, Horizon: 30 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if expected := time.Date(2024, 1, 12, 8, 0, 0, 0, time.UTC); runOutAt == nil || !runOutAt.Equal(expected) {
+		t.Errorf("Expected run out at %v, got %v", expected, runOutAt)
+	}
+}
+
+func TestReminderSupply_DosesRemainingDoesNotOverflow(t *testing.T) {
+	supply := &ReminderSupply{Quantity: math.MaxFloat64, DosePerOccurrence: 1e-9}
+	if doses := supply.DosesRemaining(); doses != math.MaxInt32 {
+		t.Errorf("Expected %d doses, got %d", math.MaxInt32, doses)
+	}
+}
+
+func TestReminderSupply_RefillAt(t *testing.T) {
+	reminder := &Reminder{
+		RRule:   "FREQ=DAILY;BYHOUR=8;COUNT=365",
+		StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	supply := &ReminderSupply{Quantity: 30, DosePerOccurrence: 1, RefillThresholdDays: 7}
+
+	refillAt, err := supply.RefillAt(reminder, now, recurrence.DefaultLimits())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := time.Date(2024, 1, 25, 8, 0, 0, 0, time.UTC)
+	if refillAt == nil || !refillAt.Equal(expected) {
+		t.Errorf("Expected refill at %v, got %v", expected, refillAt)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
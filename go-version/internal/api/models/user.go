@@ -1,6 +1,7 @@
 package models
 
 import (
+	"strings"
 	"time"
 )
 
@@ -13,3 +14,9 @@ type User struct {
 	CreatedAt *time.Time `db:"created_at" json:"-"`
 	UpdatedAt *time.Time `db:"updated_at" json:"-"`
 }
+
+// NormalizeEmail returns the form emails are stored and compared in, so
+// that addresses differing only in case or surrounding space are the same.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
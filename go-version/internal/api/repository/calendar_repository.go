@@ -0,0 +1,350 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/ical"
+	"go-version/internal/recurrence"
+
+	"github.com/google/uuid"
+)
+
+const calendarName = "Folia reminders"
+
+type CalendarRepositoryInterface interface {
+	CreateFeed(ctx context.Context, params *domain.CalendarFeedCreateDomain) (*CalendarFeedResult, error)
+	DeleteFeed(ctx context.Context, params *domain.CalendarFeedDeleteDomain) error
+	RenderFeed(ctx context.Context, params *domain.CalendarFeedGetDomain) (*CalendarRenderResult, error)
+	ListCalendarObjects(ctx context.Context, params *domain.CalendarObjectListDomain) (*CalendarObjectListResult, error)
+	GetCalendarObject(ctx context.Context, params *domain.CalendarObjectGetDomain) (*CalendarObjectResult, error)
+	PutCalendarObject(ctx context.Context, params *domain.CalendarObjectPutDomain) (*CalendarObjectPutResult, error)
+	DeleteCalendarObject(ctx context.Context, params *domain.CalendarObjectDeleteDomain) error
+}
+
+// CalendarRepository serves reminders as calendars: read-only feeds, and a
+// CalDAV collection whose changes go through the ReminderRepository so they
+// are validated like any other.
+type CalendarRepository struct {
+	feedStore          store.CalendarFeedStoreInterface
+	reminderStore      store.ReminderStoreInterface
+	reminderRepository ReminderRepositoryInterface
+	// limits caps the recurrence rules of calendar objects put over CalDAV.
+	limits recurrence.Limits
+}
+
+func NewCalendarRepository(feedStore store.CalendarFeedStoreInterface, reminderStore store.ReminderStoreInterface, reminderRepository ReminderRepositoryInterface, limits recurrence.Limits) (*CalendarRepository, error) {
+	return &CalendarRepository{feedStore: feedStore, reminderStore: reminderStore, reminderRepository: reminderRepository, limits: limits}, nil
+}
+
+// CreateFeed issues a new feed token for the user, revoking any previous
+// one. The token is only returned here; afterwards just its hash is known.
+func (r *CalendarRepository) CreateFeed(ctx context.Context, req *domain.CalendarFeedCreateDomain) (*CalendarFeedResult, error) {
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return nil, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+
+	feed, err := r.feedStore.ReplaceFeed(ctx, &models.CalendarFeed{
+		UserId:    req.UserID,
+		TokenHash: hashFeedToken(token),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCalendarFeedResult(feed, token), nil
+}
+
+func (r *CalendarRepository) DeleteFeed(ctx context.Context, req *domain.CalendarFeedDeleteDomain) error {
+	err := r.feedStore.DeleteFeed(ctx, req.UserID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+// RenderFeed renders the reminders of the feed's owner as an iCalendar
+// document. The ETag is derived from the rendered body so it changes
+// whenever any reminder does.
+func (r *CalendarRepository) RenderFeed(ctx context.Context, req *domain.CalendarFeedGetDomain) (*CalendarRenderResult, error) {
+	feed, err := r.feedStore.GetFeedByTokenHash(ctx, hashFeedToken(req.FeedToken))
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	reminders, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{UserID: feed.UserId})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := ical.WriteCalendar(&buf, calendarName, reminders); err != nil {
+		return nil, err
+	}
+
+	return &CalendarRenderResult{
+		Body: buf.Bytes(),
+		ETag: calendarETag(buf.Bytes()),
+	}, nil
+}
+
+// ListCalendarObjects returns the user's reminders as CalDAV calendar
+// objects, along with a CTag that changes whenever any of them does.
+func (r *CalendarRepository) ListCalendarObjects(ctx context.Context, req *domain.CalendarObjectListDomain) (*CalendarObjectListResult, error) {
+	reminders, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(reminders, func(i, j int) bool {
+		return reminders[i].Id < reminders[j].Id
+	})
+
+	var wanted map[string]bool
+	if req.ReminderIDs != nil {
+		wanted = make(map[string]bool, len(req.ReminderIDs))
+		for _, id := range req.ReminderIDs {
+			wanted[id] = true
+		}
+	}
+
+	ctag := sha256.New()
+	result := &CalendarObjectListResult{Objects: []CalendarObjectResult{}}
+	for i := range reminders {
+		object, err := newCalendarObject(&reminders[i])
+		if err != nil {
+			return nil, err
+		}
+		ctag.Write([]byte(object.ReminderID + object.ETag))
+
+		if wanted != nil && !wanted[object.ReminderID] {
+			continue
+		}
+		if !occursWithin(&reminders[i], req.Start, req.End) {
+			continue
+		}
+		result.Objects = append(result.Objects, *object)
+	}
+	result.CTag = `"` + hex.EncodeToString(ctag.Sum(nil)) + `"`
+
+	return result, nil
+}
+
+func (r *CalendarRepository) GetCalendarObject(ctx context.Context, req *domain.CalendarObjectGetDomain) (*CalendarObjectResult, error) {
+	reminder, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return newCalendarObject(reminder)
+}
+
+// PutCalendarObject creates or replaces the reminder named by a CalDAV
+// resource. The resource must hold a single VEVENT whose UID is the
+// resource name, and that name must be a UUID since it becomes the
+// reminder's id. The write only succeeds if the reminder is still as it was
+// when the request's preconditions were checked against it, so that of two
+// clients replacing the same version, one fails rather than losing the
+// other's change.
+func (r *CalendarRepository) PutCalendarObject(ctx context.Context, req *domain.CalendarObjectPutDomain) (*CalendarObjectPutResult, error) {
+	entry, err := singleCalendarEvent(req.Entries)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(req.ReminderID); err != nil {
+		return nil, &ErrInvalidCalendarEntry{Reason: "resource name must be a UUID"}
+	}
+	if entry.UID != req.ReminderID {
+		return nil, &ErrInvalidCalendarEntry{Reason: "UID must match the resource name"}
+	}
+
+	reminder, err := reminderFromCalendarEntry(req.UserID, entry, r.limits)
+	if err != nil {
+		return nil, err
+	}
+
+	current, version, err := r.currentCalendarObject(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil && req.IfNoneMatch == "*" {
+		return nil, &ErrPreconditionFailed{}
+	}
+	if req.IfMatch != "" && (current == nil || !matchesETag(req.IfMatch, current.ETag)) {
+		return nil, &ErrPreconditionFailed{}
+	}
+
+	if current == nil {
+		// The id may already be taken, by a reminder created since it was
+		// looked up or by another user's reminder, which the lookup cannot
+		// see.
+		_, err := r.reminderRepository.CreateReminder(ctx, &domain.ReminderCreateDomain{
+			UserID:      req.UserID,
+			ReminderID:  req.ReminderID,
+			RRule:       reminder.RRule,
+			Description: reminder.Description,
+			StartAt:     reminder.StartAt,
+		})
+		if err != nil {
+			var existsErr *store.ReminderExistsError
+			if errors.As(err, &existsErr) {
+				if req.IfNoneMatch == "*" {
+					return nil, &ErrPreconditionFailed{}
+				}
+				return nil, &ErrCalendarObjectConflict{ReminderID: req.ReminderID}
+			}
+			return nil, err
+		}
+		return &CalendarObjectPutResult{Created: true}, nil
+	}
+
+	// Without If-Match the client replaces whatever is there, so only a
+	// conditional request is checked against the version it was evaluated
+	// against.
+	if req.IfMatch == "" {
+		version = 0
+	}
+
+	description := ""
+	if reminder.Description != nil {
+		description = *reminder.Description
+	}
+	_, err = r.reminderRepository.UpdateReminder(ctx, &domain.ReminderUpdateDomain{
+		UserID:      req.UserID,
+		ReminderID:  req.ReminderID,
+		RRule:       &reminder.RRule,
+		Description: &description,
+		StartAt:     &reminder.StartAt,
+		Version:     version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &CalendarObjectPutResult{Created: false}, nil
+}
+
+func (r *CalendarRepository) DeleteCalendarObject(ctx context.Context, req *domain.CalendarObjectDeleteDomain) error {
+	current, version, err := r.currentCalendarObject(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return &NoResourceFoundError{Err: &store.NoReminderFoundError{ID: req.ReminderID}}
+	}
+	if req.IfMatch == "" {
+		version = 0
+	} else if !matchesETag(req.IfMatch, current.ETag) {
+		return &ErrPreconditionFailed{}
+	}
+
+	return r.reminderRepository.DeleteReminder(ctx, &domain.ReminderDeleteDomain{
+		UserID:     req.UserID,
+		ReminderID: req.ReminderID,
+		Version:    version,
+	})
+}
+
+// currentCalendarObject returns the calendar object of an existing
+// reminder and the version it was rendered from, or nil if there is none.
+func (r *CalendarRepository) currentCalendarObject(ctx context.Context, userID, reminderID string) (*CalendarObjectResult, int, error) {
+	reminder, err := r.reminderStore.GetReminderByID(ctx, userID, reminderID)
+	if err != nil {
+		var notFoundErr *store.NoReminderFoundError
+		if errors.As(err, &notFoundErr) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+
+	object, err := newCalendarObject(reminder)
+	if err != nil {
+		return nil, 0, err
+	}
+	return object, reminder.Version, nil
+}
+
+func newCalendarObject(reminder *models.Reminder) (*CalendarObjectResult, error) {
+	var buf bytes.Buffer
+	if err := ical.WriteCalendar(&buf, "", []models.Reminder{*reminder}); err != nil {
+		return nil, err
+	}
+
+	return &CalendarObjectResult{
+		ReminderID: reminder.Id,
+		ETag:       calendarETag(buf.Bytes()),
+		Body:       buf.Bytes(),
+	}, nil
+}
+
+// singleCalendarEvent returns the one VEVENT a CalDAV resource may hold.
+func singleCalendarEvent(entries []domain.CalendarEntryDomain) (*domain.CalendarEntryDomain, error) {
+	var event *domain.CalendarEntryDomain
+	for i := range entries {
+		switch {
+		case entries[i].Overrides:
+			return nil, &ErrInvalidCalendarEntry{Reason: "changes to a single occurrence are not supported"}
+		case entries[i].Component != "VEVENT" || event != nil:
+			return nil, &ErrInvalidCalendarEntry{Reason: "a calendar object must contain exactly one VEVENT"}
+		}
+		event = &entries[i]
+	}
+	if event == nil {
+		return nil, &ErrInvalidCalendarEntry{Reason: "a calendar object must contain exactly one VEVENT"}
+	}
+	return event, nil
+}
+
+// occursWithin reports whether the reminder has an occurrence in
+// [start, end). Either bound may be nil to leave the range open.
+func occursWithin(reminder *models.Reminder, start, end *time.Time) bool {
+	if start == nil && end == nil {
+		return true
+	}
+
+	from := reminder.StartAt
+	if start != nil {
+		from = *start
+	}
+	// Only the first occurrence in range is needed, which spares expanding
+	// a series that never ends up to an open end.
+	next, err := reminder.NextOccurrence(from)
+	if err != nil || next == nil {
+		return false
+	}
+	return end == nil || next.Before(*end)
+}
+
+// matchesETag reports whether an If-Match header lists etag, using the
+// strong comparison RFC 9110 requires for this header.
+func matchesETag(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
+
+func calendarETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,405 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/api/store/mocks"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCalendarRepository_CreateFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	feedStore := mocks.NewMockCalendarFeedStoreInterface(ctrl)
+
+	var storedHash string
+	feedStore.EXPECT().
+		ReplaceFeed(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+			storedHash = feed.TokenHash
+			return feed, nil
+		}).
+		Times(1)
+
+	repo := &CalendarRepository{feedStore: feedStore}
+
+	result, err := repo.CreateFeed(context.Background(), &domain.CalendarFeedCreateDomain{UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if *result.Token == "" || storedHash == *result.Token {
+		t.Errorf("Expected only a hash of the token to be stored")
+	}
+	if storedHash != hashFeedToken(*result.Token) {
+		t.Errorf("Stored hash does not match the returned token")
+	}
+	if *result.URL != "/api/calendar/"+*result.Token+".ics" {
+		t.Errorf("Unexpected feed URL %s", *result.URL)
+	}
+}
+
+func TestCalendarRepository_RenderFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	description := "Take pills"
+	reminder := models.Reminder{
+		Id:          "reminder-1",
+		UserId:      "user-123",
+		RRule:       "FREQ=DAILY;COUNT=10",
+		StartAt:     time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC),
+		Description: &description,
+	}
+
+	testCases := []struct {
+		name          string
+		setupMock     func() *CalendarRepository
+		expectedError bool
+		validateError func(error) bool
+	}{
+		{
+			name: "renders the owner's reminders",
+			setupMock: func() *CalendarRepository {
+				feedStore := mocks.NewMockCalendarFeedStoreInterface(ctrl)
+				reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+
+				feedStore.EXPECT().GetFeedByTokenHash(gomock.Any(), hashFeedToken("token")).Return(&models.CalendarFeed{UserId: "user-123"}, nil).Times(2)
+				reminderStore.EXPECT().ListReminders(gomock.Any(), &store.ReminderListFilters{UserID: "user-123"}).Return([]models.Reminder{reminder}, nil).Times(2)
+
+				return &CalendarRepository{feedStore: feedStore, reminderStore: reminderStore}
+			},
+			expectedError: false,
+		},
+		{
+			name: "unknown token",
+			setupMock: func() *CalendarRepository {
+				feedStore := mocks.NewMockCalendarFeedStoreInterface(ctrl)
+				feedStore.EXPECT().GetFeedByTokenHash(gomock.Any(), hashFeedToken("token")).Return(nil, &store.NoCalendarFeedFoundError{}).Times(1)
+
+				return &CalendarRepository{feedStore: feedStore}
+			},
+			expectedError: true,
+			validateError: func(err error) bool {
+				var noResourceErr *NoResourceFoundError
+				return errors.As(err, &noResourceErr)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := tc.setupMock()
+			req := &domain.CalendarFeedGetDomain{FeedToken: "token"}
+
+			result, err := repo.RenderFeed(context.Background(), req)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if tc.validateError != nil && !tc.validateError(err) {
+					t.Errorf("Error validation failed: %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !strings.Contains(string(result.Body), "UID:reminder-1\r\n") {
+				t.Errorf("Expected the reminder in the feed, got:\n%s", result.Body)
+			}
+
+			again, err := repo.RenderFeed(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if again.ETag != result.ETag {
+				t.Errorf("Expected a stable ETag, got %s and %s", result.ETag, again.ETag)
+			}
+		})
+	}
+}
+
+func TestCalendarRepository_PutCalendarObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reminderID := "5f0c8a8e-3c1e-4f59-9a36-3f2f7b2a1c11"
+	start := time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC)
+	event := domain.CalendarEntryDomain{
+		Component: "VEVENT",
+		UID:       reminderID,
+		Summary:   "Take pills",
+		RRule:     "FREQ=DAILY;COUNT=3",
+		StartAt:   &start,
+	}
+	existing := &models.Reminder{
+		Id:      reminderID,
+		UserId:  "user-123",
+		RRule:   "FREQ=DAILY;COUNT=3",
+		StartAt: start,
+		Version: 3,
+	}
+	existingObject, _ := newCalendarObject(existing)
+
+	testCases := []struct {
+		name            string
+		entries         []domain.CalendarEntryDomain
+		ifMatch         string
+		ifNoneMatch     string
+		setupMock       func(reminderStore *mocks.MockReminderStoreInterface)
+		expectedCreated bool
+		validateError   func(error) bool
+	}{
+		{
+			name:        "creates a new reminder named by the resource",
+			entries:     []domain.CalendarEntryDomain{event},
+			ifNoneMatch: "*",
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", reminderID).Return(nil, &store.NoReminderFoundError{ID: reminderID}).Times(1)
+				reminderStore.EXPECT().
+					CreateReminder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error) {
+						if reminder.Id != reminderID || reminder.RRule != event.RRule || *reminder.Description != "Take pills" {
+							t.Errorf("Unexpected reminder %+v", reminder)
+						}
+						return reminder, nil
+					}).
+					Times(1)
+			},
+			expectedCreated: true,
+		},
+		{
+			name:    "updates an existing reminder when the ETag matches",
+			entries: []domain.CalendarEntryDomain{event},
+			ifMatch: existingObject.ETag,
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", reminderID).Return(existing, nil).Times(1)
+				reminderStore.EXPECT().GetAccessibleReminder(gomock.Any(), "user-123", reminderID, models.CaregiverPermissionManage).Return(existing, nil).Times(1)
+				reminderStore.EXPECT().
+					UpdateReminder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error) {
+						if reminder.Version != existing.Version {
+							t.Errorf("Expected the update to be conditional on version %d, got %d", existing.Version, reminder.Version)
+						}
+						return existing, nil
+					}).
+					Times(1)
+			},
+			expectedCreated: false,
+		},
+		{
+			name:    "replaces an existing reminder unconditionally without If-Match",
+			entries: []domain.CalendarEntryDomain{event},
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", reminderID).Return(existing, nil).Times(1)
+				reminderStore.EXPECT().GetAccessibleReminder(gomock.Any(), "user-123", reminderID, models.CaregiverPermissionManage).Return(existing, nil).Times(1)
+				reminderStore.EXPECT().
+					UpdateReminder(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error) {
+						if reminder.Version != 0 {
+							t.Errorf("Expected an unconditional update, got version %d", reminder.Version)
+						}
+						return existing, nil
+					}).
+					Times(1)
+			},
+			expectedCreated: false,
+		},
+		{
+			name:    "rejects a matching ETag when the reminder changes before the write",
+			entries: []domain.CalendarEntryDomain{event},
+			ifMatch: existingObject.ETag,
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", reminderID).Return(existing, nil).Times(1)
+				reminderStore.EXPECT().GetAccessibleReminder(gomock.Any(), "user-123", reminderID, models.CaregiverPermissionManage).Return(existing, nil).Times(1)
+				reminderStore.EXPECT().UpdateReminder(gomock.Any(), gomock.Any()).Return(nil, &store.ReminderChangedError{ID: reminderID}).Times(1)
+			},
+			validateError: func(err error) bool {
+				var preconditionErr *ErrPreconditionFailed
+				return errors.As(err, &preconditionErr)
+			},
+		},
+		{
+			name:    "reports a conflict when another user's reminder has the id",
+			entries: []domain.CalendarEntryDomain{event},
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", reminderID).Return(nil, &store.NoReminderFoundError{ID: reminderID}).Times(1)
+				reminderStore.EXPECT().CreateReminder(gomock.Any(), gomock.Any()).Return(nil, &store.ReminderExistsError{ID: reminderID}).Times(1)
+			},
+			validateError: func(err error) bool {
+				var conflictErr *ErrCalendarObjectConflict
+				return errors.As(err, &conflictErr)
+			},
+		},
+		{
+			name:        "rejects creating when another request created the reminder first",
+			entries:     []domain.CalendarEntryDomain{event},
+			ifNoneMatch: "*",
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", reminderID).Return(nil, &store.NoReminderFoundError{ID: reminderID}).Times(1)
+				reminderStore.EXPECT().CreateReminder(gomock.Any(), gomock.Any()).Return(nil, &store.ReminderExistsError{ID: reminderID}).Times(1)
+			},
+			validateError: func(err error) bool {
+				var preconditionErr *ErrPreconditionFailed
+				return errors.As(err, &preconditionErr)
+			},
+		},
+		{
+			name:    "rejects a stale ETag",
+			entries: []domain.CalendarEntryDomain{event},
+			ifMatch: `"stale"`,
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", reminderID).Return(existing, nil).Times(1)
+			},
+			validateError: func(err error) bool {
+				var preconditionErr *ErrPreconditionFailed
+				return errors.As(err, &preconditionErr)
+			},
+		},
+		{
+			name:        "rejects creating over an existing reminder",
+			entries:     []domain.CalendarEntryDomain{event},
+			ifNoneMatch: "*",
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", reminderID).Return(existing, nil).Times(1)
+			},
+			validateError: func(err error) bool {
+				var preconditionErr *ErrPreconditionFailed
+				return errors.As(err, &preconditionErr)
+			},
+		},
+		{
+			name: "rejects a UID that differs from the resource name",
+			entries: []domain.CalendarEntryDomain{
+				{Component: "VEVENT", UID: "other", RRule: event.RRule, StartAt: &start},
+			},
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+			},
+			validateError: func(err error) bool {
+				var invalidErr *ErrInvalidCalendarEntry
+				return errors.As(err, &invalidErr)
+			},
+		},
+		{
+			name:    "rejects overridden occurrences",
+			entries: []domain.CalendarEntryDomain{event, {Component: "VEVENT", UID: reminderID, Overrides: true, StartAt: &start}},
+			setupMock: func(reminderStore *mocks.MockReminderStoreInterface) {
+			},
+			validateError: func(err error) bool {
+				var invalidErr *ErrInvalidCalendarEntry
+				return errors.As(err, &invalidErr)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+			tc.setupMock(reminderStore)
+
+			repo := &CalendarRepository{
+				reminderStore:      reminderStore,
+				reminderRepository: &ReminderRepository{reminderStore: reminderStore},
+			}
+
+			result, err := repo.PutCalendarObject(context.Background(), &domain.CalendarObjectPutDomain{
+				UserID:      "user-123",
+				ReminderID:  reminderID,
+				Entries:     tc.entries,
+				IfMatch:     tc.ifMatch,
+				IfNoneMatch: tc.ifNoneMatch,
+			})
+
+			if tc.validateError != nil {
+				if err == nil || !tc.validateError(err) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Created != tc.expectedCreated {
+				t.Errorf("Expected created %v, got %v", tc.expectedCreated, result.Created)
+			}
+		})
+	}
+}
+
+func TestCalendarRepository_ListCalendarObjects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reminders := []models.Reminder{
+		{Id: "b", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=3", StartAt: time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC)},
+		{Id: "a", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=3", StartAt: time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	rangeStart := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
+	rangeEnd := time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		request     *domain.CalendarObjectListDomain
+		expectedIDs []string
+	}{
+		{
+			name:        "lists every reminder in a stable order",
+			request:     &domain.CalendarObjectListDomain{UserID: "user-123"},
+			expectedIDs: []string{"a", "b"},
+		},
+		{
+			name:        "filters by time range",
+			request:     &domain.CalendarObjectListDomain{UserID: "user-123", Start: &rangeStart, End: &rangeEnd},
+			expectedIDs: []string{"b"},
+		},
+		{
+			name:        "filters by id",
+			request:     &domain.CalendarObjectListDomain{UserID: "user-123", ReminderIDs: []string{"a", "missing"}},
+			expectedIDs: []string{"a"},
+		},
+	}
+
+	var ctag string
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+			reminderStore.EXPECT().
+				ListReminders(gomock.Any(), &store.ReminderListFilters{UserID: "user-123"}).
+				Return(append([]models.Reminder(nil), reminders...), nil).
+				Times(1)
+
+			repo := &CalendarRepository{reminderStore: reminderStore}
+
+			result, err := repo.ListCalendarObjects(context.Background(), tc.request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var ids []string
+			for _, object := range result.Objects {
+				ids = append(ids, object.ReminderID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tc.expectedIDs, ",") {
+				t.Errorf("Expected %v, got %v", tc.expectedIDs, ids)
+			}
+
+			// Filters do not change the collection's CTag.
+			if ctag != "" && result.CTag != ctag {
+				t.Errorf("Expected CTag %s, got %s", ctag, result.CTag)
+			}
+			ctag = result.CTag
+		})
+	}
+}
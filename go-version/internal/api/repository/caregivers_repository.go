@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+
+	"github.com/google/uuid"
+)
+
+type CaregiverRepositoryInterface interface {
+	InviteCaregiver(ctx context.Context, params *domain.CaregiverInviteDomain) (*CaregiverGrantResult, error)
+	ListCaregivers(ctx context.Context, params *domain.CaregiverListDomain) (*CaregiverListResult, error)
+	RevokeCaregiver(ctx context.Context, params *domain.CaregiverRevokeDomain) error
+	ListActivity(ctx context.Context, params *domain.CaregiverActivityListDomain) (*CaregiverActivityResult, error)
+	ListInvitations(ctx context.Context, params *domain.CaregiverInvitationListDomain) (*CaregiverInvitationListResult, error)
+	AcceptInvitation(ctx context.Context, params *domain.CaregiverInvitationAcceptDomain) (*CaregiverGrantResult, error)
+	DeclineInvitation(ctx context.Context, params *domain.CaregiverInvitationDeclineDomain) error
+}
+
+type CaregiverRepository struct {
+	caregiverStore store.CaregiverStoreInterface
+	reminderStore  store.ReminderStoreInterface
+	userStore      store.UserStoreInterface
+}
+
+func NewCaregiverRepository(caregiverStore store.CaregiverStoreInterface, reminderStore store.ReminderStoreInterface, userStore store.UserStoreInterface) (*CaregiverRepository, error) {
+	return &CaregiverRepository{
+		caregiverStore: caregiverStore,
+		reminderStore:  reminderStore,
+		userStore:      userStore,
+	}, nil
+}
+
+// InviteCaregiver invites the email to care for the user's reminders.
+// Inviting an email again changes what its grant covers.
+func (r *CaregiverRepository) InviteCaregiver(ctx context.Context, req *domain.CaregiverInviteDomain) (*CaregiverGrantResult, error) {
+	owner, err := r.userStore.GetUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if userEmail(owner) == req.Email {
+		return nil, &ErrInvalidCaregiverInvitation{Reason: "you cannot invite yourself"}
+	}
+
+	var reminderIDs []string
+	if req.ReminderIDs != nil {
+		reminderIDs = slices.Clone(req.ReminderIDs)
+		slices.Sort(reminderIDs)
+		reminderIDs = slices.Compact(reminderIDs)
+		for _, reminderID := range reminderIDs {
+			if _, err := r.reminderStore.GetReminderByID(ctx, req.UserID, reminderID); err != nil {
+				return nil, &NoResourceFoundError{Err: err}
+			}
+		}
+	}
+
+	grant, err := r.caregiverStore.UpsertGrant(ctx, &models.CaregiverGrant{
+		Id:           uuid.New().String(),
+		OwnerId:      req.UserID,
+		Email:        req.Email,
+		Permission:   req.Permission,
+		AllReminders: reminderIDs == nil,
+		ReminderIds:  reminderIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCaregiverGrantResult(grant), nil
+}
+
+func (r *CaregiverRepository) ListCaregivers(ctx context.Context, req *domain.CaregiverListDomain) (*CaregiverListResult, error) {
+	grants, err := r.caregiverStore.ListGrantsByOwner(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCaregiverListResult(grants), nil
+}
+
+func (r *CaregiverRepository) RevokeCaregiver(ctx context.Context, req *domain.CaregiverRevokeDomain) error {
+	err := r.caregiverStore.DeleteGrant(ctx, req.UserID, req.GrantID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+func (r *CaregiverRepository) ListActivity(ctx context.Context, req *domain.CaregiverActivityListDomain) (*CaregiverActivityResult, error) {
+	actions, err := r.caregiverStore.ListActions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCaregiverActivityResult(actions), nil
+}
+
+// ListInvitations returns the invitations sent to the user's email and the
+// grants they have accepted.
+func (r *CaregiverRepository) ListInvitations(ctx context.Context, req *domain.CaregiverInvitationListDomain) (*CaregiverInvitationListResult, error) {
+	user, err := r.userStore.GetUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	grants, err := r.caregiverStore.ListGrantsForCaregiver(ctx, req.UserID, userEmail(user))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCaregiverInvitationListResult(grants), nil
+}
+
+func (r *CaregiverRepository) AcceptInvitation(ctx context.Context, req *domain.CaregiverInvitationAcceptDomain) (*CaregiverGrantResult, error) {
+	user, err := r.userStore.GetUser(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	grant, err := r.caregiverStore.AcceptGrant(ctx, req.GrantID, req.UserID, userEmail(user))
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewCaregiverGrantResult(grant), nil
+}
+
+func (r *CaregiverRepository) DeclineInvitation(ctx context.Context, req *domain.CaregiverInvitationDeclineDomain) error {
+	user, err := r.userStore.GetUser(ctx, req.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = r.caregiverStore.DeleteGrantForCaregiver(ctx, req.UserID, userEmail(user), req.GrantID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+// userEmail returns the user's email the way invitations store it.
+func userEmail(user *models.User) string {
+	return models.NormalizeEmail(user.Email)
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/api/store/mocks"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestCaregiverRepository_InviteCaregiver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	owner := &models.User{Id: "owner-1", Email: "Owner@Example.com"}
+
+	testCases := []struct {
+		name          string
+		request       *domain.CaregiverInviteDomain
+		setupMock     func(caregiverStore *mocks.MockCaregiverStoreInterface, reminderStore *mocks.MockReminderStoreInterface)
+		validateError func(error) bool
+	}{
+		{
+			name: "shares every reminder",
+			request: &domain.CaregiverInviteDomain{
+				UserID:     "owner-1",
+				Email:      "carer@example.com",
+				Permission: models.CaregiverPermissionView,
+			},
+			setupMock: func(caregiverStore *mocks.MockCaregiverStoreInterface, reminderStore *mocks.MockReminderStoreInterface) {
+				caregiverStore.EXPECT().
+					UpsertGrant(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, grant *models.CaregiverGrant) (*models.CaregiverGrant, error) {
+						if !grant.AllReminders || grant.ReminderIds != nil {
+							t.Errorf("Expected a grant over every reminder, got %+v", grant)
+						}
+						return grant, nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "shares the selected reminders once each",
+			request: &domain.CaregiverInviteDomain{
+				UserID:      "owner-1",
+				Email:       "carer@example.com",
+				Permission:  models.CaregiverPermissionManage,
+				ReminderIDs: []string{"reminder-2", "reminder-1", "reminder-2"},
+			},
+			setupMock: func(caregiverStore *mocks.MockCaregiverStoreInterface, reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "owner-1", "reminder-1").Return(&models.Reminder{Id: "reminder-1"}, nil).Times(1)
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "owner-1", "reminder-2").Return(&models.Reminder{Id: "reminder-2"}, nil).Times(1)
+				caregiverStore.EXPECT().
+					UpsertGrant(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, grant *models.CaregiverGrant) (*models.CaregiverGrant, error) {
+						if grant.AllReminders || !reflect.DeepEqual(grant.ReminderIds, []string{"reminder-1", "reminder-2"}) {
+							t.Errorf("Expected a grant over reminder-1 and reminder-2, got %+v", grant)
+						}
+						return grant, nil
+					}).
+					Times(1)
+			},
+		},
+		{
+			name: "rejects a reminder the user does not own",
+			request: &domain.CaregiverInviteDomain{
+				UserID:      "owner-1",
+				Email:       "carer@example.com",
+				Permission:  models.CaregiverPermissionView,
+				ReminderIDs: []string{"reminder-9"},
+			},
+			setupMock: func(caregiverStore *mocks.MockCaregiverStoreInterface, reminderStore *mocks.MockReminderStoreInterface) {
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "owner-1", "reminder-9").Return(nil, &store.NoReminderFoundError{ID: "reminder-9"}).Times(1)
+			},
+			validateError: func(err error) bool {
+				var notFoundErr *NoResourceFoundError
+				return errors.As(err, &notFoundErr)
+			},
+		},
+		{
+			name: "rejects inviting yourself",
+			request: &domain.CaregiverInviteDomain{
+				UserID:     "owner-1",
+				Email:      "owner@example.com",
+				Permission: models.CaregiverPermissionView,
+			},
+			setupMock: func(caregiverStore *mocks.MockCaregiverStoreInterface, reminderStore *mocks.MockReminderStoreInterface) {
+			},
+			validateError: func(err error) bool {
+				var invalidErr *ErrInvalidCaregiverInvitation
+				return errors.As(err, &invalidErr)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			caregiverStore := mocks.NewMockCaregiverStoreInterface(ctrl)
+			reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+			userStore := mocks.NewMockUserStoreInterface(ctrl)
+			userStore.EXPECT().GetUser(gomock.Any(), "owner-1").Return(owner, nil).Times(1)
+			tc.setupMock(caregiverStore, reminderStore)
+
+			repo := &CaregiverRepository{caregiverStore: caregiverStore, reminderStore: reminderStore, userStore: userStore}
+
+			result, err := repo.InviteCaregiver(context.Background(), tc.request)
+			if tc.validateError != nil {
+				if err == nil || !tc.validateError(err) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if *result.Email != tc.request.Email || *result.Permission != tc.request.Permission {
+				t.Errorf("Expected the grant to be returned, got %+v", result)
+			}
+		})
+	}
+}
+
+func TestCaregiverRepository_AcceptInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	caregiverStore := mocks.NewMockCaregiverStoreInterface(ctrl)
+	userStore := mocks.NewMockUserStoreInterface(ctrl)
+	userStore.EXPECT().GetUser(gomock.Any(), "caregiver-1").Return(&models.User{Id: "caregiver-1", Email: " Carer@Example.com"}, nil).Times(2)
+	caregiverStore.EXPECT().
+		AcceptGrant(gomock.Any(), "grant-1", "caregiver-1", "carer@example.com").
+		Return(&models.CaregiverGrant{Id: "grant-1", Status: models.CaregiverGrantAccepted}, nil).
+		Times(1)
+	caregiverStore.EXPECT().
+		AcceptGrant(gomock.Any(), "grant-2", "caregiver-1", "carer@example.com").
+		Return(nil, &store.NoCaregiverGrantFoundError{ID: "grant-2"}).
+		Times(1)
+
+	repo := &CaregiverRepository{caregiverStore: caregiverStore, userStore: userStore}
+
+	result, err := repo.AcceptInvitation(context.Background(), &domain.CaregiverInvitationAcceptDomain{UserID: "caregiver-1", GrantID: "grant-1"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if *result.Status != models.CaregiverGrantAccepted {
+		t.Errorf("Expected the grant to be accepted, got %s", *result.Status)
+	}
+
+	_, err = repo.AcceptInvitation(context.Background(), &domain.CaregiverInvitationAcceptDomain{UserID: "caregiver-1", GrantID: "grant-2"})
+	var notFoundErr *NoResourceFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("Expected NoResourceFoundError for an invitation sent to someone else, got %v", err)
+	}
+}
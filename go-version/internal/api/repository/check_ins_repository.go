@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+
+	"github.com/google/uuid"
+)
+
+type CheckInRepositoryInterface interface {
+	GetQuestionnaire(ctx context.Context, params *domain.CheckInQuestionnaireGetDomain) (*CheckInQuestionnaireResult, error)
+	SetQuestionnaire(ctx context.Context, params *domain.CheckInQuestionnaireSetDomain) (*CheckInQuestionnaireResult, error)
+	DeleteQuestionnaire(ctx context.Context, params *domain.CheckInQuestionnaireDeleteDomain) error
+	SubmitResponse(ctx context.Context, params *domain.CheckInResponseSubmitDomain) (*CheckInResponseResult, error)
+	ListResponses(ctx context.Context, params *domain.CheckInResponseListDomain) (*CheckInResponseListResult, error)
+}
+
+type CheckInRepository struct {
+	checkInStore  store.CheckInStoreInterface
+	reminderStore store.ReminderStoreInterface
+}
+
+func NewCheckInRepository(checkInStore store.CheckInStoreInterface, reminderStore store.ReminderStoreInterface) (*CheckInRepository, error) {
+	return &CheckInRepository{
+		checkInStore:  checkInStore,
+		reminderStore: reminderStore,
+	}, nil
+}
+
+func (r *CheckInRepository) GetQuestionnaire(ctx context.Context, req *domain.CheckInQuestionnaireGetDomain) (*CheckInQuestionnaireResult, error) {
+	questionnaire, err := r.checkInStore.GetQuestionnaire(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewCheckInQuestionnaireResult(questionnaire), nil
+}
+
+func (r *CheckInRepository) SetQuestionnaire(ctx context.Context, req *domain.CheckInQuestionnaireSetDomain) (*CheckInQuestionnaireResult, error) {
+	if _, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID); err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	questions := make([]models.CheckInQuestion, len(req.Questions))
+	for i, question := range req.Questions {
+		questions[i] = models.CheckInQuestion{
+			Position: i + 1,
+			Key:      question.Key,
+			Type:     question.Type,
+			Prompt:   question.Prompt,
+			Required: question.Required,
+			Min:      question.Min,
+			Max:      question.Max,
+			Unit:     question.Unit,
+		}
+	}
+
+	questionnaire, err := r.checkInStore.ReplaceQuestionnaire(ctx, &models.CheckInQuestionnaire{
+		Id:         uuid.New().String(),
+		ReminderId: req.ReminderID,
+		UserId:     req.UserID,
+		Questions:  questions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCheckInQuestionnaireResult(questionnaire), nil
+}
+
+func (r *CheckInRepository) DeleteQuestionnaire(ctx context.Context, req *domain.CheckInQuestionnaireDeleteDomain) error {
+	err := r.checkInStore.DeleteQuestionnaire(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+// SubmitResponse checks the answers against the reminder's questionnaire
+// and saves them for the occurrence.
+func (r *CheckInRepository) SubmitResponse(ctx context.Context, req *domain.CheckInResponseSubmitDomain) (*CheckInResponseResult, error) {
+	reminder, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	questionnaire, err := r.checkInStore.GetQuestionnaire(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	if !isReminderOccurrence(reminder, req.OccurrenceAt) {
+		return nil, &ErrInvalidOccurrence{OccurrenceAt: req.OccurrenceAt}
+	}
+
+	answers, err := checkInAnswers(questionnaire, req.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.checkInStore.ReplaceResponse(ctx, &models.CheckInResponse{
+		Id:           uuid.New().String(),
+		ReminderId:   reminder.Id,
+		UserId:       req.UserID,
+		OccurrenceAt: req.OccurrenceAt,
+		Answers:      answers,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCheckInResponseResult(response), nil
+}
+
+func (r *CheckInRepository) ListResponses(ctx context.Context, req *domain.CheckInResponseListDomain) (*CheckInResponseListResult, error) {
+	if _, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID); err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	responses, err := r.checkInStore.ListResponses(ctx, &store.CheckInResponseListFilters{
+		UserID:     req.UserID,
+		ReminderID: req.ReminderID,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCheckInResponseListResult(responses), nil
+}
+
+// checkInAnswers checks every answer against its question and returns the
+// given answers in question order.
+func checkInAnswers(questionnaire *models.CheckInQuestionnaire, values map[string]any) ([]models.CheckInAnswer, error) {
+	var reasons []string
+
+	unknown := []string{}
+	for key := range values {
+		if questionnaire.Question(key) == nil {
+			unknown = append(unknown, key)
+		}
+	}
+	slices.Sort(unknown)
+	for _, key := range unknown {
+		reasons = append(reasons, key+" is not a question")
+	}
+
+	answers := []models.CheckInAnswer{}
+	for _, question := range questionnaire.Questions {
+		value := values[question.Key]
+		if err := question.CheckAnswer(value); err != nil {
+			reasons = append(reasons, err.Error())
+			continue
+		}
+		if value != nil {
+			answers = append(answers, models.CheckInAnswer{QuestionKey: question.Key, Value: value})
+		}
+	}
+
+	if len(reasons) > 0 {
+		return nil, &ErrInvalidCheckInAnswers{Reasons: reasons}
+	}
+	return answers, nil
+}
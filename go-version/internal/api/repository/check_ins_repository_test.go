@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store/mocks"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newCheckInQuestionnaire() *models.CheckInQuestionnaire {
+	return &models.CheckInQuestionnaire{
+		Id:         "questionnaire-1",
+		ReminderId: "reminder-1",
+		UserId:     "user-123",
+		Questions: []models.CheckInQuestion{
+			{Position: 1, Key: "pain", Type: models.CheckInQuestionScale, Prompt: "Pain?", Required: true},
+			{Position: 2, Key: "slept", Type: models.CheckInQuestionYesNo, Prompt: "Slept well?"},
+		},
+	}
+}
+
+func TestCheckInRepository_SubmitResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reminder := &models.Reminder{
+		Id:      "reminder-1",
+		UserId:  "user-123",
+		RRule:   "FREQ=DAILY;COUNT=10",
+		StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+	}
+	occurrenceAt := time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC)
+
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(reminder, nil).Times(1)
+
+	checkInStore := mocks.NewMockCheckInStoreInterface(ctrl)
+	checkInStore.EXPECT().GetQuestionnaire(gomock.Any(), "user-123", "reminder-1").Return(newCheckInQuestionnaire(), nil).Times(1)
+	checkInStore.EXPECT().
+		ReplaceResponse(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, response *models.CheckInResponse) (*models.CheckInResponse, error) {
+			expected := []models.CheckInAnswer{{QuestionKey: "pain", Value: 4.0}}
+			if !reflect.DeepEqual(response.Answers, expected) {
+				t.Errorf("Expected answers %v, got %v", expected, response.Answers)
+			}
+			if !response.OccurrenceAt.Equal(occurrenceAt) {
+				t.Errorf("Expected occurrence %v, got %v", occurrenceAt, response.OccurrenceAt)
+			}
+			return response, nil
+		}).
+		Times(1)
+
+	repo := &CheckInRepository{checkInStore: checkInStore, reminderStore: reminderStore}
+
+	_, err := repo.SubmitResponse(context.Background(), &domain.CheckInResponseSubmitDomain{
+		UserID:       "user-123",
+		ReminderID:   "reminder-1",
+		OccurrenceAt: occurrenceAt,
+		Answers:      map[string]any{"pain": 4.0, "slept": nil},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestCheckInRepository_SubmitResponseInvalidAnswers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reminder := &models.Reminder{
+		Id:      "reminder-1",
+		UserId:  "user-123",
+		RRule:   "FREQ=DAILY;COUNT=10",
+		StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC),
+	}
+
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(reminder, nil).Times(1)
+
+	checkInStore := mocks.NewMockCheckInStoreInterface(ctrl)
+	checkInStore.EXPECT().GetQuestionnaire(gomock.Any(), "user-123", "reminder-1").Return(newCheckInQuestionnaire(), nil).Times(1)
+	checkInStore.EXPECT().ReplaceResponse(gomock.Any(), gomock.Any()).Times(0)
+
+	repo := &CheckInRepository{checkInStore: checkInStore, reminderStore: reminderStore}
+
+	_, err := repo.SubmitResponse(context.Background(), &domain.CheckInResponseSubmitDomain{
+		UserID:       "user-123",
+		ReminderID:   "reminder-1",
+		OccurrenceAt: time.Date(2024, 1, 2, 8, 0, 0, 0, time.UTC),
+		Answers:      map[string]any{"slept": "yes", "mood": 3.0},
+	})
+
+	var invalidErr *ErrInvalidCheckInAnswers
+	if !errors.As(err, &invalidErr) {
+		t.Fatalf("Expected ErrInvalidCheckInAnswers, got %v", err)
+	}
+	expected := []string{"mood is not a question", "pain is required", "slept must be true or false"}
+	if !reflect.DeepEqual(invalidErr.Reasons, expected) {
+		t.Errorf("Expected reasons %v, got %v", expected, invalidErr.Reasons)
+	}
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+
+	"github.com/google/uuid"
+)
+
+type ContactRepositoryInterface interface {
+	ListContacts(ctx context.Context, params *domain.ContactListDomain) (*ContactListResult, error)
+	CreateContact(ctx context.Context, params *domain.ContactCreateDomain) (*ContactCreateResult, error)
+	DeleteContact(ctx context.Context, params *domain.ContactDeleteDomain) error
+}
+
+type ContactRepository struct {
+	store store.ContactStoreInterface
+}
+
+func NewContactRepository(store store.ContactStoreInterface) (*ContactRepository, error) {
+	return &ContactRepository{store: store}, nil
+}
+
+func (r *ContactRepository) ListContacts(ctx context.Context, req *domain.ContactListDomain) (*ContactListResult, error) {
+	contacts, err := r.store.ListContacts(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewContactListResult(contacts), nil
+}
+
+func (r *ContactRepository) CreateContact(ctx context.Context, req *domain.ContactCreateDomain) (*ContactCreateResult, error) {
+	newContact := &models.Contact{
+		Id:     uuid.New().String(),
+		UserId: req.UserID,
+		Name:   req.Name,
+		Email:  req.Email,
+	}
+
+	createdContact, err := r.store.CreateContact(ctx, newContact)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewContactCreateResult(createdContact), nil
+}
+
+func (r *ContactRepository) DeleteContact(ctx context.Context, req *domain.ContactDeleteDomain) error {
+	err := r.store.DeleteContact(ctx, req.UserID, req.ContactID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
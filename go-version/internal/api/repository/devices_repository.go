@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/notify"
+	"go-version/internal/push"
+
+	"github.com/google/uuid"
+)
+
+type DeviceRepositoryInterface interface {
+	ListDevices(ctx context.Context, params *domain.DeviceListDomain) (*DeviceListResult, error)
+	RegisterDevice(ctx context.Context, params *domain.DeviceCreateDomain) (*DeviceCreateResult, error)
+	DeleteDevice(ctx context.Context, params *domain.DeviceDeleteDomain) error
+	Send(ctx context.Context, msg *notify.Message) error
+}
+
+type DeviceRepository struct {
+	store     store.DeviceStoreInterface
+	providers map[string]push.PushProvider
+}
+
+func NewDeviceRepository(store store.DeviceStoreInterface, providers ...push.PushProvider) (*DeviceRepository, error) {
+	providersMap := make(map[string]push.PushProvider)
+	for _, provider := range providers {
+		providersMap[provider.Platform()] = provider
+	}
+	return &DeviceRepository{store: store, providers: providersMap}, nil
+}
+
+func (r *DeviceRepository) ListDevices(ctx context.Context, req *domain.DeviceListDomain) (*DeviceListResult, error) {
+	devices, err := r.store.ListDevices(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDeviceListResult(devices), nil
+}
+
+func (r *DeviceRepository) RegisterDevice(ctx context.Context, req *domain.DeviceCreateDomain) (*DeviceCreateResult, error) {
+	newDevice := &models.Device{
+		Id:         uuid.New().String(),
+		UserId:     req.UserID,
+		Platform:   req.Platform,
+		Token:      req.Token,
+		AppVersion: req.AppVersion,
+	}
+
+	createdDevice, err := r.store.UpsertDevice(ctx, newDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDeviceCreateResult(createdDevice), nil
+}
+
+func (r *DeviceRepository) DeleteDevice(ctx context.Context, req *domain.DeviceDeleteDomain) error {
+	err := r.store.DeleteDevice(ctx, req.UserID, req.DeviceID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+// Send delivers the message to each of the user's registered devices through
+// the provider for its platform. Devices on platforms without a configured
+// provider are skipped, and tokens the provider rejects as invalid are removed.
+func (r *DeviceRepository) Send(ctx context.Context, msg *notify.Message) error {
+	devices, err := r.store.ListDevices(ctx, msg.UserID)
+	if err != nil {
+		return err
+	}
+
+	notification := &push.Notification{
+		Title: msg.Title,
+		Body:  msg.Body,
+		Data: map[string]string{
+			"reminder_id":   msg.ReminderID,
+			"occurrence_at": msg.OccurrenceAt.Format(time.RFC3339),
+		},
+	}
+
+	var errs []error
+	for _, device := range devices {
+		provider, ok := r.providers[device.Platform]
+		if !ok {
+			continue
+		}
+
+		err := provider.Send(ctx, device.Token, notification)
+
+		var invalidTokenErr *push.ErrInvalidToken
+		if errors.As(err, &invalidTokenErr) {
+			if err := r.store.DeleteDeviceByToken(ctx, device.Platform, device.Token); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("device %s: %w", device.Id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
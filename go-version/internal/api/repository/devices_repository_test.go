@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store/mocks"
+	"go-version/internal/api/utils"
+	"go-version/internal/notify"
+	"go-version/internal/push"
+
+	"go.uber.org/mock/gomock"
+)
+
+type fakePushProvider struct {
+	platform string
+	sent     []string
+	errs     map[string]error
+}
+
+func (p *fakePushProvider) Platform() string {
+	return p.platform
+}
+
+func (p *fakePushProvider) Send(ctx context.Context, token string, notification *push.Notification) error {
+	p.sent = append(p.sent, token)
+	return p.errs[token]
+}
+
+func TestDeviceRepository_RegisterDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockDeviceStoreInterface(ctrl)
+
+	testCases := []struct {
+		name          string
+		request       *domain.DeviceCreateDomain
+		setupMock     func()
+		expectedError bool
+	}{
+		{
+			name: "successful registration",
+			request: &domain.DeviceCreateDomain{
+				UserID:     "user-123",
+				Platform:   push.PlatformIOS,
+				Token:      "apns-token",
+				AppVersion: utils.StringPtr("2.4.0"),
+			},
+			setupMock: func() {
+				mockStore.EXPECT().
+					UpsertDevice(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, device *models.Device) (*models.Device, error) {
+						if device.UserId != "user-123" || device.Token != "apns-token" {
+							t.Errorf("Unexpected device %+v", device)
+						}
+						if device.Id == "" {
+							t.Error("Expected generated UUID for ID")
+						}
+						return device, nil
+					}).
+					Times(1)
+			},
+			expectedError: false,
+		},
+		{
+			name: "store error",
+			request: &domain.DeviceCreateDomain{
+				UserID:   "user-123",
+				Platform: push.PlatformAndroid,
+				Token:    "fcm-token",
+			},
+			setupMock: func() {
+				mockStore.EXPECT().
+					UpsertDevice(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database error")).
+					Times(1)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMock()
+
+			repo, _ := NewDeviceRepository(mockStore)
+
+			result, err := repo.RegisterDevice(context.Background(), tc.request)
+
+			if tc.expectedError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+				}
+				if result != nil {
+					t.Errorf("Expected nil result but got: %v", result)
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				if result == nil {
+					t.Errorf("Expected result but got nil")
+				}
+			}
+		})
+	}
+}
+
+func TestDeviceRepository_Send(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockDeviceStoreInterface(ctrl)
+
+	ios := &fakePushProvider{
+		platform: push.PlatformIOS,
+		errs: map[string]error{
+			"stale-token": &push.ErrInvalidToken{Platform: push.PlatformIOS, Reason: "Unregistered"},
+		},
+	}
+
+	mockStore.EXPECT().
+		ListDevices(gomock.Any(), "user-123").
+		Return([]models.Device{
+			{Id: "device-1", UserId: "user-123", Platform: push.PlatformIOS, Token: "live-token"},
+			{Id: "device-2", UserId: "user-123", Platform: push.PlatformIOS, Token: "stale-token"},
+			{Id: "device-3", UserId: "user-123", Platform: push.PlatformAndroid, Token: "no-provider"},
+		}, nil).
+		Times(1)
+	mockStore.EXPECT().
+		DeleteDeviceByToken(gomock.Any(), push.PlatformIOS, "stale-token").
+		Return(nil).
+		Times(1)
+
+	repo, _ := NewDeviceRepository(mockStore, ios)
+
+	err := repo.Send(context.Background(), &notify.Message{
+		UserID:       "user-123",
+		ReminderID:   "reminder-1",
+		Title:        "Reminder",
+		Body:         "Take medication",
+		OccurrenceAt: time.Now(),
+	})
+	if err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if len(ios.sent) != 2 {
+		t.Errorf("Expected 2 ios sends, got %d", len(ios.sent))
+	}
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+)
+
+type DigestSubscriptionRepositoryInterface interface {
+	GetDigestSubscription(ctx context.Context, params *domain.DigestSubscriptionGetDomain) (*DigestSubscriptionResult, error)
+	SetDigestSubscription(ctx context.Context, params *domain.DigestSubscriptionSetDomain) (*DigestSubscriptionResult, error)
+	DeleteDigestSubscription(ctx context.Context, params *domain.DigestSubscriptionDeleteDomain) error
+}
+
+type DigestSubscriptionRepository struct {
+	store store.DigestSubscriptionStoreInterface
+}
+
+func NewDigestSubscriptionRepository(store store.DigestSubscriptionStoreInterface) (*DigestSubscriptionRepository, error) {
+	return &DigestSubscriptionRepository{store: store}, nil
+}
+
+func (r *DigestSubscriptionRepository) GetDigestSubscription(ctx context.Context, req *domain.DigestSubscriptionGetDomain) (*DigestSubscriptionResult, error) {
+	subscription, err := r.store.GetDigestSubscription(ctx, req.UserID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewDigestSubscriptionResult(subscription), nil
+}
+
+func (r *DigestSubscriptionRepository) SetDigestSubscription(ctx context.Context, req *domain.DigestSubscriptionSetDomain) (*DigestSubscriptionResult, error) {
+	subscription, err := r.store.UpsertDigestSubscription(ctx, &models.DigestSubscription{
+		UserId:    req.UserID,
+		Frequency: req.Frequency,
+		SendTime:  req.SendTime,
+		Weekday:   req.Weekday,
+		TimeZone:  req.TimeZone,
+		Channel:   req.Channel,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDigestSubscriptionResult(subscription), nil
+}
+
+func (r *DigestSubscriptionRepository) DeleteDigestSubscription(ctx context.Context, req *domain.DigestSubscriptionDeleteDomain) error {
+	err := r.store.DeleteDigestSubscription(ctx, req.UserID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
@@ -1,5 +1,10 @@
 package repository
 
+import (
+	"strings"
+	"time"
+)
+
 type NoResourceFoundError struct {
 	Err error
 }
@@ -15,3 +20,89 @@ type ErrInvalidRRule struct {
 func (e *ErrInvalidRRule) Error() string {
 	return "rrule is invalid: " + e.Err.Error()
 }
+
+type ErrInvalidCursor struct {
+	Reason string
+}
+
+func (e *ErrInvalidCursor) Error() string {
+	return "cursor is invalid: " + e.Reason
+}
+
+type ErrPushNotConfigured struct{}
+
+func (e *ErrPushNotConfigured) Error() string {
+	return "web push is not configured"
+}
+
+type ErrInvalidContact struct {
+	Err error
+}
+
+func (e *ErrInvalidContact) Error() string {
+	return "contact is invalid: " + e.Err.Error()
+}
+
+type ErrInvalidOccurrence struct {
+	OccurrenceAt time.Time
+}
+
+func (e *ErrInvalidOccurrence) Error() string {
+	return "reminder has no occurrence at " + e.OccurrenceAt.Format(time.RFC3339)
+}
+
+type ErrInvalidCalendarEntry struct {
+	Reason string
+}
+
+func (e *ErrInvalidCalendarEntry) Error() string {
+	return e.Reason
+}
+
+type ErrPreconditionFailed struct{}
+
+func (e *ErrPreconditionFailed) Error() string {
+	return "the resource does not match the request's preconditions"
+}
+
+// ErrCalendarObjectConflict is returned when a CalDAV resource cannot be
+// created because its name is already the id of another reminder.
+type ErrCalendarObjectConflict struct {
+	ReminderID string
+}
+
+func (e *ErrCalendarObjectConflict) Error() string {
+	return "another calendar object already uses the UID " + e.ReminderID
+}
+
+type ErrPatientAccessDenied struct {
+	PatientID string
+}
+
+func (e *ErrPatientAccessDenied) Error() string {
+	return "access to patient " + e.PatientID + " is not allowed"
+}
+
+type ErrInvalidCheckInAnswers struct {
+	Reasons []string
+}
+
+func (e *ErrInvalidCheckInAnswers) Error() string {
+	return "answers are invalid: " + strings.Join(e.Reasons, "; ")
+}
+
+type ErrInvalidCaregiverInvitation struct {
+	Reason string
+}
+
+func (e *ErrInvalidCaregiverInvitation) Error() string {
+	return "caregiver invitation is invalid: " + e.Reason
+}
+
+type ErrInvalidOrganizationMember struct {
+	Reason string
+}
+
+func (e *ErrInvalidOrganizationMember) Error() string {
+	return "organization member is invalid: " + e.Reason
+}
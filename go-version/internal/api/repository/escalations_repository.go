@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+
+	"github.com/google/uuid"
+)
+
+type EscalationRepositoryInterface interface {
+	GetPolicy(ctx context.Context, params *domain.EscalationPolicyGetDomain) (*EscalationPolicyResult, error)
+	SetPolicy(ctx context.Context, params *domain.EscalationPolicySetDomain) (*EscalationPolicyResult, error)
+	DeletePolicy(ctx context.Context, params *domain.EscalationPolicyDeleteDomain) error
+	ListEvents(ctx context.Context, params *domain.EscalationEventListDomain) (*EscalationEventListResult, error)
+	AcknowledgeOccurrence(ctx context.Context, params *domain.ReminderAcknowledgeDomain) (*ReminderAcknowledgeResult, error)
+}
+
+type EscalationRepository struct {
+	escalationStore store.EscalationStoreInterface
+	reminderStore   store.ReminderStoreInterface
+	contactStore    store.ContactStoreInterface
+}
+
+func NewEscalationRepository(escalationStore store.EscalationStoreInterface, reminderStore store.ReminderStoreInterface, contactStore store.ContactStoreInterface) (*EscalationRepository, error) {
+	return &EscalationRepository{
+		escalationStore: escalationStore,
+		reminderStore:   reminderStore,
+		contactStore:    contactStore,
+	}, nil
+}
+
+func (r *EscalationRepository) GetPolicy(ctx context.Context, req *domain.EscalationPolicyGetDomain) (*EscalationPolicyResult, error) {
+	policy, err := r.escalationStore.GetPolicy(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewEscalationPolicyResult(policy), nil
+}
+
+func (r *EscalationRepository) SetPolicy(ctx context.Context, req *domain.EscalationPolicySetDomain) (*EscalationPolicyResult, error) {
+	if _, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID); err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	steps := make([]models.EscalationStep, len(req.Steps))
+	for i, step := range req.Steps {
+		if _, err := r.contactStore.GetContact(ctx, req.UserID, step.ContactID); err != nil {
+			var notFoundErr *store.NoContactFoundError
+			if errors.As(err, &notFoundErr) {
+				return nil, &ErrInvalidContact{Err: err}
+			}
+			return nil, err
+		}
+		steps[i] = models.EscalationStep{
+			Position:     i + 1,
+			DelayMinutes: step.DelayMinutes,
+			ContactId:    step.ContactID,
+			Channel:      step.Channel,
+		}
+	}
+
+	policy, err := r.escalationStore.ReplacePolicy(ctx, &models.EscalationPolicy{
+		Id:         uuid.New().String(),
+		ReminderId: req.ReminderID,
+		UserId:     req.UserID,
+		Steps:      steps,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEscalationPolicyResult(policy), nil
+}
+
+func (r *EscalationRepository) DeletePolicy(ctx context.Context, req *domain.EscalationPolicyDeleteDomain) error {
+	err := r.escalationStore.DeletePolicy(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+func (r *EscalationRepository) ListEvents(ctx context.Context, req *domain.EscalationEventListDomain) (*EscalationEventListResult, error) {
+	if _, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID); err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	events, err := r.escalationStore.ListEvents(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEscalationEventListResult(events), nil
+}
+
+// AcknowledgeOccurrence marks an occurrence of the reminder as done, which
+// stops any further escalation for it.
+func (r *EscalationRepository) AcknowledgeOccurrence(ctx context.Context, req *domain.ReminderAcknowledgeDomain) (*ReminderAcknowledgeResult, error) {
+	reminder, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	if !isReminderOccurrence(reminder, req.OccurrenceAt) {
+		return nil, &ErrInvalidOccurrence{OccurrenceAt: req.OccurrenceAt}
+	}
+
+	ack, err := r.escalationStore.CreateAcknowledgement(ctx, &models.ReminderAcknowledgement{
+		Id:           uuid.New().String(),
+		ReminderId:   reminder.Id,
+		UserId:       req.UserID,
+		OccurrenceAt: req.OccurrenceAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReminderAcknowledgeResult(ack), nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/api/store/mocks"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestEscalationRepository_SetPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testCases := []struct {
+		name          string
+		setupMock     func() *EscalationRepository
+		expectedError bool
+		validateError func(error) bool
+	}{
+		{
+			name: "saves steps in order",
+			setupMock: func() *EscalationRepository {
+				escalationStore := mocks.NewMockEscalationStoreInterface(ctrl)
+				reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+				contactStore := mocks.NewMockContactStoreInterface(ctrl)
+
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(&models.Reminder{Id: "reminder-1"}, nil).Times(1)
+				contactStore.EXPECT().GetContact(gomock.Any(), "user-123", "contact-1").Return(&models.Contact{Id: "contact-1"}, nil).Times(2)
+				escalationStore.EXPECT().
+					ReplacePolicy(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, policy *models.EscalationPolicy) (*models.EscalationPolicy, error) {
+						if len(policy.Steps) != 2 || policy.Steps[0].Position != 1 || policy.Steps[1].Position != 2 {
+							t.Errorf("Expected steps to be numbered from 1, got %+v", policy.Steps)
+						}
+						return policy, nil
+					}).
+					Times(1)
+
+				return &EscalationRepository{escalationStore: escalationStore, reminderStore: reminderStore, contactStore: contactStore}
+			},
+			expectedError: false,
+		},
+		{
+			name: "reminder not found",
+			setupMock: func() *EscalationRepository {
+				reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(nil, &store.NoReminderFoundError{ID: "reminder-1"}).Times(1)
+
+				return &EscalationRepository{reminderStore: reminderStore}
+			},
+			expectedError: true,
+			validateError: func(err error) bool {
+				var noResourceErr *NoResourceFoundError
+				return errors.As(err, &noResourceErr)
+			},
+		},
+		{
+			name: "contact belongs to another user",
+			setupMock: func() *EscalationRepository {
+				reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+				contactStore := mocks.NewMockContactStoreInterface(ctrl)
+
+				reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(&models.Reminder{Id: "reminder-1"}, nil).Times(1)
+				contactStore.EXPECT().GetContact(gomock.Any(), "user-123", "contact-1").Return(nil, &store.NoContactFoundError{ID: "contact-1"}).Times(1)
+
+				return &EscalationRepository{reminderStore: reminderStore, contactStore: contactStore}
+			},
+			expectedError: true,
+			validateError: func(err error) bool {
+				var invalidContactErr *ErrInvalidContact
+				return errors.As(err, &invalidContactErr)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := tc.setupMock()
+
+			result, err := repo.SetPolicy(context.Background(), &domain.EscalationPolicySetDomain{
+				UserID:     "user-123",
+				ReminderID: "reminder-1",
+				Steps: []domain.EscalationStepDomain{
+					{DelayMinutes: 15, ContactID: "contact-1", Channel: models.ChannelWebPush},
+					{DelayMinutes: 60, ContactID: "contact-1", Channel: models.ChannelMobilePush},
+				},
+			})
+
+			if tc.expectedError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if tc.validateError != nil && !tc.validateError(err) {
+					t.Errorf("Error validation failed: %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(result.Steps) != 2 {
+				t.Errorf("Expected 2 steps, got %d", len(result.Steps))
+			}
+		})
+	}
+}
+
+func TestEscalationRepository_AcknowledgeOccurrence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reminder := &models.Reminder{
+		Id:      "reminder-1",
+		UserId:  "user-123",
+		RRule:   "FREQ=DAILY;COUNT=10",
+		StartAt: time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name          string
+		occurrenceAt  time.Time
+		expectCreate  bool
+		expectedError bool
+	}{
+		{
+			name:         "acknowledges a scheduled occurrence",
+			occurrenceAt: time.Date(2023, 10, 3, 9, 0, 0, 0, time.UTC),
+			expectCreate: true,
+		},
+		{
+			name:          "rejects a time that is not an occurrence",
+			occurrenceAt:  time.Date(2023, 10, 3, 9, 30, 0, 0, time.UTC),
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			escalationStore := mocks.NewMockEscalationStoreInterface(ctrl)
+			reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+
+			reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(reminder, nil).Times(1)
+			if tc.expectCreate {
+				escalationStore.EXPECT().
+					CreateAcknowledgement(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, ack *models.ReminderAcknowledgement) (*models.ReminderAcknowledgement, error) {
+						return ack, nil
+					}).
+					Times(1)
+			}
+
+			repo := &EscalationRepository{escalationStore: escalationStore, reminderStore: reminderStore}
+
+			_, err := repo.AcknowledgeOccurrence(context.Background(), &domain.ReminderAcknowledgeDomain{
+				UserID:       "user-123",
+				ReminderID:   "reminder-1",
+				OccurrenceAt: tc.occurrenceAt,
+			})
+
+			if tc.expectedError {
+				var invalidErr *ErrInvalidOccurrence
+				if !errors.As(err, &invalidErr) {
+					t.Errorf("Expected ErrInvalidOccurrence, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
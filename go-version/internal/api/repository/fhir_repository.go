@@ -0,0 +1,324 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/fhir"
+	"go-version/internal/recurrence"
+)
+
+// Statuses of MedicationRequests that are still to be taken, and so are
+// imported as reminders.
+var importedMedicationStatuses = []string{"active", "on-hold", "draft"}
+
+// maxMedicationNameLength matches the limit on medication names sent with
+// reminders.
+const maxMedicationNameLength = 200
+
+type FHIRRepositoryInterface interface {
+	ListMedicationRequests(ctx context.Context, params *domain.FHIRMedicationListDomain) (*fhir.Bundle, error)
+	CreateMedicationRequests(ctx context.Context, params *domain.FHIRMedicationCreateDomain) (*fhir.Bundle, error)
+}
+
+// FHIRRepository exchanges reminders with clinical systems as FHIR
+// MedicationRequests, one per reminder, whose dosage timing is the
+// reminder's RRULE. Reminders are created through the ReminderRepository
+// so they are validated like any other.
+type FHIRRepository struct {
+	reminderStore      store.ReminderStoreInterface
+	reminderRepository ReminderRepositoryInterface
+	// limits caps the timings of imported MedicationRequests before they
+	// are converted to rules.
+	limits recurrence.Limits
+}
+
+func NewFHIRRepository(reminderStore store.ReminderStoreInterface, reminderRepository ReminderRepositoryInterface, limits recurrence.Limits) (*FHIRRepository, error) {
+	return &FHIRRepository{reminderStore: reminderStore, reminderRepository: reminderRepository, limits: limits}, nil
+}
+
+// ListMedicationRequests returns the user's reminders as a searchset
+// Bundle. Rules whose timing is only approximated are reported as warnings
+// in an OperationOutcome entry.
+func (r *FHIRRepository) ListMedicationRequests(ctx context.Context, req *domain.FHIRMedicationListDomain) (*fhir.Bundle, error) {
+	if req.PatientID != "" && req.PatientID != req.UserID {
+		return nil, &ErrPatientAccessDenied{PatientID: req.PatientID}
+	}
+
+	reminders, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := fhir.NewBundle(fhir.BundleTypeSearchset)
+	total := len(reminders)
+	bundle.Total = &total
+
+	var issues []fhir.OperationOutcomeIssue
+	for i := range reminders {
+		resource, notes := medicationRequestFromReminder(&reminders[i], time.Now())
+		for _, note := range notes {
+			issues = append(issues, fhir.OperationOutcomeIssue{
+				Severity:    fhir.IssueSeverityWarning,
+				Code:        fhir.IssueCodeNotSupported,
+				Diagnostics: note,
+				Expression:  []string{medicationRequestPath(reminders[i].Id) + ".dosageInstruction[0].timing"},
+			})
+		}
+		err := bundle.AddResource(medicationRequestPath(resource.ID), resource, &fhir.BundleSearch{Mode: "match"})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(issues) > 0 {
+		err := bundle.AddResource("", fhir.NewOperationOutcome(issues...), &fhir.BundleSearch{Mode: "outcome"})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bundle, nil
+}
+
+// CreateMedicationRequests creates a reminder for each timed dosage
+// instruction of each MedicationRequest, and reports the outcome of each
+// resource in a batch-response Bundle. A resource whose timing cannot be
+// represented creates no reminders at all.
+func (r *FHIRRepository) CreateMedicationRequests(ctx context.Context, req *domain.FHIRMedicationCreateDomain) (*fhir.Bundle, error) {
+	bundle := fhir.NewBundle(fhir.BundleTypeBatchResponse)
+	for _, entry := range req.Entries {
+		response, err := r.createMedicationRequest(ctx, req.UserID, &entry)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Entry = append(bundle.Entry, fhir.BundleEntry{Response: response})
+	}
+	return bundle, nil
+}
+
+func (r *FHIRRepository) createMedicationRequest(ctx context.Context, userID string, entry *domain.FHIRMedicationEntryDomain) (*fhir.BundleResponse, error) {
+	reject := func(status, code, diagnostics string) (*fhir.BundleResponse, error) {
+		return &fhir.BundleResponse{
+			Status: status,
+			Outcome: fhir.NewOperationOutcome(fhir.OperationOutcomeIssue{
+				Severity:    fhir.IssueSeverityError,
+				Code:        code,
+				Diagnostics: diagnostics,
+			}),
+		}, nil
+	}
+
+	if entry.ParseError != nil {
+		return reject("400 Bad Request", fhir.IssueCodeInvalid, entry.ParseError.Error())
+	}
+	resource := entry.Resource
+
+	if resource.Subject != nil && resource.Subject.Reference != "" &&
+		resource.Subject.Reference != fhir.ResourceTypePatient+"/"+userID {
+		return reject("403 Forbidden", fhir.IssueCodeForbidden, "subject must be the authenticated patient")
+	}
+	if !slices.Contains(importedMedicationStatuses, resource.Status) {
+		return &fhir.BundleResponse{
+			Status: "200 OK",
+			Outcome: fhir.NewOperationOutcome(fhir.OperationOutcomeIssue{
+				Severity:    fhir.IssueSeverityInformation,
+				Code:        fhir.IssueCodeInformational,
+				Diagnostics: fmt.Sprintf("not imported because its status is %q", resource.Status),
+			}),
+		}, nil
+	}
+
+	label := strings.TrimSpace(resource.MedicationCodeableConcept.Label())
+	if label == "" {
+		return reject("422 Unprocessable Entity", fhir.IssueCodeInvalid, "medicationCodeableConcept must have a text or a display")
+	}
+	if len(label) > maxMedicationNameLength {
+		return reject("422 Unprocessable Entity", fhir.IssueCodeInvalid, "the medication name must be at most 200 characters")
+	}
+
+	// Every dosage is converted before any reminder is created, so that a
+	// resource is imported either whole or not at all.
+	var creates []*domain.ReminderCreateDomain
+	var issues []fhir.OperationOutcomeIssue
+	for i, dosage := range resource.DosageInstruction {
+		if dosage.Timing == nil {
+			continue
+		}
+		path := fmt.Sprintf("MedicationRequest.dosageInstruction[%d].timing", i)
+
+		rule, start, notes, err := fhir.RRuleFromTiming(dosage.Timing, r.limits.OrDefault().MaxOccurrences)
+		if err != nil {
+			return reject("422 Unprocessable Entity", fhir.IssueCodeNotSupported, path+": "+err.Error())
+		}
+		for _, note := range notes {
+			issues = append(issues, fhir.OperationOutcomeIssue{
+				Severity:    fhir.IssueSeverityWarning,
+				Code:        fhir.IssueCodeNotSupported,
+				Diagnostics: note,
+				Expression:  []string{path},
+			})
+		}
+
+		medication, medicationNotes := medicationFromDosage(label, &dosage)
+		for _, note := range medicationNotes {
+			issues = append(issues, fhir.OperationOutcomeIssue{
+				Severity:    fhir.IssueSeverityWarning,
+				Code:        fhir.IssueCodeNotSupported,
+				Diagnostics: note,
+				Expression:  []string{fmt.Sprintf("MedicationRequest.dosageInstruction[%d]", i)},
+			})
+		}
+
+		description := label
+		if dosage.Text != "" {
+			description += " (" + dosage.Text + ")"
+		}
+		creates = append(creates, &domain.ReminderCreateDomain{
+			UserID:      userID,
+			RRule:       rule,
+			Description: &description,
+			StartAt:     start,
+			Medication:  medication,
+		})
+	}
+	if len(creates) == 0 {
+		return reject("422 Unprocessable Entity", fhir.IssueCodeInvalid, "no dosageInstruction has a timing")
+	}
+
+	var ids []string
+	for _, create := range creates {
+		created, err := r.reminderRepository.CreateReminder(ctx, create)
+		var invalidRRule *ErrInvalidRRule
+		if errors.As(err, &invalidRRule) {
+			return reject("422 Unprocessable Entity", fhir.IssueCodeProcessing, invalidRRule.Error())
+		}
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, *created.Id)
+	}
+
+	if len(ids) > 1 {
+		issues = append(issues, fhir.OperationOutcomeIssue{
+			Severity:    fhir.IssueSeverityInformation,
+			Code:        fhir.IssueCodeInformational,
+			Diagnostics: "created one MedicationRequest per dosage instruction: " + strings.Join(ids, ", "),
+		})
+	}
+
+	response := &fhir.BundleResponse{
+		Status:   "201 Created",
+		Location: medicationRequestPath(ids[0]),
+	}
+	if len(issues) > 0 {
+		response.Outcome = fhir.NewOperationOutcome(issues...)
+	}
+	return response, nil
+}
+
+// medicationRequestFromReminder describes a reminder as a MedicationRequest
+// for its user. When the timing only approximates the reminder's rule, the
+// exact rule is kept in the dosage text.
+func medicationRequestFromReminder(reminder *models.Reminder, now time.Time) (*fhir.MedicationRequest, []string) {
+	name := "Reminder"
+	if reminder.Medication != nil {
+		name = reminder.Medication.Name
+		if reminder.Medication.Strength != nil {
+			name += " " + *reminder.Medication.Strength
+		}
+	} else if reminder.Description != nil && *reminder.Description != "" {
+		name = *reminder.Description
+	}
+
+	resource := &fhir.MedicationRequest{
+		ResourceType:              fhir.ResourceTypeMedicationRequest,
+		ID:                        reminder.Id,
+		Status:                    "active",
+		Intent:                    "plan",
+		MedicationCodeableConcept: &fhir.CodeableConcept{Text: name},
+		Subject:                   &fhir.Reference{Reference: fhir.ResourceTypePatient + "/" + reminder.UserId},
+	}
+	if reminder.CreatedAt != nil {
+		resource.AuthoredOn = fhir.FormatDateTime(*reminder.CreatedAt)
+	}
+
+	dosage := dosageFromMedication(reminder.Medication)
+	timing, notes, err := fhir.TimingFromRRule(reminder.RRule, reminder.StartAt)
+	if err != nil {
+		notes = []string{"the rule could not be read: " + err.Error()}
+	} else {
+		dosage.Timing = timing
+		if end, err := fhir.ParseDateTime(timing.Repeat.BoundsPeriod.End); err == nil && end.Before(now) {
+			resource.Status = "completed"
+		}
+	}
+	if len(notes) > 0 {
+		dosage.Text = "RRULE:" + reminder.RRule
+	}
+	resource.DosageInstruction = []fhir.Dosage{dosage}
+
+	return resource, notes
+}
+
+func dosageFromMedication(medication *models.Medication) fhir.Dosage {
+	if medication == nil {
+		return fhir.Dosage{}
+	}
+
+	dosage := fhir.Dosage{}
+	if medication.Instructions != nil {
+		dosage.PatientInstruction = *medication.Instructions
+	}
+	if medication.Route != nil {
+		dosage.Route = &fhir.CodeableConcept{Text: *medication.Route}
+	}
+	if medication.DoseQuantity != nil {
+		quantity := &fhir.Quantity{Value: medication.DoseQuantity}
+		if medication.DoseUnit != nil {
+			quantity.Unit = *medication.DoseUnit
+		}
+		dosage.DoseAndRate = []fhir.DoseAndRate{{DoseQuantity: quantity}}
+	}
+	return dosage
+}
+
+// medicationFromDosage reads the structured medication of a dosage. Doses
+// and routes reminders have no unit or route for are left out and noted.
+func medicationFromDosage(name string, dosage *fhir.Dosage) (*domain.MedicationDomain, []string) {
+	medication := &domain.MedicationDomain{Name: name}
+	var notes []string
+
+	if dosage.PatientInstruction != "" {
+		medication.Instructions = &dosage.PatientInstruction
+	}
+	if route := strings.ToLower(dosage.Route.Label()); route != "" {
+		if models.IsValidMedicationRoute(route) {
+			medication.Route = &route
+		} else {
+			notes = append(notes, fmt.Sprintf("route %q is not supported and was left out", route))
+		}
+	}
+	if len(dosage.DoseAndRate) > 0 && dosage.DoseAndRate[0].DoseQuantity != nil {
+		quantity := dosage.DoseAndRate[0].DoseQuantity
+		unit := strings.ToLower(quantity.Unit)
+		if quantity.Value != nil && *quantity.Value > 0 && models.IsValidDoseUnit(unit) {
+			medication.DoseQuantity, medication.DoseUnit = quantity.Value, &unit
+		} else {
+			notes = append(notes, fmt.Sprintf("dose unit %q is not supported and the dose was left out", quantity.Unit))
+		}
+	}
+
+	return medication, notes
+}
+
+func medicationRequestPath(id string) string {
+	return fhir.ResourceTypeMedicationRequest + "/" + id
+}
@@ -0,0 +1,195 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store/mocks"
+	"go-version/internal/fhir"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestFHIRRepository_ListMedicationRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	description := "Take pills"
+	strength := "500 mg"
+	reminders := []models.Reminder{
+		{
+			Id:          "reminder-1",
+			UserId:      "user-123",
+			RRule:       "FREQ=DAILY;BYHOUR=8,20;COUNT=10",
+			StartAt:     time.Date(2023, 10, 1, 8, 0, 0, 0, time.UTC),
+			Description: &description,
+			Medication:  &models.Medication{Name: "Metformin", Strength: &strength},
+		},
+		{
+			Id:      "reminder-2",
+			UserId:  "user-123",
+			RRule:   "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=3",
+			StartAt: time.Date(2099, 1, 31, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	reminderStore.EXPECT().ListReminders(gomock.Any(), gomock.Any()).Return(reminders, nil).Times(1)
+
+	repo := &FHIRRepository{reminderStore: reminderStore}
+
+	bundle, err := repo.ListMedicationRequests(context.Background(), &domain.FHIRMedicationListDomain{
+		UserID:    "user-123",
+		PatientID: "user-123",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if bundle.Type != fhir.BundleTypeSearchset || *bundle.Total != 2 || len(bundle.Entry) != 3 {
+		t.Fatalf("Expected 2 matches and an outcome, got %+v", bundle)
+	}
+
+	var first fhir.MedicationRequest
+	if err := json.Unmarshal(bundle.Entry[0].Resource, &first); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first.Status != "completed" || first.Subject.Reference != "Patient/user-123" || first.MedicationCodeableConcept.Text != "Metformin 500 mg" {
+		t.Errorf("Unexpected resource %+v", first)
+	}
+	if first.DosageInstruction[0].Text != "" {
+		t.Errorf("Expected no rule text for a lossless timing, got %q", first.DosageInstruction[0].Text)
+	}
+
+	var second fhir.MedicationRequest
+	if err := json.Unmarshal(bundle.Entry[1].Resource, &second); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if second.Status != "active" || second.DosageInstruction[0].Text != "RRULE:"+reminders[1].RRule {
+		t.Errorf("Expected the exact rule to be kept for a lossy timing, got %+v", second)
+	}
+
+	outcome := bundle.Entry[2]
+	if outcome.Search.Mode != "outcome" || !strings.Contains(string(outcome.Resource), "BYMONTHDAY") {
+		t.Errorf("Expected an outcome describing the lost rule part, got %s", outcome.Resource)
+	}
+}
+
+func TestFHIRRepository_ListMedicationRequestsOfAnotherPatient(t *testing.T) {
+	repo := &FHIRRepository{}
+
+	_, err := repo.ListMedicationRequests(context.Background(), &domain.FHIRMedicationListDomain{
+		UserID:    "user-123",
+		PatientID: "user-456",
+	})
+
+	var accessDeniedErr *ErrPatientAccessDenied
+	if !errors.As(err, &accessDeniedErr) {
+		t.Fatalf("Expected ErrPatientAccessDenied, got %v", err)
+	}
+}
+
+func TestFHIRRepository_CreateMedicationRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	count := 4
+	timing := &fhir.Timing{Repeat: &fhir.TimingRepeat{
+		PeriodUnit:   "d",
+		TimeOfDay:    []string{"08:00:00", "20:00:00"},
+		Count:        &count,
+		BoundsPeriod: &fhir.Period{Start: "2024-01-01"},
+	}}
+	medication := &fhir.CodeableConcept{Text: "Amoxicillin"}
+	dose := 1.0
+
+	entries := []domain.FHIRMedicationEntryDomain{
+		{Resource: &fhir.MedicationRequest{
+			ResourceType:              fhir.ResourceTypeMedicationRequest,
+			Status:                    "active",
+			MedicationCodeableConcept: medication,
+			Subject:                   &fhir.Reference{Reference: "Patient/user-123"},
+			DosageInstruction: []fhir.Dosage{{
+				Text:        "500 mg",
+				Timing:      timing,
+				Route:       &fhir.CodeableConcept{Text: "Oral"},
+				DoseAndRate: []fhir.DoseAndRate{{DoseQuantity: &fhir.Quantity{Value: &dose, Unit: "capsule"}}},
+			}},
+		}},
+		{Resource: &fhir.MedicationRequest{
+			ResourceType:              fhir.ResourceTypeMedicationRequest,
+			Status:                    "active",
+			MedicationCodeableConcept: medication,
+			DosageInstruction: []fhir.Dosage{{Timing: &fhir.Timing{Repeat: &fhir.TimingRepeat{
+				PeriodUnit:   "d",
+				When:         []string{"ACM"},
+				Count:        &count,
+				BoundsPeriod: &fhir.Period{Start: "2024-01-01"},
+			}}}},
+		}},
+		{Resource: &fhir.MedicationRequest{
+			ResourceType:              fhir.ResourceTypeMedicationRequest,
+			Status:                    "completed",
+			MedicationCodeableConcept: medication,
+			DosageInstruction:         []fhir.Dosage{{Timing: timing}},
+		}},
+		{Resource: &fhir.MedicationRequest{
+			ResourceType:              fhir.ResourceTypeMedicationRequest,
+			Status:                    "active",
+			MedicationCodeableConcept: medication,
+			Subject:                   &fhir.Reference{Reference: "Patient/user-456"},
+			DosageInstruction:         []fhir.Dosage{{Timing: timing}},
+		}},
+		{ParseError: errors.New("resourceType \"Patient\" is not MedicationRequest")},
+	}
+
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	reminderStore.EXPECT().
+		CreateReminder(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error) {
+			if reminder.RRule != "FREQ=DAILY;INTERVAL=1;COUNT=4;BYHOUR=8,20;BYMINUTE=0;BYSECOND=0" {
+				t.Errorf("Unexpected rule %s", reminder.RRule)
+			}
+			if *reminder.Description != "Amoxicillin (500 mg)" {
+				t.Errorf("Unexpected description %q", *reminder.Description)
+			}
+			if reminder.Medication == nil || reminder.Medication.Name != "Amoxicillin" ||
+				*reminder.Medication.Route != "oral" || *reminder.Medication.DoseQuantity != 1 || *reminder.Medication.DoseUnit != "capsule" {
+				t.Errorf("Unexpected medication %+v", reminder.Medication)
+			}
+			return reminder, nil
+		}).
+		Times(1)
+
+	repo := &FHIRRepository{
+		reminderStore:      reminderStore,
+		reminderRepository: &ReminderRepository{reminderStore: reminderStore},
+	}
+
+	bundle, err := repo.CreateMedicationRequests(context.Background(), &domain.FHIRMedicationCreateDomain{
+		UserID:  "user-123",
+		Entries: entries,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{"201 Created", "422 Unprocessable Entity", "200 OK", "403 Forbidden", "400 Bad Request"}
+	if len(bundle.Entry) != len(expected) {
+		t.Fatalf("Expected %d entries, got %d", len(expected), len(bundle.Entry))
+	}
+	for i, status := range expected {
+		if bundle.Entry[i].Response.Status != status {
+			t.Errorf("Entry %d: expected status %s, got %s", i, status, bundle.Entry[i].Response.Status)
+		}
+	}
+	if !strings.HasPrefix(bundle.Entry[0].Response.Location, "MedicationRequest/") {
+		t.Errorf("Unexpected location %s", bundle.Entry[0].Response.Location)
+	}
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/recurrence"
+
+	"github.com/google/uuid"
+)
+
+type JournalRepositoryInterface interface {
+	ListEntries(ctx context.Context, params *domain.JournalEntryListDomain) (*JournalEntryListResult, error)
+	GetEntry(ctx context.Context, params *domain.JournalEntryGetDomain) (*JournalEntryResult, error)
+	CreateEntry(ctx context.Context, params *domain.JournalEntryCreateDomain) (*JournalEntryResult, error)
+	UpdateEntry(ctx context.Context, params *domain.JournalEntryUpdateDomain) (*JournalEntryResult, error)
+	DeleteEntry(ctx context.Context, params *domain.JournalEntryDeleteDomain) error
+	Timeline(ctx context.Context, params *domain.TimelineDomain) (*TimelineResult, error)
+}
+
+type JournalRepository struct {
+	journalStore  store.JournalStoreInterface
+	reminderStore store.ReminderStoreInterface
+	limits        recurrence.Limits
+}
+
+func NewJournalRepository(journalStore store.JournalStoreInterface, reminderStore store.ReminderStoreInterface, limits recurrence.Limits) (*JournalRepository, error) {
+	return &JournalRepository{
+		journalStore:  journalStore,
+		reminderStore: reminderStore,
+		limits:        limits,
+	}, nil
+}
+
+func (r *JournalRepository) ListEntries(ctx context.Context, req *domain.JournalEntryListDomain) (*JournalEntryListResult, error) {
+	entries, err := r.journalStore.ListEntries(ctx, &store.JournalEntryListFilters{
+		UserID:    req.UserID,
+		Search:    req.Search,
+		Tag:       req.Tag,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJournalEntryListResult(entries), nil
+}
+
+func (r *JournalRepository) GetEntry(ctx context.Context, req *domain.JournalEntryGetDomain) (*JournalEntryResult, error) {
+	entry, err := r.journalStore.GetEntry(ctx, req.UserID, req.EntryID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewJournalEntryResult(entry), nil
+}
+
+func (r *JournalRepository) CreateEntry(ctx context.Context, req *domain.JournalEntryCreateDomain) (*JournalEntryResult, error) {
+	entry, err := r.journalStore.CreateEntry(ctx, &models.JournalEntry{
+		Id:           uuid.New().String(),
+		UserId:       req.UserID,
+		OccurredAt:   req.OccurredAt,
+		Text:         req.Text,
+		Tags:         journalTags(req.Tags),
+		Measurements: journalMeasurements(req.Measurements),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJournalEntryResult(entry), nil
+}
+
+func (r *JournalRepository) UpdateEntry(ctx context.Context, req *domain.JournalEntryUpdateDomain) (*JournalEntryResult, error) {
+	entry, err := r.journalStore.GetEntry(ctx, req.UserID, req.EntryID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	if req.OccurredAt != nil {
+		entry.OccurredAt = *req.OccurredAt
+	}
+	if req.Text != nil {
+		entry.Text = *req.Text
+	}
+	if req.Tags != nil {
+		entry.Tags = journalTags(req.Tags)
+	}
+	if req.Measurements != nil {
+		entry.Measurements = journalMeasurements(req.Measurements)
+	}
+
+	updated, err := r.journalStore.UpdateEntry(ctx, entry)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewJournalEntryResult(updated), nil
+}
+
+func (r *JournalRepository) DeleteEntry(ctx context.Context, req *domain.JournalEntryDeleteDomain) error {
+	err := r.journalStore.DeleteEntry(ctx, req.UserID, req.EntryID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+// Timeline merges the journal entries and reminder occurrences falling
+// within the range into one list, earliest first.
+func (r *JournalRepository) Timeline(ctx context.Context, req *domain.TimelineDomain) (*TimelineResult, error) {
+	entries, err := r.journalStore.ListEntries(ctx, &store.JournalEntryListFilters{
+		UserID:    req.UserID,
+		StartDate: &req.StartDate,
+		EndDate:   &req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reminders, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{
+		UserID:    req.UserID,
+		StartDate: &req.StartDate,
+		EndDate:   &req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := []TimelineItemResult{}
+	for i := range entries {
+		items = append(items, TimelineItemResult{
+			Type:         TimelineItemJournalEntry,
+			At:           entries[i].OccurredAt,
+			JournalEntry: &entries[i],
+		})
+	}
+	for i := range reminders {
+		occurrences, err := reminders[i].OccurrencesBetween(req.StartDate, req.EndDate, r.limits)
+		if err != nil {
+			continue
+		}
+		reminder := NewTimelineReminderResult(&reminders[i])
+		for _, occurrence := range occurrences {
+			items = append(items, TimelineItemResult{
+				Type:     TimelineItemReminderOccurrence,
+				At:       occurrence,
+				Reminder: reminder,
+			})
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].At.Before(items[j].At)
+	})
+
+	return &TimelineResult{Items: items}, nil
+}
+
+// journalTags sorts the tags and drops duplicates, which the transport
+// has already lowercased.
+func journalTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	sorted := slices.Clone(tags)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
+
+func journalMeasurements(measurements []domain.JournalMeasurementDomain) []models.JournalMeasurement {
+	result := make([]models.JournalMeasurement, len(measurements))
+	for i, measurement := range measurements {
+		result[i] = models.JournalMeasurement{
+			Name:  measurement.Name,
+			Value: measurement.Value,
+			Unit:  measurement.Unit,
+		}
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store/mocks"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestJournalRepository_UpdateEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existing := &models.JournalEntry{
+		Id:           "entry-1",
+		UserId:       "user-123",
+		OccurredAt:   time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC),
+		Text:         "Migraine started",
+		Tags:         []string{"headache"},
+		Measurements: []models.JournalMeasurement{{Name: "pain", Value: 7}},
+	}
+
+	journalStore := mocks.NewMockJournalStoreInterface(ctrl)
+	journalStore.EXPECT().GetEntry(gomock.Any(), "user-123", "entry-1").Return(existing, nil).Times(1)
+	journalStore.EXPECT().
+		UpdateEntry(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, entry *models.JournalEntry) (*models.JournalEntry, error) {
+			if entry.Text != "Migraine started" || !entry.OccurredAt.Equal(existing.OccurredAt) {
+				t.Errorf("Expected unchanged fields to be kept, got %+v", entry)
+			}
+			if !reflect.DeepEqual(entry.Tags, []string{"aura", "headache"}) {
+				t.Errorf("Expected sorted unique tags, got %v", entry.Tags)
+			}
+			if len(entry.Measurements) != 1 {
+				t.Errorf("Expected measurements to be kept, got %v", entry.Measurements)
+			}
+			return entry, nil
+		}).
+		Times(1)
+
+	repo := &JournalRepository{journalStore: journalStore}
+
+	_, err := repo.UpdateEntry(context.Background(), &domain.JournalEntryUpdateDomain{
+		UserID:  "user-123",
+		EntryID: "entry-1",
+		Tags:    []string{"headache", "aura", "headache"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+}
+
+func TestJournalRepository_Timeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	start := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	entries := []models.JournalEntry{
+		{Id: "entry-1", OccurredAt: time.Date(2024, 1, 2, 10, 30, 0, 0, time.UTC), Text: "Migraine started"},
+		{Id: "entry-2", OccurredAt: time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC), Text: "Skipped lunch"},
+	}
+	reminders := []models.Reminder{
+		{Id: "reminder-1", RRule: "FREQ=DAILY;BYHOUR=8;COUNT=10", StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)},
+	}
+
+	journalStore := mocks.NewMockJournalStoreInterface(ctrl)
+	journalStore.EXPECT().ListEntries(gomock.Any(), gomock.Any()).Return(entries, nil).Times(1)
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	reminderStore.EXPECT().ListReminders(gomock.Any(), gomock.Any()).Return(reminders, nil).Times(1)
+
+	repo := &JournalRepository{journalStore: journalStore, reminderStore: reminderStore}
+
+	timeline, err := repo.Timeline(context.Background(), &domain.TimelineDomain{
+		UserID:    "user-123",
+		StartDate: start,
+		EndDate:   end,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []string{
+		TimelineItemReminderOccurrence,
+		TimelineItemJournalEntry,
+		TimelineItemReminderOccurrence,
+		TimelineItemJournalEntry,
+	}
+	if len(timeline.Items) != len(expected) {
+		t.Fatalf("Expected %d items, got %d", len(expected), len(timeline.Items))
+	}
+	for i, itemType := range expected {
+		if timeline.Items[i].Type != itemType {
+			t.Errorf("Item %d: expected %s, got %s at %v", i, itemType, timeline.Items[i].Type, timeline.Items[i].At)
+		}
+	}
+}
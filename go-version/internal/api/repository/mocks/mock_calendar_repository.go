@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/calendar_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/calendar_repository.go -destination=internal/api/repository/mocks/mock_calendar_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalendarRepositoryInterface is a mock of CalendarRepositoryInterface interface.
+type MockCalendarRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarRepositoryInterfaceMockRecorder is the mock recorder for MockCalendarRepositoryInterface.
+type MockCalendarRepositoryInterfaceMockRecorder struct {
+	mock *MockCalendarRepositoryInterface
+}
+
+// NewMockCalendarRepositoryInterface creates a new mock instance.
+func NewMockCalendarRepositoryInterface(ctrl *gomock.Controller) *MockCalendarRepositoryInterface {
+	mock := &MockCalendarRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockCalendarRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarRepositoryInterface) EXPECT() *MockCalendarRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateFeed mocks base method.
+func (m *MockCalendarRepositoryInterface) CreateFeed(ctx context.Context, params *domain.CalendarFeedCreateDomain) (*repository.CalendarFeedResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeed", ctx, params)
+	ret0, _ := ret[0].(*repository.CalendarFeedResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeed indicates an expected call of CreateFeed.
+func (mr *MockCalendarRepositoryInterfaceMockRecorder) CreateFeed(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeed", reflect.TypeOf((*MockCalendarRepositoryInterface)(nil).CreateFeed), ctx, params)
+}
+
+// DeleteCalendarObject mocks base method.
+func (m *MockCalendarRepositoryInterface) DeleteCalendarObject(ctx context.Context, params *domain.CalendarObjectDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendarObject", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendarObject indicates an expected call of DeleteCalendarObject.
+func (mr *MockCalendarRepositoryInterfaceMockRecorder) DeleteCalendarObject(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendarObject", reflect.TypeOf((*MockCalendarRepositoryInterface)(nil).DeleteCalendarObject), ctx, params)
+}
+
+// DeleteFeed mocks base method.
+func (m *MockCalendarRepositoryInterface) DeleteFeed(ctx context.Context, params *domain.CalendarFeedDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeed", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeed indicates an expected call of DeleteFeed.
+func (mr *MockCalendarRepositoryInterfaceMockRecorder) DeleteFeed(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeed", reflect.TypeOf((*MockCalendarRepositoryInterface)(nil).DeleteFeed), ctx, params)
+}
+
+// GetCalendarObject mocks base method.
+func (m *MockCalendarRepositoryInterface) GetCalendarObject(ctx context.Context, params *domain.CalendarObjectGetDomain) (*repository.CalendarObjectResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendarObject", ctx, params)
+	ret0, _ := ret[0].(*repository.CalendarObjectResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendarObject indicates an expected call of GetCalendarObject.
+func (mr *MockCalendarRepositoryInterfaceMockRecorder) GetCalendarObject(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendarObject", reflect.TypeOf((*MockCalendarRepositoryInterface)(nil).GetCalendarObject), ctx, params)
+}
+
+// ListCalendarObjects mocks base method.
+func (m *MockCalendarRepositoryInterface) ListCalendarObjects(ctx context.Context, params *domain.CalendarObjectListDomain) (*repository.CalendarObjectListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCalendarObjects", ctx, params)
+	ret0, _ := ret[0].(*repository.CalendarObjectListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCalendarObjects indicates an expected call of ListCalendarObjects.
+func (mr *MockCalendarRepositoryInterfaceMockRecorder) ListCalendarObjects(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCalendarObjects", reflect.TypeOf((*MockCalendarRepositoryInterface)(nil).ListCalendarObjects), ctx, params)
+}
+
+// PutCalendarObject mocks base method.
+func (m *MockCalendarRepositoryInterface) PutCalendarObject(ctx context.Context, params *domain.CalendarObjectPutDomain) (*repository.CalendarObjectPutResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutCalendarObject", ctx, params)
+	ret0, _ := ret[0].(*repository.CalendarObjectPutResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutCalendarObject indicates an expected call of PutCalendarObject.
+func (mr *MockCalendarRepositoryInterfaceMockRecorder) PutCalendarObject(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutCalendarObject", reflect.TypeOf((*MockCalendarRepositoryInterface)(nil).PutCalendarObject), ctx, params)
+}
+
+// RenderFeed mocks base method.
+func (m *MockCalendarRepositoryInterface) RenderFeed(ctx context.Context, params *domain.CalendarFeedGetDomain) (*repository.CalendarRenderResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenderFeed", ctx, params)
+	ret0, _ := ret[0].(*repository.CalendarRenderResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenderFeed indicates an expected call of RenderFeed.
+func (mr *MockCalendarRepositoryInterfaceMockRecorder) RenderFeed(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenderFeed", reflect.TypeOf((*MockCalendarRepositoryInterface)(nil).RenderFeed), ctx, params)
+}
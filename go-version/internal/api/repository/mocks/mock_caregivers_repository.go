@@ -0,0 +1,146 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/caregivers_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/caregivers_repository.go -destination=internal/api/repository/mocks/mock_caregivers_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCaregiverRepositoryInterface is a mock of CaregiverRepositoryInterface interface.
+type MockCaregiverRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCaregiverRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCaregiverRepositoryInterfaceMockRecorder is the mock recorder for MockCaregiverRepositoryInterface.
+type MockCaregiverRepositoryInterfaceMockRecorder struct {
+	mock *MockCaregiverRepositoryInterface
+}
+
+// NewMockCaregiverRepositoryInterface creates a new mock instance.
+func NewMockCaregiverRepositoryInterface(ctrl *gomock.Controller) *MockCaregiverRepositoryInterface {
+	mock := &MockCaregiverRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockCaregiverRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCaregiverRepositoryInterface) EXPECT() *MockCaregiverRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvitation mocks base method.
+func (m *MockCaregiverRepositoryInterface) AcceptInvitation(ctx context.Context, params *domain.CaregiverInvitationAcceptDomain) (*repository.CaregiverGrantResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, params)
+	ret0, _ := ret[0].(*repository.CaregiverGrantResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockCaregiverRepositoryInterfaceMockRecorder) AcceptInvitation(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockCaregiverRepositoryInterface)(nil).AcceptInvitation), ctx, params)
+}
+
+// DeclineInvitation mocks base method.
+func (m *MockCaregiverRepositoryInterface) DeclineInvitation(ctx context.Context, params *domain.CaregiverInvitationDeclineDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvitation", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineInvitation indicates an expected call of DeclineInvitation.
+func (mr *MockCaregiverRepositoryInterfaceMockRecorder) DeclineInvitation(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvitation", reflect.TypeOf((*MockCaregiverRepositoryInterface)(nil).DeclineInvitation), ctx, params)
+}
+
+// InviteCaregiver mocks base method.
+func (m *MockCaregiverRepositoryInterface) InviteCaregiver(ctx context.Context, params *domain.CaregiverInviteDomain) (*repository.CaregiverGrantResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteCaregiver", ctx, params)
+	ret0, _ := ret[0].(*repository.CaregiverGrantResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteCaregiver indicates an expected call of InviteCaregiver.
+func (mr *MockCaregiverRepositoryInterfaceMockRecorder) InviteCaregiver(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteCaregiver", reflect.TypeOf((*MockCaregiverRepositoryInterface)(nil).InviteCaregiver), ctx, params)
+}
+
+// ListActivity mocks base method.
+func (m *MockCaregiverRepositoryInterface) ListActivity(ctx context.Context, params *domain.CaregiverActivityListDomain) (*repository.CaregiverActivityResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivity", ctx, params)
+	ret0, _ := ret[0].(*repository.CaregiverActivityResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivity indicates an expected call of ListActivity.
+func (mr *MockCaregiverRepositoryInterfaceMockRecorder) ListActivity(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivity", reflect.TypeOf((*MockCaregiverRepositoryInterface)(nil).ListActivity), ctx, params)
+}
+
+// ListCaregivers mocks base method.
+func (m *MockCaregiverRepositoryInterface) ListCaregivers(ctx context.Context, params *domain.CaregiverListDomain) (*repository.CaregiverListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCaregivers", ctx, params)
+	ret0, _ := ret[0].(*repository.CaregiverListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCaregivers indicates an expected call of ListCaregivers.
+func (mr *MockCaregiverRepositoryInterfaceMockRecorder) ListCaregivers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCaregivers", reflect.TypeOf((*MockCaregiverRepositoryInterface)(nil).ListCaregivers), ctx, params)
+}
+
+// ListInvitations mocks base method.
+func (m *MockCaregiverRepositoryInterface) ListInvitations(ctx context.Context, params *domain.CaregiverInvitationListDomain) (*repository.CaregiverInvitationListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInvitations", ctx, params)
+	ret0, _ := ret[0].(*repository.CaregiverInvitationListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInvitations indicates an expected call of ListInvitations.
+func (mr *MockCaregiverRepositoryInterfaceMockRecorder) ListInvitations(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInvitations", reflect.TypeOf((*MockCaregiverRepositoryInterface)(nil).ListInvitations), ctx, params)
+}
+
+// RevokeCaregiver mocks base method.
+func (m *MockCaregiverRepositoryInterface) RevokeCaregiver(ctx context.Context, params *domain.CaregiverRevokeDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCaregiver", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeCaregiver indicates an expected call of RevokeCaregiver.
+func (mr *MockCaregiverRepositoryInterfaceMockRecorder) RevokeCaregiver(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCaregiver", reflect.TypeOf((*MockCaregiverRepositoryInterface)(nil).RevokeCaregiver), ctx, params)
+}
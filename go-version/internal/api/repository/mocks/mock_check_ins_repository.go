@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/check_ins_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/check_ins_repository.go -destination=internal/api/repository/mocks/mock_check_ins_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCheckInRepositoryInterface is a mock of CheckInRepositoryInterface interface.
+type MockCheckInRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckInRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCheckInRepositoryInterfaceMockRecorder is the mock recorder for MockCheckInRepositoryInterface.
+type MockCheckInRepositoryInterfaceMockRecorder struct {
+	mock *MockCheckInRepositoryInterface
+}
+
+// NewMockCheckInRepositoryInterface creates a new mock instance.
+func NewMockCheckInRepositoryInterface(ctrl *gomock.Controller) *MockCheckInRepositoryInterface {
+	mock := &MockCheckInRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockCheckInRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckInRepositoryInterface) EXPECT() *MockCheckInRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteQuestionnaire mocks base method.
+func (m *MockCheckInRepositoryInterface) DeleteQuestionnaire(ctx context.Context, params *domain.CheckInQuestionnaireDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestionnaire", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestionnaire indicates an expected call of DeleteQuestionnaire.
+func (mr *MockCheckInRepositoryInterfaceMockRecorder) DeleteQuestionnaire(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestionnaire", reflect.TypeOf((*MockCheckInRepositoryInterface)(nil).DeleteQuestionnaire), ctx, params)
+}
+
+// GetQuestionnaire mocks base method.
+func (m *MockCheckInRepositoryInterface) GetQuestionnaire(ctx context.Context, params *domain.CheckInQuestionnaireGetDomain) (*repository.CheckInQuestionnaireResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionnaire", ctx, params)
+	ret0, _ := ret[0].(*repository.CheckInQuestionnaireResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestionnaire indicates an expected call of GetQuestionnaire.
+func (mr *MockCheckInRepositoryInterfaceMockRecorder) GetQuestionnaire(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionnaire", reflect.TypeOf((*MockCheckInRepositoryInterface)(nil).GetQuestionnaire), ctx, params)
+}
+
+// ListResponses mocks base method.
+func (m *MockCheckInRepositoryInterface) ListResponses(ctx context.Context, params *domain.CheckInResponseListDomain) (*repository.CheckInResponseListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResponses", ctx, params)
+	ret0, _ := ret[0].(*repository.CheckInResponseListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResponses indicates an expected call of ListResponses.
+func (mr *MockCheckInRepositoryInterfaceMockRecorder) ListResponses(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResponses", reflect.TypeOf((*MockCheckInRepositoryInterface)(nil).ListResponses), ctx, params)
+}
+
+// SetQuestionnaire mocks base method.
+func (m *MockCheckInRepositoryInterface) SetQuestionnaire(ctx context.Context, params *domain.CheckInQuestionnaireSetDomain) (*repository.CheckInQuestionnaireResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuestionnaire", ctx, params)
+	ret0, _ := ret[0].(*repository.CheckInQuestionnaireResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuestionnaire indicates an expected call of SetQuestionnaire.
+func (mr *MockCheckInRepositoryInterfaceMockRecorder) SetQuestionnaire(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuestionnaire", reflect.TypeOf((*MockCheckInRepositoryInterface)(nil).SetQuestionnaire), ctx, params)
+}
+
+// SubmitResponse mocks base method.
+func (m *MockCheckInRepositoryInterface) SubmitResponse(ctx context.Context, params *domain.CheckInResponseSubmitDomain) (*repository.CheckInResponseResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitResponse", ctx, params)
+	ret0, _ := ret[0].(*repository.CheckInResponseResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitResponse indicates an expected call of SubmitResponse.
+func (mr *MockCheckInRepositoryInterfaceMockRecorder) SubmitResponse(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitResponse", reflect.TypeOf((*MockCheckInRepositoryInterface)(nil).SubmitResponse), ctx, params)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/contacts_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/contacts_repository.go -destination=internal/api/repository/mocks/mock_contacts_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContactRepositoryInterface is a mock of ContactRepositoryInterface interface.
+type MockContactRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockContactRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockContactRepositoryInterfaceMockRecorder is the mock recorder for MockContactRepositoryInterface.
+type MockContactRepositoryInterfaceMockRecorder struct {
+	mock *MockContactRepositoryInterface
+}
+
+// NewMockContactRepositoryInterface creates a new mock instance.
+func NewMockContactRepositoryInterface(ctrl *gomock.Controller) *MockContactRepositoryInterface {
+	mock := &MockContactRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockContactRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContactRepositoryInterface) EXPECT() *MockContactRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateContact mocks base method.
+func (m *MockContactRepositoryInterface) CreateContact(ctx context.Context, params *domain.ContactCreateDomain) (*repository.ContactCreateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContact", ctx, params)
+	ret0, _ := ret[0].(*repository.ContactCreateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContact indicates an expected call of CreateContact.
+func (mr *MockContactRepositoryInterfaceMockRecorder) CreateContact(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContact", reflect.TypeOf((*MockContactRepositoryInterface)(nil).CreateContact), ctx, params)
+}
+
+// DeleteContact mocks base method.
+func (m *MockContactRepositoryInterface) DeleteContact(ctx context.Context, params *domain.ContactDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContact", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContact indicates an expected call of DeleteContact.
+func (mr *MockContactRepositoryInterfaceMockRecorder) DeleteContact(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockContactRepositoryInterface)(nil).DeleteContact), ctx, params)
+}
+
+// ListContacts mocks base method.
+func (m *MockContactRepositoryInterface) ListContacts(ctx context.Context, params *domain.ContactListDomain) (*repository.ContactListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContacts", ctx, params)
+	ret0, _ := ret[0].(*repository.ContactListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContacts indicates an expected call of ListContacts.
+func (mr *MockContactRepositoryInterfaceMockRecorder) ListContacts(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContacts", reflect.TypeOf((*MockContactRepositoryInterface)(nil).ListContacts), ctx, params)
+}
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/devices_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/devices_repository.go -destination=internal/api/repository/mocks/mock_devices_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	notify "go-version/internal/notify"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeviceRepositoryInterface is a mock of DeviceRepositoryInterface interface.
+type MockDeviceRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeviceRepositoryInterfaceMockRecorder is the mock recorder for MockDeviceRepositoryInterface.
+type MockDeviceRepositoryInterfaceMockRecorder struct {
+	mock *MockDeviceRepositoryInterface
+}
+
+// NewMockDeviceRepositoryInterface creates a new mock instance.
+func NewMockDeviceRepositoryInterface(ctrl *gomock.Controller) *MockDeviceRepositoryInterface {
+	mock := &MockDeviceRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockDeviceRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceRepositoryInterface) EXPECT() *MockDeviceRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteDevice mocks base method.
+func (m *MockDeviceRepositoryInterface) DeleteDevice(ctx context.Context, params *domain.DeviceDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevice", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevice indicates an expected call of DeleteDevice.
+func (mr *MockDeviceRepositoryInterfaceMockRecorder) DeleteDevice(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockDeviceRepositoryInterface)(nil).DeleteDevice), ctx, params)
+}
+
+// ListDevices mocks base method.
+func (m *MockDeviceRepositoryInterface) ListDevices(ctx context.Context, params *domain.DeviceListDomain) (*repository.DeviceListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDevices", ctx, params)
+	ret0, _ := ret[0].(*repository.DeviceListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDevices indicates an expected call of ListDevices.
+func (mr *MockDeviceRepositoryInterfaceMockRecorder) ListDevices(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDevices", reflect.TypeOf((*MockDeviceRepositoryInterface)(nil).ListDevices), ctx, params)
+}
+
+// RegisterDevice mocks base method.
+func (m *MockDeviceRepositoryInterface) RegisterDevice(ctx context.Context, params *domain.DeviceCreateDomain) (*repository.DeviceCreateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDevice", ctx, params)
+	ret0, _ := ret[0].(*repository.DeviceCreateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDevice indicates an expected call of RegisterDevice.
+func (mr *MockDeviceRepositoryInterfaceMockRecorder) RegisterDevice(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDevice", reflect.TypeOf((*MockDeviceRepositoryInterface)(nil).RegisterDevice), ctx, params)
+}
+
+// Send mocks base method.
+func (m *MockDeviceRepositoryInterface) Send(ctx context.Context, msg *notify.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockDeviceRepositoryInterfaceMockRecorder) Send(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockDeviceRepositoryInterface)(nil).Send), ctx, msg)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/digest_subscriptions_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/digest_subscriptions_repository.go -destination=internal/api/repository/mocks/mock_digest_subscriptions_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDigestSubscriptionRepositoryInterface is a mock of DigestSubscriptionRepositoryInterface interface.
+type MockDigestSubscriptionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestSubscriptionRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDigestSubscriptionRepositoryInterfaceMockRecorder is the mock recorder for MockDigestSubscriptionRepositoryInterface.
+type MockDigestSubscriptionRepositoryInterfaceMockRecorder struct {
+	mock *MockDigestSubscriptionRepositoryInterface
+}
+
+// NewMockDigestSubscriptionRepositoryInterface creates a new mock instance.
+func NewMockDigestSubscriptionRepositoryInterface(ctrl *gomock.Controller) *MockDigestSubscriptionRepositoryInterface {
+	mock := &MockDigestSubscriptionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockDigestSubscriptionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestSubscriptionRepositoryInterface) EXPECT() *MockDigestSubscriptionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteDigestSubscription mocks base method.
+func (m *MockDigestSubscriptionRepositoryInterface) DeleteDigestSubscription(ctx context.Context, params *domain.DigestSubscriptionDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDigestSubscription", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDigestSubscription indicates an expected call of DeleteDigestSubscription.
+func (mr *MockDigestSubscriptionRepositoryInterfaceMockRecorder) DeleteDigestSubscription(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDigestSubscription", reflect.TypeOf((*MockDigestSubscriptionRepositoryInterface)(nil).DeleteDigestSubscription), ctx, params)
+}
+
+// GetDigestSubscription mocks base method.
+func (m *MockDigestSubscriptionRepositoryInterface) GetDigestSubscription(ctx context.Context, params *domain.DigestSubscriptionGetDomain) (*repository.DigestSubscriptionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestSubscription", ctx, params)
+	ret0, _ := ret[0].(*repository.DigestSubscriptionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestSubscription indicates an expected call of GetDigestSubscription.
+func (mr *MockDigestSubscriptionRepositoryInterfaceMockRecorder) GetDigestSubscription(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestSubscription", reflect.TypeOf((*MockDigestSubscriptionRepositoryInterface)(nil).GetDigestSubscription), ctx, params)
+}
+
+// SetDigestSubscription mocks base method.
+func (m *MockDigestSubscriptionRepositoryInterface) SetDigestSubscription(ctx context.Context, params *domain.DigestSubscriptionSetDomain) (*repository.DigestSubscriptionResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDigestSubscription", ctx, params)
+	ret0, _ := ret[0].(*repository.DigestSubscriptionResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDigestSubscription indicates an expected call of SetDigestSubscription.
+func (mr *MockDigestSubscriptionRepositoryInterfaceMockRecorder) SetDigestSubscription(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDigestSubscription", reflect.TypeOf((*MockDigestSubscriptionRepositoryInterface)(nil).SetDigestSubscription), ctx, params)
+}
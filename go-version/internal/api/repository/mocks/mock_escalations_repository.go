@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/escalations_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/escalations_repository.go -destination=internal/api/repository/mocks/mock_escalations_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEscalationRepositoryInterface is a mock of EscalationRepositoryInterface interface.
+type MockEscalationRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEscalationRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockEscalationRepositoryInterfaceMockRecorder is the mock recorder for MockEscalationRepositoryInterface.
+type MockEscalationRepositoryInterfaceMockRecorder struct {
+	mock *MockEscalationRepositoryInterface
+}
+
+// NewMockEscalationRepositoryInterface creates a new mock instance.
+func NewMockEscalationRepositoryInterface(ctrl *gomock.Controller) *MockEscalationRepositoryInterface {
+	mock := &MockEscalationRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockEscalationRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEscalationRepositoryInterface) EXPECT() *MockEscalationRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AcknowledgeOccurrence mocks base method.
+func (m *MockEscalationRepositoryInterface) AcknowledgeOccurrence(ctx context.Context, params *domain.ReminderAcknowledgeDomain) (*repository.ReminderAcknowledgeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeOccurrence", ctx, params)
+	ret0, _ := ret[0].(*repository.ReminderAcknowledgeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeOccurrence indicates an expected call of AcknowledgeOccurrence.
+func (mr *MockEscalationRepositoryInterfaceMockRecorder) AcknowledgeOccurrence(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeOccurrence", reflect.TypeOf((*MockEscalationRepositoryInterface)(nil).AcknowledgeOccurrence), ctx, params)
+}
+
+// DeletePolicy mocks base method.
+func (m *MockEscalationRepositoryInterface) DeletePolicy(ctx context.Context, params *domain.EscalationPolicyDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicy", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicy indicates an expected call of DeletePolicy.
+func (mr *MockEscalationRepositoryInterfaceMockRecorder) DeletePolicy(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockEscalationRepositoryInterface)(nil).DeletePolicy), ctx, params)
+}
+
+// GetPolicy mocks base method.
+func (m *MockEscalationRepositoryInterface) GetPolicy(ctx context.Context, params *domain.EscalationPolicyGetDomain) (*repository.EscalationPolicyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", ctx, params)
+	ret0, _ := ret[0].(*repository.EscalationPolicyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockEscalationRepositoryInterfaceMockRecorder) GetPolicy(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockEscalationRepositoryInterface)(nil).GetPolicy), ctx, params)
+}
+
+// ListEvents mocks base method.
+func (m *MockEscalationRepositoryInterface) ListEvents(ctx context.Context, params *domain.EscalationEventListDomain) (*repository.EscalationEventListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, params)
+	ret0, _ := ret[0].(*repository.EscalationEventListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockEscalationRepositoryInterfaceMockRecorder) ListEvents(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEscalationRepositoryInterface)(nil).ListEvents), ctx, params)
+}
+
+// SetPolicy mocks base method.
+func (m *MockEscalationRepositoryInterface) SetPolicy(ctx context.Context, params *domain.EscalationPolicySetDomain) (*repository.EscalationPolicyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPolicy", ctx, params)
+	ret0, _ := ret[0].(*repository.EscalationPolicyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPolicy indicates an expected call of SetPolicy.
+func (mr *MockEscalationRepositoryInterfaceMockRecorder) SetPolicy(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPolicy", reflect.TypeOf((*MockEscalationRepositoryInterface)(nil).SetPolicy), ctx, params)
+}
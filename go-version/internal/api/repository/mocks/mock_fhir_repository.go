@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/fhir_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/fhir_repository.go -destination=internal/api/repository/mocks/mock_fhir_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	fhir "go-version/internal/fhir"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFHIRRepositoryInterface is a mock of FHIRRepositoryInterface interface.
+type MockFHIRRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockFHIRRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockFHIRRepositoryInterfaceMockRecorder is the mock recorder for MockFHIRRepositoryInterface.
+type MockFHIRRepositoryInterfaceMockRecorder struct {
+	mock *MockFHIRRepositoryInterface
+}
+
+// NewMockFHIRRepositoryInterface creates a new mock instance.
+func NewMockFHIRRepositoryInterface(ctrl *gomock.Controller) *MockFHIRRepositoryInterface {
+	mock := &MockFHIRRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockFHIRRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFHIRRepositoryInterface) EXPECT() *MockFHIRRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateMedicationRequests mocks base method.
+func (m *MockFHIRRepositoryInterface) CreateMedicationRequests(ctx context.Context, params *domain.FHIRMedicationCreateDomain) (*fhir.Bundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMedicationRequests", ctx, params)
+	ret0, _ := ret[0].(*fhir.Bundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMedicationRequests indicates an expected call of CreateMedicationRequests.
+func (mr *MockFHIRRepositoryInterfaceMockRecorder) CreateMedicationRequests(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMedicationRequests", reflect.TypeOf((*MockFHIRRepositoryInterface)(nil).CreateMedicationRequests), ctx, params)
+}
+
+// ListMedicationRequests mocks base method.
+func (m *MockFHIRRepositoryInterface) ListMedicationRequests(ctx context.Context, params *domain.FHIRMedicationListDomain) (*fhir.Bundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMedicationRequests", ctx, params)
+	ret0, _ := ret[0].(*fhir.Bundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMedicationRequests indicates an expected call of ListMedicationRequests.
+func (mr *MockFHIRRepositoryInterfaceMockRecorder) ListMedicationRequests(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMedicationRequests", reflect.TypeOf((*MockFHIRRepositoryInterface)(nil).ListMedicationRequests), ctx, params)
+}
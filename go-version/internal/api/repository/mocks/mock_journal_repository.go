@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/journal_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/journal_repository.go -destination=internal/api/repository/mocks/mock_journal_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJournalRepositoryInterface is a mock of JournalRepositoryInterface interface.
+type MockJournalRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockJournalRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockJournalRepositoryInterfaceMockRecorder is the mock recorder for MockJournalRepositoryInterface.
+type MockJournalRepositoryInterfaceMockRecorder struct {
+	mock *MockJournalRepositoryInterface
+}
+
+// NewMockJournalRepositoryInterface creates a new mock instance.
+func NewMockJournalRepositoryInterface(ctrl *gomock.Controller) *MockJournalRepositoryInterface {
+	mock := &MockJournalRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockJournalRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJournalRepositoryInterface) EXPECT() *MockJournalRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateEntry mocks base method.
+func (m *MockJournalRepositoryInterface) CreateEntry(ctx context.Context, params *domain.JournalEntryCreateDomain) (*repository.JournalEntryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, params)
+	ret0, _ := ret[0].(*repository.JournalEntryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockJournalRepositoryInterfaceMockRecorder) CreateEntry(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockJournalRepositoryInterface)(nil).CreateEntry), ctx, params)
+}
+
+// DeleteEntry mocks base method.
+func (m *MockJournalRepositoryInterface) DeleteEntry(ctx context.Context, params *domain.JournalEntryDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntry", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEntry indicates an expected call of DeleteEntry.
+func (mr *MockJournalRepositoryInterfaceMockRecorder) DeleteEntry(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockJournalRepositoryInterface)(nil).DeleteEntry), ctx, params)
+}
+
+// GetEntry mocks base method.
+func (m *MockJournalRepositoryInterface) GetEntry(ctx context.Context, params *domain.JournalEntryGetDomain) (*repository.JournalEntryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, params)
+	ret0, _ := ret[0].(*repository.JournalEntryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockJournalRepositoryInterfaceMockRecorder) GetEntry(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockJournalRepositoryInterface)(nil).GetEntry), ctx, params)
+}
+
+// ListEntries mocks base method.
+func (m *MockJournalRepositoryInterface) ListEntries(ctx context.Context, params *domain.JournalEntryListDomain) (*repository.JournalEntryListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, params)
+	ret0, _ := ret[0].(*repository.JournalEntryListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockJournalRepositoryInterfaceMockRecorder) ListEntries(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockJournalRepositoryInterface)(nil).ListEntries), ctx, params)
+}
+
+// Timeline mocks base method.
+func (m *MockJournalRepositoryInterface) Timeline(ctx context.Context, params *domain.TimelineDomain) (*repository.TimelineResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Timeline", ctx, params)
+	ret0, _ := ret[0].(*repository.TimelineResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Timeline indicates an expected call of Timeline.
+func (mr *MockJournalRepositoryInterfaceMockRecorder) Timeline(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Timeline", reflect.TypeOf((*MockJournalRepositoryInterface)(nil).Timeline), ctx, params)
+}
+
+// UpdateEntry mocks base method.
+func (m *MockJournalRepositoryInterface) UpdateEntry(ctx context.Context, params *domain.JournalEntryUpdateDomain) (*repository.JournalEntryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntry", ctx, params)
+	ret0, _ := ret[0].(*repository.JournalEntryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEntry indicates an expected call of UpdateEntry.
+func (mr *MockJournalRepositoryInterfaceMockRecorder) UpdateEntry(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntry", reflect.TypeOf((*MockJournalRepositoryInterface)(nil).UpdateEntry), ctx, params)
+}
@@ -0,0 +1,235 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/organizations_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/organizations_repository.go -destination=internal/api/repository/mocks/mock_organizations_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganizationRepositoryInterface is a mock of OrganizationRepositoryInterface interface.
+type MockOrganizationRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganizationRepositoryInterfaceMockRecorder is the mock recorder for MockOrganizationRepositoryInterface.
+type MockOrganizationRepositoryInterfaceMockRecorder struct {
+	mock *MockOrganizationRepositoryInterface
+}
+
+// NewMockOrganizationRepositoryInterface creates a new mock instance.
+func NewMockOrganizationRepositoryInterface(ctrl *gomock.Controller) *MockOrganizationRepositoryInterface {
+	mock := &MockOrganizationRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockOrganizationRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationRepositoryInterface) EXPECT() *MockOrganizationRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ConsentEnrollment mocks base method.
+func (m *MockOrganizationRepositoryInterface) ConsentEnrollment(ctx context.Context, params *domain.EnrollmentConsentDomain) (*repository.PatientEnrollmentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsentEnrollment", ctx, params)
+	ret0, _ := ret[0].(*repository.PatientEnrollmentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsentEnrollment indicates an expected call of ConsentEnrollment.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) ConsentEnrollment(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsentEnrollment", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).ConsentEnrollment), ctx, params)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockOrganizationRepositoryInterface) CreateOrganization(ctx context.Context, params *domain.OrganizationCreateDomain) (*repository.OrganizationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, params)
+	ret0, _ := ret[0].(*repository.OrganizationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) CreateOrganization(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).CreateOrganization), ctx, params)
+}
+
+// DeleteEnrollment mocks base method.
+func (m *MockOrganizationRepositoryInterface) DeleteEnrollment(ctx context.Context, params *domain.EnrollmentDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnrollment", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnrollment indicates an expected call of DeleteEnrollment.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) DeleteEnrollment(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollment", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).DeleteEnrollment), ctx, params)
+}
+
+// DeleteMember mocks base method.
+func (m *MockOrganizationRepositoryInterface) DeleteMember(ctx context.Context, params *domain.OrganizationMemberDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) DeleteMember(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).DeleteMember), ctx, params)
+}
+
+// EnrollPatient mocks base method.
+func (m *MockOrganizationRepositoryInterface) EnrollPatient(ctx context.Context, params *domain.PatientEnrollDomain) (*repository.PatientEnrollmentResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollPatient", ctx, params)
+	ret0, _ := ret[0].(*repository.PatientEnrollmentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollPatient indicates an expected call of EnrollPatient.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) EnrollPatient(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollPatient", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).EnrollPatient), ctx, params)
+}
+
+// GetPatientAdherence mocks base method.
+func (m *MockOrganizationRepositoryInterface) GetPatientAdherence(ctx context.Context, params *domain.PatientAdherenceDomain) (*repository.AdherenceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPatientAdherence", ctx, params)
+	ret0, _ := ret[0].(*repository.AdherenceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPatientAdherence indicates an expected call of GetPatientAdherence.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) GetPatientAdherence(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPatientAdherence", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).GetPatientAdherence), ctx, params)
+}
+
+// ListEnrollments mocks base method.
+func (m *MockOrganizationRepositoryInterface) ListEnrollments(ctx context.Context, params *domain.EnrollmentListDomain) (*repository.EnrollmentListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollments", ctx, params)
+	ret0, _ := ret[0].(*repository.EnrollmentListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnrollments indicates an expected call of ListEnrollments.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) ListEnrollments(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollments", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).ListEnrollments), ctx, params)
+}
+
+// ListMembers mocks base method.
+func (m *MockOrganizationRepositoryInterface) ListMembers(ctx context.Context, params *domain.OrganizationMemberListDomain) (*repository.OrganizationMemberListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, params)
+	ret0, _ := ret[0].(*repository.OrganizationMemberListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) ListMembers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).ListMembers), ctx, params)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockOrganizationRepositoryInterface) ListOrganizations(ctx context.Context, params *domain.OrganizationListDomain) (*repository.OrganizationListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", ctx, params)
+	ret0, _ := ret[0].(*repository.OrganizationListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) ListOrganizations(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).ListOrganizations), ctx, params)
+}
+
+// ListPatientReminders mocks base method.
+func (m *MockOrganizationRepositoryInterface) ListPatientReminders(ctx context.Context, params *domain.PatientReminderListDomain) (*repository.ReminderListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPatientReminders", ctx, params)
+	ret0, _ := ret[0].(*repository.ReminderListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPatientReminders indicates an expected call of ListPatientReminders.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) ListPatientReminders(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPatientReminders", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).ListPatientReminders), ctx, params)
+}
+
+// ListPatients mocks base method.
+func (m *MockOrganizationRepositoryInterface) ListPatients(ctx context.Context, params *domain.PatientListDomain) (*repository.PatientListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPatients", ctx, params)
+	ret0, _ := ret[0].(*repository.PatientListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPatients indicates an expected call of ListPatients.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) ListPatients(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPatients", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).ListPatients), ctx, params)
+}
+
+// SetMember mocks base method.
+func (m *MockOrganizationRepositoryInterface) SetMember(ctx context.Context, params *domain.OrganizationMemberSetDomain) (*repository.OrganizationMemberResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMember", ctx, params)
+	ret0, _ := ret[0].(*repository.OrganizationMemberResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMember indicates an expected call of SetMember.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) SetMember(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMember", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).SetMember), ctx, params)
+}
+
+// UnenrollPatient mocks base method.
+func (m *MockOrganizationRepositoryInterface) UnenrollPatient(ctx context.Context, params *domain.PatientUnenrollDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnenrollPatient", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnenrollPatient indicates an expected call of UnenrollPatient.
+func (mr *MockOrganizationRepositoryInterfaceMockRecorder) UnenrollPatient(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnenrollPatient", reflect.TypeOf((*MockOrganizationRepositoryInterface)(nil).UnenrollPatient), ctx, params)
+}
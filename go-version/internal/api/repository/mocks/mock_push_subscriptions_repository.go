@@ -0,0 +1,170 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/push_subscriptions_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/push_subscriptions_repository.go -destination=internal/api/repository/mocks/mock_push_subscriptions_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	notify "go-version/internal/notify"
+	webpush "go-version/internal/webpush"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPushSubscriptionRepositoryInterface is a mock of PushSubscriptionRepositoryInterface interface.
+type MockPushSubscriptionRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPushSubscriptionRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPushSubscriptionRepositoryInterfaceMockRecorder is the mock recorder for MockPushSubscriptionRepositoryInterface.
+type MockPushSubscriptionRepositoryInterfaceMockRecorder struct {
+	mock *MockPushSubscriptionRepositoryInterface
+}
+
+// NewMockPushSubscriptionRepositoryInterface creates a new mock instance.
+func NewMockPushSubscriptionRepositoryInterface(ctrl *gomock.Controller) *MockPushSubscriptionRepositoryInterface {
+	mock := &MockPushSubscriptionRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockPushSubscriptionRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPushSubscriptionRepositoryInterface) EXPECT() *MockPushSubscriptionRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateSubscription mocks base method.
+func (m *MockPushSubscriptionRepositoryInterface) CreateSubscription(ctx context.Context, params *domain.PushSubscriptionCreateDomain) (*repository.PushSubscriptionCreateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", ctx, params)
+	ret0, _ := ret[0].(*repository.PushSubscriptionCreateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockPushSubscriptionRepositoryInterfaceMockRecorder) CreateSubscription(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockPushSubscriptionRepositoryInterface)(nil).CreateSubscription), ctx, params)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockPushSubscriptionRepositoryInterface) DeleteSubscription(ctx context.Context, params *domain.PushSubscriptionDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockPushSubscriptionRepositoryInterfaceMockRecorder) DeleteSubscription(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockPushSubscriptionRepositoryInterface)(nil).DeleteSubscription), ctx, params)
+}
+
+// GetVapidPublicKey mocks base method.
+func (m *MockPushSubscriptionRepositoryInterface) GetVapidPublicKey(ctx context.Context) (*repository.VapidPublicKeyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVapidPublicKey", ctx)
+	ret0, _ := ret[0].(*repository.VapidPublicKeyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVapidPublicKey indicates an expected call of GetVapidPublicKey.
+func (mr *MockPushSubscriptionRepositoryInterfaceMockRecorder) GetVapidPublicKey(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVapidPublicKey", reflect.TypeOf((*MockPushSubscriptionRepositoryInterface)(nil).GetVapidPublicKey), ctx)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockPushSubscriptionRepositoryInterface) ListSubscriptions(ctx context.Context, params *domain.PushSubscriptionListDomain) (*repository.PushSubscriptionListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, params)
+	ret0, _ := ret[0].(*repository.PushSubscriptionListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockPushSubscriptionRepositoryInterfaceMockRecorder) ListSubscriptions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockPushSubscriptionRepositoryInterface)(nil).ListSubscriptions), ctx, params)
+}
+
+// Send mocks base method.
+func (m *MockPushSubscriptionRepositoryInterface) Send(ctx context.Context, msg *notify.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, msg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPushSubscriptionRepositoryInterfaceMockRecorder) Send(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPushSubscriptionRepositoryInterface)(nil).Send), ctx, msg)
+}
+
+// MockWebPushSender is a mock of WebPushSender interface.
+type MockWebPushSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebPushSenderMockRecorder
+	isgomock struct{}
+}
+
+// MockWebPushSenderMockRecorder is the mock recorder for MockWebPushSender.
+type MockWebPushSenderMockRecorder struct {
+	mock *MockWebPushSender
+}
+
+// NewMockWebPushSender creates a new mock instance.
+func NewMockWebPushSender(ctrl *gomock.Controller) *MockWebPushSender {
+	mock := &MockWebPushSender{ctrl: ctrl}
+	mock.recorder = &MockWebPushSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebPushSender) EXPECT() *MockWebPushSenderMockRecorder {
+	return m.recorder
+}
+
+// PublicKey mocks base method.
+func (m *MockWebPushSender) PublicKey() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKey")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// PublicKey indicates an expected call of PublicKey.
+func (mr *MockWebPushSenderMockRecorder) PublicKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKey", reflect.TypeOf((*MockWebPushSender)(nil).PublicKey))
+}
+
+// Send mocks base method.
+func (m *MockWebPushSender) Send(ctx context.Context, sub webpush.Subscription, payload []byte, opts *webpush.Options) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, sub, payload, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockWebPushSenderMockRecorder) Send(ctx, sub, payload, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebPushSender)(nil).Send), ctx, sub, payload, opts)
+}
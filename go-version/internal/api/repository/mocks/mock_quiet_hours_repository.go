@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/quiet_hours_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/quiet_hours_repository.go -destination=internal/api/repository/mocks/mock_quiet_hours_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuietHoursRepositoryInterface is a mock of QuietHoursRepositoryInterface interface.
+type MockQuietHoursRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuietHoursRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockQuietHoursRepositoryInterfaceMockRecorder is the mock recorder for MockQuietHoursRepositoryInterface.
+type MockQuietHoursRepositoryInterfaceMockRecorder struct {
+	mock *MockQuietHoursRepositoryInterface
+}
+
+// NewMockQuietHoursRepositoryInterface creates a new mock instance.
+func NewMockQuietHoursRepositoryInterface(ctrl *gomock.Controller) *MockQuietHoursRepositoryInterface {
+	mock := &MockQuietHoursRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockQuietHoursRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuietHoursRepositoryInterface) EXPECT() *MockQuietHoursRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteQuietHours mocks base method.
+func (m *MockQuietHoursRepositoryInterface) DeleteQuietHours(ctx context.Context, params *domain.QuietHoursDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuietHours", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuietHours indicates an expected call of DeleteQuietHours.
+func (mr *MockQuietHoursRepositoryInterfaceMockRecorder) DeleteQuietHours(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuietHours", reflect.TypeOf((*MockQuietHoursRepositoryInterface)(nil).DeleteQuietHours), ctx, params)
+}
+
+// GetQuietHours mocks base method.
+func (m *MockQuietHoursRepositoryInterface) GetQuietHours(ctx context.Context, params *domain.QuietHoursGetDomain) (*repository.QuietHoursResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuietHours", ctx, params)
+	ret0, _ := ret[0].(*repository.QuietHoursResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuietHours indicates an expected call of GetQuietHours.
+func (mr *MockQuietHoursRepositoryInterfaceMockRecorder) GetQuietHours(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuietHours", reflect.TypeOf((*MockQuietHoursRepositoryInterface)(nil).GetQuietHours), ctx, params)
+}
+
+// SetQuietHours mocks base method.
+func (m *MockQuietHoursRepositoryInterface) SetQuietHours(ctx context.Context, params *domain.QuietHoursSetDomain) (*repository.QuietHoursResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetQuietHours", ctx, params)
+	ret0, _ := ret[0].(*repository.QuietHoursResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetQuietHours indicates an expected call of SetQuietHours.
+func (mr *MockQuietHoursRepositoryInterfaceMockRecorder) SetQuietHours(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetQuietHours", reflect.TypeOf((*MockQuietHoursRepositoryInterface)(nil).SetQuietHours), ctx, params)
+}
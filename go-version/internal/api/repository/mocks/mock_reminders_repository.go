@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	domain "go-version/internal/api/domain"
+	models "go-version/internal/api/models"
 	repository "go-version/internal/api/repository"
 	reflect "reflect"
 
@@ -71,6 +72,51 @@ func (mr *MockReminderRepositoryInterfaceMockRecorder) DeleteReminder(ctx, param
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockReminderRepositoryInterface)(nil).DeleteReminder), ctx, params)
 }
 
+// GetReminder mocks base method.
+func (m *MockReminderRepositoryInterface) GetReminder(ctx context.Context, params *domain.ReminderGetDomain) (*models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminder", ctx, params)
+	ret0, _ := ret[0].(*models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminder indicates an expected call of GetReminder.
+func (mr *MockReminderRepositoryInterfaceMockRecorder) GetReminder(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminder", reflect.TypeOf((*MockReminderRepositoryInterface)(nil).GetReminder), ctx, params)
+}
+
+// ImportReminders mocks base method.
+func (m *MockReminderRepositoryInterface) ImportReminders(ctx context.Context, params *domain.ReminderImportDomain) (*repository.ReminderImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportReminders", ctx, params)
+	ret0, _ := ret[0].(*repository.ReminderImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportReminders indicates an expected call of ImportReminders.
+func (mr *MockReminderRepositoryInterfaceMockRecorder) ImportReminders(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportReminders", reflect.TypeOf((*MockReminderRepositoryInterface)(nil).ImportReminders), ctx, params)
+}
+
+// ListAgenda mocks base method.
+func (m *MockReminderRepositoryInterface) ListAgenda(ctx context.Context, params *domain.AgendaListDomain) (*repository.AgendaResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAgenda", ctx, params)
+	ret0, _ := ret[0].(*repository.AgendaResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAgenda indicates an expected call of ListAgenda.
+func (mr *MockReminderRepositoryInterfaceMockRecorder) ListAgenda(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAgenda", reflect.TypeOf((*MockReminderRepositoryInterface)(nil).ListAgenda), ctx, params)
+}
+
 // ListReminders mocks base method.
 func (m *MockReminderRepositoryInterface) ListReminders(ctx context.Context, params *domain.ReminderListDomain) (*repository.ReminderListResult, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +132,49 @@ func (mr *MockReminderRepositoryInterfaceMockRecorder) ListReminders(ctx, params
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReminders", reflect.TypeOf((*MockReminderRepositoryInterface)(nil).ListReminders), ctx, params)
 }
 
+// ListUpcoming mocks base method.
+func (m *MockReminderRepositoryInterface) ListUpcoming(ctx context.Context, params *domain.ReminderUpcomingDomain) (*repository.UpcomingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUpcoming", ctx, params)
+	ret0, _ := ret[0].(*repository.UpcomingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUpcoming indicates an expected call of ListUpcoming.
+func (mr *MockReminderRepositoryInterfaceMockRecorder) ListUpcoming(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUpcoming", reflect.TypeOf((*MockReminderRepositoryInterface)(nil).ListUpcoming), ctx, params)
+}
+
+// StreamAgenda mocks base method.
+func (m *MockReminderRepositoryInterface) StreamAgenda(ctx context.Context, params *domain.AgendaListDomain, emit func(*repository.StreamLineResult) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamAgenda", ctx, params, emit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamAgenda indicates an expected call of StreamAgenda.
+func (mr *MockReminderRepositoryInterfaceMockRecorder) StreamAgenda(ctx, params, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamAgenda", reflect.TypeOf((*MockReminderRepositoryInterface)(nil).StreamAgenda), ctx, params, emit)
+}
+
+// StreamReminders mocks base method.
+func (m *MockReminderRepositoryInterface) StreamReminders(ctx context.Context, params *domain.ReminderListDomain, emit func(*repository.StreamLineResult) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamReminders", ctx, params, emit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamReminders indicates an expected call of StreamReminders.
+func (mr *MockReminderRepositoryInterfaceMockRecorder) StreamReminders(ctx, params, emit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamReminders", reflect.TypeOf((*MockReminderRepositoryInterface)(nil).StreamReminders), ctx, params, emit)
+}
+
 // UpdateReminder mocks base method.
 func (m *MockReminderRepositoryInterface) UpdateReminder(ctx context.Context, params *domain.ReminderUpdateDomain) (*repository.ReminderUpdateResult, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,102 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/repository/supplies_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/repository/supplies_repository.go -destination=internal/api/repository/mocks/mock_supplies_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	domain "go-version/internal/api/domain"
+	repository "go-version/internal/api/repository"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSupplyRepositoryInterface is a mock of SupplyRepositoryInterface interface.
+type MockSupplyRepositoryInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSupplyRepositoryInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockSupplyRepositoryInterfaceMockRecorder is the mock recorder for MockSupplyRepositoryInterface.
+type MockSupplyRepositoryInterfaceMockRecorder struct {
+	mock *MockSupplyRepositoryInterface
+}
+
+// NewMockSupplyRepositoryInterface creates a new mock instance.
+func NewMockSupplyRepositoryInterface(ctrl *gomock.Controller) *MockSupplyRepositoryInterface {
+	mock := &MockSupplyRepositoryInterface{ctrl: ctrl}
+	mock.recorder = &MockSupplyRepositoryInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSupplyRepositoryInterface) EXPECT() *MockSupplyRepositoryInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteSupply mocks base method.
+func (m *MockSupplyRepositoryInterface) DeleteSupply(ctx context.Context, params *domain.ReminderSupplyDeleteDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSupply", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSupply indicates an expected call of DeleteSupply.
+func (mr *MockSupplyRepositoryInterfaceMockRecorder) DeleteSupply(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSupply", reflect.TypeOf((*MockSupplyRepositoryInterface)(nil).DeleteSupply), ctx, params)
+}
+
+// GetSupply mocks base method.
+func (m *MockSupplyRepositoryInterface) GetSupply(ctx context.Context, params *domain.ReminderSupplyGetDomain) (*repository.ReminderSupplyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupply", ctx, params)
+	ret0, _ := ret[0].(*repository.ReminderSupplyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupply indicates an expected call of GetSupply.
+func (mr *MockSupplyRepositoryInterfaceMockRecorder) GetSupply(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockSupplyRepositoryInterface)(nil).GetSupply), ctx, params)
+}
+
+// LogDose mocks base method.
+func (m *MockSupplyRepositoryInterface) LogDose(ctx context.Context, params *domain.ReminderDoseLogDomain) (*repository.DoseLogResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogDose", ctx, params)
+	ret0, _ := ret[0].(*repository.DoseLogResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogDose indicates an expected call of LogDose.
+func (mr *MockSupplyRepositoryInterfaceMockRecorder) LogDose(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogDose", reflect.TypeOf((*MockSupplyRepositoryInterface)(nil).LogDose), ctx, params)
+}
+
+// SetSupply mocks base method.
+func (m *MockSupplyRepositoryInterface) SetSupply(ctx context.Context, params *domain.ReminderSupplySetDomain) (*repository.ReminderSupplyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSupply", ctx, params)
+	ret0, _ := ret[0].(*repository.ReminderSupplyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSupply indicates an expected call of SetSupply.
+func (mr *MockSupplyRepositoryInterfaceMockRecorder) SetSupply(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSupply", reflect.TypeOf((*MockSupplyRepositoryInterface)(nil).SetSupply), ctx, params)
+}
@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"go-version/internal/api/authz"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/recurrence"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationRepositoryInterface interface {
+	CreateOrganization(ctx context.Context, params *domain.OrganizationCreateDomain) (*OrganizationResult, error)
+	ListOrganizations(ctx context.Context, params *domain.OrganizationListDomain) (*OrganizationListResult, error)
+	ListMembers(ctx context.Context, params *domain.OrganizationMemberListDomain) (*OrganizationMemberListResult, error)
+	SetMember(ctx context.Context, params *domain.OrganizationMemberSetDomain) (*OrganizationMemberResult, error)
+	DeleteMember(ctx context.Context, params *domain.OrganizationMemberDeleteDomain) error
+	EnrollPatient(ctx context.Context, params *domain.PatientEnrollDomain) (*PatientEnrollmentResult, error)
+	ListPatients(ctx context.Context, params *domain.PatientListDomain) (*PatientListResult, error)
+	UnenrollPatient(ctx context.Context, params *domain.PatientUnenrollDomain) error
+	ListPatientReminders(ctx context.Context, params *domain.PatientReminderListDomain) (*ReminderListResult, error)
+	GetPatientAdherence(ctx context.Context, params *domain.PatientAdherenceDomain) (*AdherenceResult, error)
+	ListEnrollments(ctx context.Context, params *domain.EnrollmentListDomain) (*EnrollmentListResult, error)
+	ConsentEnrollment(ctx context.Context, params *domain.EnrollmentConsentDomain) (*PatientEnrollmentResult, error)
+	DeleteEnrollment(ctx context.Context, params *domain.EnrollmentDeleteDomain) error
+}
+
+// OrganizationRepository gives clinicians access to the reminders of the
+// patients enrolled in their organization. Every organization-scoped
+// request is checked by the authorizer before any patient data is read.
+type OrganizationRepository struct {
+	organizationStore store.OrganizationStoreInterface
+	userStore         store.UserStoreInterface
+	reminderStore     store.ReminderStoreInterface
+	escalationStore   store.EscalationStoreInterface
+	authorizer        *authz.Authorizer
+	limits            recurrence.Limits
+}
+
+func NewOrganizationRepository(organizationStore store.OrganizationStoreInterface, userStore store.UserStoreInterface, reminderStore store.ReminderStoreInterface, escalationStore store.EscalationStoreInterface, authorizer *authz.Authorizer, limits recurrence.Limits) (*OrganizationRepository, error) {
+	return &OrganizationRepository{
+		organizationStore: organizationStore,
+		userStore:         userStore,
+		reminderStore:     reminderStore,
+		escalationStore:   escalationStore,
+		authorizer:        authorizer,
+		limits:            limits,
+	}, nil
+}
+
+// CreateOrganization creates an organization whose first admin is the
+// user.
+func (r *OrganizationRepository) CreateOrganization(ctx context.Context, req *domain.OrganizationCreateDomain) (*OrganizationResult, error) {
+	organization, err := r.organizationStore.CreateOrganization(ctx, &models.Organization{
+		Id:   uuid.New().String(),
+		Name: req.Name,
+	}, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOrganizationResult(organization), nil
+}
+
+func (r *OrganizationRepository) ListOrganizations(ctx context.Context, req *domain.OrganizationListDomain) (*OrganizationListResult, error) {
+	organizations, err := r.organizationStore.ListOrganizations(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOrganizationListResult(organizations), nil
+}
+
+func (r *OrganizationRepository) ListMembers(ctx context.Context, req *domain.OrganizationMemberListDomain) (*OrganizationMemberListResult, error) {
+	if _, err := r.authorizer.RequireRole(ctx, req.UserID, req.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	members, err := r.organizationStore.ListMembers(ctx, req.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOrganizationMemberListResult(members), nil
+}
+
+// SetMember lets an admin add a user to the organization or change their
+// role. The last admin cannot give up the role.
+func (r *OrganizationRepository) SetMember(ctx context.Context, req *domain.OrganizationMemberSetDomain) (*OrganizationMemberResult, error) {
+	if _, err := r.authorizer.RequireRole(ctx, req.UserID, req.OrganizationID, models.OrganizationRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	user, err := r.userStore.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	if req.Role != models.OrganizationRoleAdmin {
+		if err := r.checkNotLastAdmin(ctx, req.OrganizationID, user.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	member, err := r.organizationStore.UpsertMember(ctx, &models.OrganizationMember{
+		OrganizationId: req.OrganizationID,
+		UserId:         user.Id,
+		Role:           req.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOrganizationMemberResult(member), nil
+}
+
+// DeleteMember lets an admin remove a member, or any member leave. The
+// last admin cannot be removed.
+func (r *OrganizationRepository) DeleteMember(ctx context.Context, req *domain.OrganizationMemberDeleteDomain) error {
+	roles := []string{models.OrganizationRoleAdmin}
+	if req.MemberID == req.UserID {
+		roles = nil
+	}
+	if _, err := r.authorizer.RequireRole(ctx, req.UserID, req.OrganizationID, roles...); err != nil {
+		return err
+	}
+
+	if err := r.checkNotLastAdmin(ctx, req.OrganizationID, req.MemberID); err != nil {
+		return err
+	}
+
+	err := r.organizationStore.DeleteMember(ctx, req.OrganizationID, req.MemberID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+// checkNotLastAdmin fails if the user is the organization's only admin.
+func (r *OrganizationRepository) checkNotLastAdmin(ctx context.Context, organizationID, userID string) error {
+	members, err := r.organizationStore.ListMembers(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+
+	admins, isAdmin := 0, false
+	for _, member := range members {
+		if member.Role == models.OrganizationRoleAdmin {
+			admins++
+			isAdmin = isAdmin || member.UserId == userID
+		}
+	}
+	if isAdmin && admins == 1 {
+		return &ErrInvalidOrganizationMember{Reason: "an organization needs at least one admin"}
+	}
+	return nil
+}
+
+// EnrollPatient asks the user whose email it is to join the organization's
+// roster. Their reminders stay private until they consent.
+func (r *OrganizationRepository) EnrollPatient(ctx context.Context, req *domain.PatientEnrollDomain) (*PatientEnrollmentResult, error) {
+	if _, err := r.authorizer.RequireRole(ctx, req.UserID, req.OrganizationID, models.OrganizationRoleAdmin, models.OrganizationRoleClinician); err != nil {
+		return nil, err
+	}
+
+	patient, err := r.userStore.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	enrollment, err := r.organizationStore.UpsertEnrollment(ctx, &models.PatientEnrollment{
+		Id:             uuid.New().String(),
+		OrganizationId: req.OrganizationID,
+		PatientId:      patient.Id,
+		EnrolledBy:     req.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPatientEnrollmentResult(enrollment), nil
+}
+
+func (r *OrganizationRepository) ListPatients(ctx context.Context, req *domain.PatientListDomain) (*PatientListResult, error) {
+	if _, err := r.authorizer.RequireRole(ctx, req.UserID, req.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	enrollments, err := r.organizationStore.ListEnrollments(ctx, req.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPatientListResult(enrollments), nil
+}
+
+func (r *OrganizationRepository) UnenrollPatient(ctx context.Context, req *domain.PatientUnenrollDomain) error {
+	if _, err := r.authorizer.RequireRole(ctx, req.UserID, req.OrganizationID, models.OrganizationRoleAdmin, models.OrganizationRoleClinician); err != nil {
+		return err
+	}
+
+	err := r.organizationStore.DeleteEnrollment(ctx, req.OrganizationID, req.PatientID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+func (r *OrganizationRepository) ListPatientReminders(ctx context.Context, req *domain.PatientReminderListDomain) (*ReminderListResult, error) {
+	if err := r.authorizer.RequirePatient(ctx, req.UserID, req.OrganizationID, req.PatientID); err != nil {
+		return nil, err
+	}
+
+	reminders, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{UserID: req.PatientID})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewReminderListResult(reminders), nil
+}
+
+// GetPatientAdherence reports how many of the patient's occurrences within
+// the range were acknowledged. Occurrences that are not yet due are left
+// out.
+func (r *OrganizationRepository) GetPatientAdherence(ctx context.Context, req *domain.PatientAdherenceDomain) (*AdherenceResult, error) {
+	if err := r.authorizer.RequirePatient(ctx, req.UserID, req.OrganizationID, req.PatientID); err != nil {
+		return nil, err
+	}
+
+	reminders, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{
+		UserID:    req.PatientID,
+		StartDate: &req.StartDate,
+		EndDate:   &req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	acks, err := r.escalationStore.ListAcknowledgements(ctx, req.PatientID, req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return patientAdherence(req.PatientID, req.StartDate, req.EndDate, reminders, acks, time.Now(), r.limits), nil
+}
+
+// ListEnrollments returns the organizations that have enrolled the user
+// as a patient, including those waiting for their consent.
+func (r *OrganizationRepository) ListEnrollments(ctx context.Context, req *domain.EnrollmentListDomain) (*EnrollmentListResult, error) {
+	enrollments, err := r.organizationStore.ListEnrollmentsForPatient(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEnrollmentListResult(enrollments), nil
+}
+
+func (r *OrganizationRepository) ConsentEnrollment(ctx context.Context, req *domain.EnrollmentConsentDomain) (*PatientEnrollmentResult, error) {
+	enrollment, err := r.organizationStore.ConsentEnrollment(ctx, req.EnrollmentID, req.UserID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewPatientEnrollmentResult(enrollment), nil
+}
+
+func (r *OrganizationRepository) DeleteEnrollment(ctx context.Context, req *domain.EnrollmentDeleteDomain) error {
+	err := r.organizationStore.DeleteEnrollmentForPatient(ctx, req.EnrollmentID, req.UserID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+// patientAdherence counts the occurrences due between start and the
+// earlier of end and now, and how many of them were acknowledged.
+func patientAdherence(patientID string, start, end time.Time, reminders []models.Reminder, acks []models.ReminderAcknowledgement, now time.Time, limits recurrence.Limits) *AdherenceResult {
+	if now.Before(end) {
+		end = now
+	}
+
+	acknowledged := make(map[string]bool, len(acks))
+	for _, ack := range acks {
+		acknowledged[ack.ReminderId+"\x00"+ack.OccurrenceAt.UTC().Format(time.RFC3339)] = true
+	}
+
+	result := &AdherenceResult{
+		PatientId: patientID,
+		StartDate: start,
+		EndDate:   end,
+		Reminders: []ReminderAdherenceResult{},
+	}
+	for i := range reminders {
+		reminder := &reminders[i]
+		item := ReminderAdherenceResult{
+			ReminderId:  reminder.Id,
+			Description: reminder.Description,
+			Medication:  reminder.Medication,
+		}
+		if end.After(start) {
+			occurrences, err := reminder.OccurrencesBetween(start, end, limits)
+			if err == nil {
+				for _, occurrence := range occurrences {
+					item.Scheduled++
+					if acknowledged[reminder.Id+"\x00"+occurrence.UTC().Format(time.RFC3339)] {
+						item.Acknowledged++
+					}
+				}
+			}
+		}
+		item.Rate = adherenceRate(item.Acknowledged, item.Scheduled)
+
+		result.Scheduled += item.Scheduled
+		result.Acknowledged += item.Acknowledged
+		result.Reminders = append(result.Reminders, item)
+	}
+	result.Rate = adherenceRate(result.Acknowledged, result.Scheduled)
+
+	return result
+}
+
+// adherenceRate is the share of scheduled occurrences that were
+// acknowledged, or nil when none were scheduled.
+func adherenceRate(acknowledged, scheduled int) *float64 {
+	if scheduled == 0 {
+		return nil
+	}
+	rate := float64(acknowledged) / float64(scheduled)
+	return &rate
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-version/internal/api/authz"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store/mocks"
+	"go-version/internal/recurrence"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestPatientAdherence(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+	// Only the occurrences on March 1st to 4th are due by now.
+	now := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	reminders := []models.Reminder{
+		{Id: "daily", RRule: "FREQ=DAILY", StartAt: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)},
+		{Id: "later", RRule: "FREQ=DAILY", StartAt: time.Date(2024, 3, 6, 9, 0, 0, 0, time.UTC)},
+	}
+	acks := []models.ReminderAcknowledgement{
+		{ReminderId: "daily", OccurrenceAt: time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)},
+		{ReminderId: "daily", OccurrenceAt: time.Date(2024, 3, 3, 10, 0, 0, 0, time.FixedZone("CET", 3600))},
+	}
+
+	result := patientAdherence("patient-1", start, end, reminders, acks, now, recurrence.DefaultLimits())
+
+	if !result.EndDate.Equal(now) {
+		t.Errorf("Expected the range to end now, got %v", result.EndDate)
+	}
+	if result.Scheduled != 4 || result.Acknowledged != 2 {
+		t.Errorf("Expected 2 of 4 occurrences acknowledged, got %d of %d", result.Acknowledged, result.Scheduled)
+	}
+	if result.Rate == nil || *result.Rate != 0.5 {
+		t.Errorf("Expected a rate of 0.5, got %v", result.Rate)
+	}
+	if len(result.Reminders) != 2 {
+		t.Fatalf("Expected 2 reminders, got %d", len(result.Reminders))
+	}
+	if later := result.Reminders[1]; later.Scheduled != 0 || later.Rate != nil {
+		t.Errorf("Expected no rate for a reminder with nothing due, got %+v", later)
+	}
+}
+
+func TestOrganizationRepository_SetMember(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationStore := mocks.NewMockOrganizationStoreInterface(ctrl)
+	userStore := mocks.NewMockUserStoreInterface(ctrl)
+	authorizer, _ := authz.NewAuthorizer(organizationStore)
+
+	admin := models.OrganizationMember{OrganizationId: "org-1", UserId: "admin-1", Role: models.OrganizationRoleAdmin}
+	organizationStore.EXPECT().GetMember(gomock.Any(), "org-1", "admin-1").Return(&admin, nil).Times(1)
+	userStore.EXPECT().GetUserByEmail(gomock.Any(), "admin@example.com").Return(&models.User{Id: "admin-1"}, nil).Times(1)
+	organizationStore.EXPECT().ListMembers(gomock.Any(), "org-1").Return([]models.OrganizationMember{admin}, nil).Times(1)
+
+	repo := &OrganizationRepository{organizationStore: organizationStore, userStore: userStore, authorizer: authorizer}
+
+	_, err := repo.SetMember(context.Background(), &domain.OrganizationMemberSetDomain{
+		UserID:         "admin-1",
+		OrganizationID: "org-1",
+		Email:          "admin@example.com",
+		Role:           models.OrganizationRoleClinician,
+	})
+	var invalidErr *ErrInvalidOrganizationMember
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("Expected the last admin to keep their role, got %v", err)
+	}
+}
+
+func TestOrganizationRepository_ListPatientReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	organizationStore := mocks.NewMockOrganizationStoreInterface(ctrl)
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	authorizer, _ := authz.NewAuthorizer(organizationStore)
+
+	organizationStore.EXPECT().
+		GetMember(gomock.Any(), "org-1", "clinician-1").
+		Return(&models.OrganizationMember{UserId: "clinician-1", Role: models.OrganizationRoleClinician}, nil).
+		Times(1)
+	organizationStore.EXPECT().
+		GetEnrollment(gomock.Any(), "org-1", "patient-1").
+		Return(&models.PatientEnrollment{Status: models.EnrollmentPending}, nil).
+		Times(1)
+	// The patient's reminders must not be read before they consent.
+	reminderStore.EXPECT().ListReminders(gomock.Any(), gomock.Any()).Times(0)
+
+	repo := &OrganizationRepository{organizationStore: organizationStore, reminderStore: reminderStore, authorizer: authorizer}
+
+	_, err := repo.ListPatientReminders(context.Background(), &domain.PatientReminderListDomain{
+		UserID:         "clinician-1",
+		OrganizationID: "org-1",
+		PatientID:      "patient-1",
+	})
+	var forbiddenErr *authz.ErrForbidden
+	if !errors.As(err, &forbiddenErr) {
+		t.Errorf("Expected ErrForbidden, got %v", err)
+	}
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/notify"
+	"go-version/internal/webpush"
+
+	"github.com/google/uuid"
+)
+
+type PushSubscriptionRepositoryInterface interface {
+	ListSubscriptions(ctx context.Context, params *domain.PushSubscriptionListDomain) (*PushSubscriptionListResult, error)
+	CreateSubscription(ctx context.Context, params *domain.PushSubscriptionCreateDomain) (*PushSubscriptionCreateResult, error)
+	DeleteSubscription(ctx context.Context, params *domain.PushSubscriptionDeleteDomain) error
+	GetVapidPublicKey(ctx context.Context) (*VapidPublicKeyResult, error)
+	Send(ctx context.Context, msg *notify.Message) error
+}
+
+// WebPushSender is the subset of webpush.Client used to deliver messages.
+type WebPushSender interface {
+	Send(ctx context.Context, sub webpush.Subscription, payload []byte, opts *webpush.Options) error
+	PublicKey() string
+}
+
+type PushSubscriptionRepository struct {
+	store  store.PushSubscriptionStoreInterface
+	sender WebPushSender
+}
+
+func NewPushSubscriptionRepository(store store.PushSubscriptionStoreInterface, sender WebPushSender) (*PushSubscriptionRepository, error) {
+	return &PushSubscriptionRepository{store: store, sender: sender}, nil
+}
+
+// LoadVAPIDKeys returns the configured VAPID key pair, falling back to the
+// pair stored in the database when none is configured. A pair is generated
+// and stored on first use so that existing browser subscriptions keep
+// working across restarts.
+func LoadVAPIDKeys(ctx context.Context, pushStore store.PushSubscriptionStoreInterface, publicKey, privateKey string) (*webpush.VAPIDKeys, error) {
+	if privateKey != "" {
+		return webpush.ParseVAPIDKeys(publicKey, privateKey)
+	}
+
+	stored, err := pushStore.GetVapidKeys(ctx)
+	if err == nil {
+		return webpush.ParseVAPIDKeys(stored.PublicKey, stored.PrivateKey)
+	}
+	var notFoundErr *store.NoVapidKeysFoundError
+	if !errors.As(err, &notFoundErr) {
+		return nil, err
+	}
+
+	generated, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err = pushStore.CreateVapidKeys(ctx, &models.VapidKeys{
+		PublicKey:  generated.PublicKey(),
+		PrivateKey: generated.PrivateKey(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return webpush.ParseVAPIDKeys(stored.PublicKey, stored.PrivateKey)
+}
+
+func (r *PushSubscriptionRepository) ListSubscriptions(ctx context.Context, req *domain.PushSubscriptionListDomain) (*PushSubscriptionListResult, error) {
+	subscriptions, err := r.store.ListSubscriptions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPushSubscriptionListResult(subscriptions), nil
+}
+
+func (r *PushSubscriptionRepository) CreateSubscription(ctx context.Context, req *domain.PushSubscriptionCreateDomain) (*PushSubscriptionCreateResult, error) {
+	newSubscription := &models.PushSubscription{
+		Id:        uuid.New().String(),
+		UserId:    req.UserID,
+		Endpoint:  req.Endpoint,
+		P256dh:    req.P256dh,
+		Auth:      req.Auth,
+		UserAgent: req.UserAgent,
+	}
+
+	createdSubscription, err := r.store.UpsertSubscription(ctx, newSubscription)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPushSubscriptionCreateResult(createdSubscription), nil
+}
+
+func (r *PushSubscriptionRepository) DeleteSubscription(ctx context.Context, req *domain.PushSubscriptionDeleteDomain) error {
+	err := r.store.DeleteSubscription(ctx, req.UserID, req.SubscriptionID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
+
+func (r *PushSubscriptionRepository) GetVapidPublicKey(ctx context.Context) (*VapidPublicKeyResult, error) {
+	if r.sender == nil {
+		return nil, &ErrPushNotConfigured{}
+	}
+	return &VapidPublicKeyResult{PublicKey: r.sender.PublicKey()}, nil
+}
+
+type webPushPayload struct {
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	ReminderID   string    `json:"reminder_id"`
+	OccurrenceAt time.Time `json:"occurrence_at"`
+}
+
+// Send delivers the message to every browser the user has subscribed.
+// Subscriptions the push service reports as gone are removed; other failures
+// are collected and returned once every subscription has been tried.
+func (r *PushSubscriptionRepository) Send(ctx context.Context, msg *notify.Message) error {
+	if r.sender == nil {
+		return &ErrPushNotConfigured{}
+	}
+
+	subscriptions, err := r.store.ListSubscriptions(ctx, msg.UserID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(webPushPayload{
+		Title:        msg.Title,
+		Body:         msg.Body,
+		ReminderID:   msg.ReminderID,
+		OccurrenceAt: msg.OccurrenceAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, sub := range subscriptions {
+		err := r.sender.Send(ctx, webpush.Subscription{
+			Endpoint: sub.Endpoint,
+			P256dh:   sub.P256dh,
+			Auth:     sub.Auth,
+		}, payload, &webpush.Options{Urgency: webpush.UrgencyHigh})
+
+		var goneErr *webpush.ErrSubscriptionGone
+		if errors.As(err, &goneErr) {
+			if err := r.store.DeleteSubscriptionByEndpoint(ctx, sub.Endpoint); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %s: %w", sub.Id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/api/store/mocks"
+	"go-version/internal/notify"
+	"go-version/internal/webpush"
+
+	"go.uber.org/mock/gomock"
+)
+
+func newTestPushSubscription(t *testing.T, id, endpoint string) models.PushSubscription {
+	t.Helper()
+	privateKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	authSecret := make([]byte, 16)
+	rand.Read(authSecret)
+
+	return models.PushSubscription{
+		Id:       id,
+		UserId:   "user-123",
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(privateKey.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(authSecret),
+	}
+}
+
+func TestPushSubscriptionRepository_CreateSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockPushSubscriptionStoreInterface(ctrl)
+
+	mockStore.EXPECT().
+		UpsertSubscription(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, sub *models.PushSubscription) (*models.PushSubscription, error) {
+			if sub.UserId != "user-123" {
+				t.Errorf("Expected UserId 'user-123', got %s", sub.UserId)
+			}
+			if sub.Id == "" {
+				t.Error("Expected generated UUID for ID")
+			}
+			return sub, nil
+		}).
+		Times(1)
+
+	repo := &PushSubscriptionRepository{store: mockStore}
+
+	result, err := repo.CreateSubscription(context.Background(), &domain.PushSubscriptionCreateDomain{
+		UserID:   "user-123",
+		Endpoint: "https://push.example.com/abc",
+		P256dh:   "key",
+		Auth:     "secret",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if *result.Endpoint != "https://push.example.com/abc" {
+		t.Errorf("Expected endpoint to be returned, got %s", *result.Endpoint)
+	}
+}
+
+func TestPushSubscriptionRepository_DeleteSubscription(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockPushSubscriptionStoreInterface(ctrl)
+	mockStore.EXPECT().
+		DeleteSubscription(gomock.Any(), "user-123", "missing").
+		Return(&store.NoPushSubscriptionFoundError{ID: "missing"}).
+		Times(1)
+
+	repo := &PushSubscriptionRepository{store: mockStore}
+
+	err := repo.DeleteSubscription(context.Background(), &domain.PushSubscriptionDeleteDomain{
+		UserID:         "user-123",
+		SubscriptionID: "missing",
+	})
+	var noResourceErr *NoResourceFoundError
+	if !errors.As(err, &noResourceErr) {
+		t.Errorf("Expected NoResourceFoundError, got %v", err)
+	}
+}
+
+func TestPushSubscriptionRepository_Send(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Local stand-in for a push service: one endpoint accepts messages, the
+	// other reports the subscription as expired.
+	delivered := 0
+	pushService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/active":
+			delivered++
+			w.WriteHeader(http.StatusCreated)
+		case "/expired":
+			w.WriteHeader(http.StatusGone)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer pushService.Close()
+
+	keys, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatalf("GenerateVAPIDKeys returned error: %v", err)
+	}
+	client := webpush.NewClient(keys, "mailto:test@example.com", pushService.Client())
+
+	testCases := []struct {
+		name              string
+		subscriptions     []models.PushSubscription
+		setupMock         func(subs []models.PushSubscription) store.PushSubscriptionStoreInterface
+		expectedError     bool
+		expectedDelivered int
+	}{
+		{
+			name: "delivers to active and removes expired subscriptions",
+			subscriptions: []models.PushSubscription{
+				newTestPushSubscription(t, "sub-1", pushService.URL+"/active"),
+				newTestPushSubscription(t, "sub-2", pushService.URL+"/expired"),
+			},
+			setupMock: func(subs []models.PushSubscription) store.PushSubscriptionStoreInterface {
+				mockStore := mocks.NewMockPushSubscriptionStoreInterface(ctrl)
+				mockStore.EXPECT().ListSubscriptions(gomock.Any(), "user-123").Return(subs, nil).Times(1)
+				mockStore.EXPECT().DeleteSubscriptionByEndpoint(gomock.Any(), pushService.URL+"/expired").Return(nil).Times(1)
+				return mockStore
+			},
+			expectedError:     false,
+			expectedDelivered: 1,
+		},
+		{
+			name: "reports push service failures",
+			subscriptions: []models.PushSubscription{
+				newTestPushSubscription(t, "sub-3", pushService.URL+"/broken"),
+			},
+			setupMock: func(subs []models.PushSubscription) store.PushSubscriptionStoreInterface {
+				mockStore := mocks.NewMockPushSubscriptionStoreInterface(ctrl)
+				mockStore.EXPECT().ListSubscriptions(gomock.Any(), "user-123").Return(subs, nil).Times(1)
+				return mockStore
+			},
+			expectedError:     true,
+			expectedDelivered: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			delivered = 0
+			pushStore := tc.setupMock(tc.subscriptions)
+
+			repo := &PushSubscriptionRepository{store: pushStore, sender: client}
+
+			err := repo.Send(context.Background(), &notify.Message{
+				UserID:       "user-123",
+				ReminderID:   "reminder-1",
+				Title:        "Reminder",
+				Body:         "Take medication",
+				OccurrenceAt: time.Now(),
+			})
+
+			if (err != nil) != tc.expectedError {
+				t.Errorf("Send() error = %v; want error: %v", err, tc.expectedError)
+			}
+			if delivered != tc.expectedDelivered {
+				t.Errorf("Expected %d deliveries, got %d", tc.expectedDelivered, delivered)
+			}
+		})
+	}
+}
+
+func TestLoadVAPIDKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockPushSubscriptionStoreInterface(ctrl)
+
+	var stored *models.VapidKeys
+	mockStore.EXPECT().
+		GetVapidKeys(gomock.Any()).
+		Return(nil, &store.NoVapidKeysFoundError{}).
+		Times(1)
+	mockStore.EXPECT().
+		CreateVapidKeys(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, keys *models.VapidKeys) (*models.VapidKeys, error) {
+			stored = keys
+			return keys, nil
+		}).
+		Times(1)
+
+	keys, err := LoadVAPIDKeys(context.Background(), mockStore, "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if stored == nil || keys.PublicKey() != stored.PublicKey {
+		t.Errorf("Expected generated keys to be stored and returned")
+	}
+
+	mockStore.EXPECT().GetVapidKeys(gomock.Any()).Return(stored, nil).Times(1)
+
+	reloaded, err := LoadVAPIDKeys(context.Background(), mockStore, "", "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if reloaded.PublicKey() != keys.PublicKey() {
+		t.Errorf("Expected stored keys to be reused")
+	}
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+)
+
+type QuietHoursRepositoryInterface interface {
+	GetQuietHours(ctx context.Context, params *domain.QuietHoursGetDomain) (*QuietHoursResult, error)
+	SetQuietHours(ctx context.Context, params *domain.QuietHoursSetDomain) (*QuietHoursResult, error)
+	DeleteQuietHours(ctx context.Context, params *domain.QuietHoursDeleteDomain) error
+}
+
+type QuietHoursRepository struct {
+	store store.QuietHoursStoreInterface
+}
+
+func NewQuietHoursRepository(store store.QuietHoursStoreInterface) (*QuietHoursRepository, error) {
+	return &QuietHoursRepository{store: store}, nil
+}
+
+func (r *QuietHoursRepository) GetQuietHours(ctx context.Context, req *domain.QuietHoursGetDomain) (*QuietHoursResult, error) {
+	quietHours, err := r.store.GetQuietHours(ctx, req.UserID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewQuietHoursResult(quietHours), nil
+}
+
+func (r *QuietHoursRepository) SetQuietHours(ctx context.Context, req *domain.QuietHoursSetDomain) (*QuietHoursResult, error) {
+	quietHours, err := r.store.UpsertQuietHours(ctx, &models.QuietHours{
+		UserId:    req.UserID,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		TimeZone:  req.TimeZone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewQuietHoursResult(quietHours), nil
+}
+
+func (r *QuietHoursRepository) DeleteQuietHours(ctx context.Context, req *domain.QuietHoursDeleteDomain) error {
+	err := r.store.DeleteQuietHours(ctx, req.UserID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+	return nil
+}
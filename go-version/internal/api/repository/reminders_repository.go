@@ -1,16 +1,23 @@
 package repository
 
 import (
+	"cmp"
+	"container/heap"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"go-version/internal/api/domain"
 	"go-version/internal/api/models"
 	"go-version/internal/api/store"
+	"go-version/internal/api/utils"
+	"go-version/internal/recurrence"
 
 	"github.com/google/uuid"
-	"github.com/teambition/rrule-go"
 )
 
 type ReminderRepositoryInterface interface {
@@ -18,49 +25,366 @@ type ReminderRepositoryInterface interface {
 	CreateReminder(ctx context.Context, params *domain.ReminderCreateDomain) (*ReminderCreateResult, error)
 	UpdateReminder(ctx context.Context, params *domain.ReminderUpdateDomain) (*ReminderUpdateResult, error)
 	DeleteReminder(ctx context.Context, params *domain.ReminderDeleteDomain) error
+	ImportReminders(ctx context.Context, params *domain.ReminderImportDomain) (*ReminderImportResult, error)
+	GetReminder(ctx context.Context, params *domain.ReminderGetDomain) (*models.Reminder, error)
+	ListUpcoming(ctx context.Context, params *domain.ReminderUpcomingDomain) (*UpcomingResult, error)
+	StreamReminders(ctx context.Context, params *domain.ReminderListDomain, emit func(*StreamLineResult) error) error
+	ListAgenda(ctx context.Context, params *domain.AgendaListDomain) (*AgendaResult, error)
+	StreamAgenda(ctx context.Context, params *domain.AgendaListDomain, emit func(*StreamLineResult) error) error
 }
 
 type ReminderRepository struct {
-	reminderStore store.ReminderStoreInterface
+	reminderStore   store.ReminderStoreInterface
+	quietHoursStore store.QuietHoursStoreInterface
+	caregiverStore  store.CaregiverStoreInterface
+	// limits caps the recurrence rules reminders may use.
+	limits recurrence.Limits
+	// maxStreamedOccurrences caps the occurrences of one streamed listing.
+	maxStreamedOccurrences int
 }
 
-func NewReminderRepository(reminderStore store.ReminderStoreInterface) (*ReminderRepository, error) {
-	return &ReminderRepository{reminderStore: reminderStore}, nil
+func NewReminderRepository(reminderStore store.ReminderStoreInterface, quietHoursStore store.QuietHoursStoreInterface, caregiverStore store.CaregiverStoreInterface, limits recurrence.Limits, maxStreamedOccurrences int) (*ReminderRepository, error) {
+	return &ReminderRepository{reminderStore: reminderStore, quietHoursStore: quietHoursStore, caregiverStore: caregiverStore, limits: limits, maxStreamedOccurrences: maxStreamedOccurrences}, nil
 }
 
+// ListReminders lists the user's reminders in the requested order, a page
+// at a time when a limit is given. Pages are ordered by key then id and
+// resume after the cursor's key and id, so reminders created or deleted
+// between requests never shift a later page. Each reminder on the page has
+// its occurrences around the request's reference time.
 func (r *ReminderRepository) ListReminders(ctx context.Context, reminderListRequest *domain.ReminderListDomain) (*ReminderListResult, error) {
-	filters := &store.ReminderListFilters{
-		UserID:    reminderListRequest.UserID,
-		Search:    reminderListRequest.Search,
-		StartDate: reminderListRequest.StartDate,
-		EndDate:   reminderListRequest.EndDate,
+	reminders, nextCursor, err := r.listReminderPage(ctx, reminderListRequest)
+	if err != nil {
+		return nil, err
+	}
+	result := NewReminderListResult(reminders)
+	result.NextCursor = nextCursor
+
+	if reminderListRequest.StartDate == nil || reminderListRequest.EndDate == nil {
+		return result, nil
+	}
+
+	quietHoursByUser := map[string]*models.QuietHours{}
+	for i := range reminders {
+		quietHours, err := r.userQuietHours(ctx, quietHoursByUser, reminders[i].UserId)
+		if err != nil {
+			return nil, err
+		}
+
+		reminders[i].PopulateMetadataFields(reminderListRequest.StartDate, reminderListRequest.EndDate, r.limits)
+		reminders[i].PopulateDeliveryTimes(quietHours)
+	}
+
+	return result, nil
+}
+
+// StreamReminders lists reminders as ListReminders does, but emits each
+// followed by its occurrences, one line at a time, rather than building
+// the listing in memory. It stops when ctx is done or emit fails, and after
+// the most occurrences that may be streamed.
+func (r *ReminderRepository) StreamReminders(ctx context.Context, req *domain.ReminderListDomain, emit func(*StreamLineResult) error) error {
+	reminders, nextCursor, err := r.listReminderPage(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	quietHoursByUser := map[string]*models.QuietHours{}
+	streamed := 0
+	for i := range reminders {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		reminder := &reminders[i]
+		if err := emit(&StreamLineResult{Type: StreamLineReminder, Reminder: reminder}); err != nil {
+			return err
+		}
+		if req.StartDate == nil || req.EndDate == nil {
+			continue
+		}
+
+		quietHours, err := r.userQuietHours(ctx, quietHoursByUser, reminder.UserId)
+		if err != nil {
+			return err
+		}
+		next, err := recurrence.From(reminder.RRule, reminder.StartAt, *req.StartDate)
+		if err != nil {
+			continue
+		}
+		for occurrence, ok := next(); ok && !occurrence.After(*req.EndDate); occurrence, ok = next() {
+			if streamed == r.maxStreamedOccurrences {
+				return emit(&StreamLineResult{Type: StreamLineTruncated, MaxOccurrences: &r.maxStreamedOccurrences})
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			deliveryAt := reminder.DeliveryTime(occurrence, quietHours)
+			if err := emit(&StreamLineResult{Type: StreamLineOccurrence, Occurrence: &OccurrenceResult{
+				ReminderId: &reminder.Id,
+				At:         occurrence,
+				DeliveryAt: &deliveryAt,
+			}}); err != nil {
+				return err
+			}
+			streamed++
+		}
+	}
+
+	return emit(&StreamLineResult{Type: StreamLineEnd, NextCursor: nextCursor})
+}
+
+// listReminderPage returns the page of reminders a listing asks for, with
+// their occurrences around its reference time, and the cursor of the next
+// page.
+func (r *ReminderRepository) listReminderPage(ctx context.Context, req *domain.ReminderListDomain) ([]models.Reminder, *string, error) {
+	cursor, err := newReminderCursor(req)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	filters := &store.ReminderListFilters{
+		UserID:        req.UserID,
+		IncludeShared: req.IncludeShared,
+		Search:        req.Search,
+		Medication:    req.Medication,
+		StartDate:     req.StartDate,
+		EndDate:       req.EndDate,
+	}
+	// The store sorts and pages by what it holds, reading one reminder past
+	// the page to know whether another follows. Other orders are sorted
+	// here, from every reminder listed.
+	sortedByStore := cursor.sortedByStore()
+	if sortedByStore {
+		limit := req.Limit + 1
+		filters.Sort = cursor.Sort
+		filters.Descending = cursor.Descending
+		filters.Limit = &limit
+		if cursor.ID != "" {
+			filters.After = &store.ReminderListKey{Time: cursor.Key.Time, Text: cursor.Key.Text, ID: cursor.ID}
+		}
+	}
 	reminders, err := r.reminderStore.ListReminders(ctx, filters)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	if !sortedByStore {
+		reminders = sortReminders(reminders, cursor)
+	}
+	reminders, nextCursor := pageReminders(reminders, cursor, req.Limit)
+
+	// Pages sorted by next occurrence keep the time of the first, so that
+	// the times shown match the order.
+	asOf := asOfOrNow(req.AsOf)
+	if cursor.AsOf != nil {
+		asOf = *cursor.AsOf
+	}
 	for i := range reminders {
-		reminders[i].PopulateMetadataFields(reminderListRequest.StartDate, reminderListRequest.EndDate)
+		reminders[i].PopulateOccurrencesAround(asOf)
 	}
+	return reminders, nextCursor, nil
+}
 
-	return NewReminderListResult(reminders), nil
+// userQuietHours returns the user's quiet hours, or nil without any, looking
+// each user up once. Shared reminders are delivered in their owner's quiet
+// hours.
+func (r *ReminderRepository) userQuietHours(ctx context.Context, quietHoursByUser map[string]*models.QuietHours, userID string) (*models.QuietHours, error) {
+	if quietHours, ok := quietHoursByUser[userID]; ok {
+		return quietHours, nil
+	}
+	quietHours, err := r.quietHoursStore.GetQuietHours(ctx, userID)
+	if err != nil {
+		var notFoundErr *store.NoQuietHoursFoundError
+		if !errors.As(err, &notFoundErr) {
+			return nil, err
+		}
+		quietHours = nil
+	}
+	quietHoursByUser[userID] = quietHours
+	return quietHours, nil
 }
 
-func (r *ReminderRepository) CreateReminder(ctx context.Context, req *domain.ReminderCreateDomain) (*ReminderCreateResult, error) {
-	if !validateReminderOccurrences(req.RRule, req.StartAt) {
-		return nil, &ErrInvalidRRule{
-			Err: errors.New("no occurrences can be generated with the provided rrule and start_at"),
+// ListAgenda lists the occurrences of the user's reminders within the range,
+// earliest first and grouped by day, a page at a time.
+func (r *ReminderRepository) ListAgenda(ctx context.Context, req *domain.AgendaListDomain) (*AgendaResult, error) {
+	agenda, err := r.prepareAgenda(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// One occurrence past the page tells whether another page follows.
+	items := mergeOccurrences(agenda.reminders, agenda.from, req.EndDate, agenda.cursor, req.Limit+1)
+	for i := range items {
+		items[i].At = items[i].At.In(agenda.location)
+	}
+
+	result := &AgendaResult{TimeZone: agenda.location.String(), Days: []AgendaDayResult{}}
+	if len(items) > req.Limit {
+		items = items[:req.Limit]
+		last := items[len(items)-1]
+		next := (&agendaCursor{At: last.At, ID: *last.ReminderId}).encode()
+		result.NextCursor = &next
+	}
+	for _, item := range items {
+		date := item.At.Format(time.DateOnly)
+		if len(result.Days) == 0 || result.Days[len(result.Days)-1].Date != date {
+			result.Days = append(result.Days, AgendaDayResult{Date: date})
+		}
+		day := &result.Days[len(result.Days)-1]
+		day.Items = append(day.Items, item)
+	}
+	return result, nil
+}
+
+// StreamAgenda emits the occurrences of the agenda one line at a time,
+// dated in its time zone, rather than a page of them. It stops when ctx is
+// done or emit fails, and after the most occurrences that may be streamed,
+// with a cursor to carry on from.
+func (r *ReminderRepository) StreamAgenda(ctx context.Context, req *domain.AgendaListDomain, emit func(*StreamLineResult) error) error {
+	agenda, err := r.prepareAgenda(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	merge := newOccurrenceMerge(agenda.reminders, agenda.from, req.EndDate)
+	var last *agendaCursor
+	streamed := 0
+	for reminder, occurrence, ok := merge.next(); ok; reminder, occurrence, ok = merge.next() {
+		if agenda.cursor != nil && !agenda.cursor.before(occurrence, reminder.Id) {
+			continue
+		}
+		if streamed == r.maxStreamedOccurrences {
+			next := last.encode()
+			return emit(&StreamLineResult{Type: StreamLineTruncated, MaxOccurrences: &r.maxStreamedOccurrences, NextCursor: &next})
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		occurrence = occurrence.In(agenda.location)
+		if err := emit(&StreamLineResult{Type: StreamLineOccurrence, Occurrence: &OccurrenceResult{
+			ReminderId:  &reminder.Id,
+			Description: reminder.Description,
+			Critical:    &reminder.Critical,
+			At:          occurrence,
+			Date:        occurrence.Format(time.DateOnly),
+		}}); err != nil {
+			return err
+		}
+		last = &agendaCursor{At: occurrence, ID: reminder.Id}
+		streamed++
+	}
+
+	return emit(&StreamLineResult{Type: StreamLineEnd})
+}
+
+// agendaQuery is what an agenda lists: the occurrences of the reminders
+// from a time, after the cursor if there is one, by day in a time zone.
+type agendaQuery struct {
+	reminders []models.Reminder
+	cursor    *agendaCursor
+	location  *time.Location
+	from      time.Time
+}
+
+func (r *ReminderRepository) prepareAgenda(ctx context.Context, req *domain.AgendaListDomain) (*agendaQuery, error) {
+	cursor, err := newAgendaCursor(req.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	location, err := r.agendaLocation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	reminders, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{
+		UserID:        req.UserID,
+		IncludeShared: req.IncludeShared,
+		StartDate:     &req.StartDate,
+		EndDate:       &req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	from := req.StartDate
+	if cursor != nil && cursor.At.After(from) {
+		from = cursor.At
+	}
+	return &agendaQuery{reminders: reminders, cursor: cursor, location: location, from: from}, nil
+}
+
+// GetReminder returns a reminder the user owns or has shared with them, with
+// its occurrences around the reference time.
+func (r *ReminderRepository) GetReminder(ctx context.Context, req *domain.ReminderGetDomain) (*models.Reminder, error) {
+	reminder, err := r.reminderStore.GetAccessibleReminder(ctx, req.UserID, req.ReminderID, models.CaregiverPermissionView)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	reminder.PopulateOccurrencesAround(asOfOrNow(req.AsOf))
+	return reminder, nil
+}
+
+// ListUpcoming returns the soonest occurrences of any of the user's
+// reminders from the reference time on.
+func (r *ReminderRepository) ListUpcoming(ctx context.Context, req *domain.ReminderUpcomingDomain) (*UpcomingResult, error) {
+	asOf, end := asOfOrNow(req.AsOf), maxOccurrenceTime
+	reminders, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{
+		UserID:        req.UserID,
+		IncludeShared: req.IncludeShared,
+		StartDate:     &asOf,
+		EndDate:       &end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := mergeOccurrences(reminders, asOf, end, nil, req.Limit)
+	if items == nil {
+		items = []AgendaItemResult{}
+	}
+	return &UpcomingResult{Occurrences: items}, nil
+}
+
+// agendaLocation returns the time zone the agenda's days are in.
+func (r *ReminderRepository) agendaLocation(ctx context.Context, req *domain.AgendaListDomain) (*time.Location, error) {
+	if req.TimeZone != "" {
+		return time.LoadLocation(req.TimeZone)
+	}
+
+	quietHours, err := r.quietHoursStore.GetQuietHours(ctx, req.UserID)
+	if err != nil {
+		var notFoundErr *store.NoQuietHoursFoundError
+		if errors.As(err, &notFoundErr) {
+			return time.UTC, nil
 		}
+		return nil, err
+	}
+	location, err := time.LoadLocation(quietHours.TimeZone)
+	if err != nil {
+		return time.UTC, nil
+	}
+	return location, nil
+}
+
+func (r *ReminderRepository) CreateReminder(ctx context.Context, req *domain.ReminderCreateDomain) (*ReminderCreateResult, error) {
+	if err := recurrence.Check(req.RRule, req.StartAt, r.limits); err != nil {
+		return nil, &ErrInvalidRRule{Err: err}
+	}
+
+	reminderID := req.ReminderID
+	if reminderID == "" {
+		reminderID = uuid.New().String()
 	}
 
 	newReminder := &models.Reminder{
-		Id:          uuid.New().String(),
+		Id:          reminderID,
 		UserId:      req.UserID,
 		RRule:       req.RRule,
 		Description: req.Description,
 		StartAt:     req.StartAt,
+		Critical:    req.Critical,
+		Medication:  newMedication(req.Medication),
 		CreatedAt:   nil,
 		UpdatedAt:   nil,
 	}
@@ -73,8 +397,10 @@ func (r *ReminderRepository) CreateReminder(ctx context.Context, req *domain.Rem
 	return NewReminderCreateResult(createdReminder), nil
 }
 
+// UpdateReminder changes a reminder the user owns or manages as a
+// caregiver. Changes made by a caregiver are recorded for the owner.
 func (r *ReminderRepository) UpdateReminder(ctx context.Context, req *domain.ReminderUpdateDomain) (*ReminderUpdateResult, error) {
-	curReminder, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID)
+	curReminder, err := r.reminderStore.GetAccessibleReminder(ctx, req.UserID, req.ReminderID, models.CaregiverPermissionManage)
 	if err != nil {
 		return nil, &NoResourceFoundError{Err: err}
 	}
@@ -85,8 +411,11 @@ func (r *ReminderRepository) UpdateReminder(ctx context.Context, req *domain.Rem
 		RRule:       curReminder.RRule,
 		Description: curReminder.Description,
 		StartAt:     curReminder.StartAt,
+		Critical:    curReminder.Critical,
+		Medication:  curReminder.Medication,
 		CreatedAt:   curReminder.CreatedAt,
 		UpdatedAt:   nil,
+		Version:     req.Version,
 	}
 
 	if req.RRule != nil || req.StartAt != nil || req.Description != nil {
@@ -99,35 +428,517 @@ func (r *ReminderRepository) UpdateReminder(ctx context.Context, req *domain.Rem
 		if req.Description != nil {
 			updates.Description = req.Description
 		}
-		if !validateReminderOccurrences(updates.RRule, updates.StartAt) {
-			return nil, &ErrInvalidRRule{
-				Err: errors.New("no occurrences can be generated with the provided rrule and start_at"),
-			}
+		if err := recurrence.Check(updates.RRule, updates.StartAt, r.limits); err != nil {
+			return nil, &ErrInvalidRRule{Err: err}
 		}
 	}
 
+	if req.Critical != nil {
+		updates.Critical = *req.Critical
+	}
+
+	if req.RemoveMedication {
+		updates.Medication = nil
+	} else if req.Medication != nil {
+		updates.Medication = newMedication(req.Medication)
+	}
+
 	updatedReminder, err := r.reminderStore.UpdateReminder(ctx, updates)
 	if err != nil {
+		var changedErr *store.ReminderChangedError
+		if errors.As(err, &changedErr) {
+			return nil, &ErrPreconditionFailed{}
+		}
 		return nil, &NoResourceFoundError{Err: err}
 	}
 
+	if err := r.recordCaregiverAction(ctx, req.UserID, curReminder, models.CaregiverActionReminderUpdated); err != nil {
+		return nil, err
+	}
+
 	return NewReminderUpdateResult(updatedReminder), nil
 }
 
+// DeleteReminder deletes a reminder the user owns or manages as a
+// caregiver. Deletions by a caregiver are recorded for the owner.
 func (r *ReminderRepository) DeleteReminder(ctx context.Context, req *domain.ReminderDeleteDomain) error {
-	err := r.reminderStore.DeleteReminder(ctx, req.UserID, req.ReminderID)
+	reminder, err := r.reminderStore.GetAccessibleReminder(ctx, req.UserID, req.ReminderID, models.CaregiverPermissionManage)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+
+	err = r.reminderStore.DeleteReminder(ctx, reminder.UserId, reminder.Id, req.Version)
 	if err != nil {
+		var changedErr *store.ReminderChangedError
+		if errors.As(err, &changedErr) {
+			return &ErrPreconditionFailed{}
+		}
 		return &NoResourceFoundError{Err: err}
 	}
-	return nil
+
+	return r.recordCaregiverAction(ctx, req.UserID, reminder, models.CaregiverActionReminderDeleted)
+}
+
+// recordCaregiverAction attributes the action to the user when they acted
+// on someone else's reminder.
+func (r *ReminderRepository) recordCaregiverAction(ctx context.Context, userID string, reminder *models.Reminder, action string) error {
+	if reminder.UserId == userID {
+		return nil
+	}
+	return r.caregiverStore.RecordAction(ctx, &models.CaregiverAction{
+		Id:          uuid.New().String(),
+		OwnerId:     reminder.UserId,
+		CaregiverId: userID,
+		ReminderId:  reminder.Id,
+		Action:      action,
+	})
+}
+
+// ImportReminders creates a reminder for every importable calendar entry and
+// reports what happened to each one. Entries matching an existing reminder
+// are skipped, so a file can be imported again after a partial failure. In a
+// dry run nothing is created.
+func (r *ReminderRepository) ImportReminders(ctx context.Context, req *domain.ReminderImportDomain) (*ReminderImportResult, error) {
+	existing, err := r.reminderStore.ListReminders(ctx, &store.ReminderListFilters{UserID: req.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(existing))
+	for i := range existing {
+		seen[reminderImportKey(&existing[i])] = true
+	}
+
+	result := NewReminderImportResult(req.DryRun)
+	for i := range req.Entries {
+		entry := &req.Entries[i]
+
+		reminder, status, reason := newImportedReminder(req.UserID, entry, r.limits)
+		if status == ImportStatusCreated && seen[reminderImportKey(reminder)] {
+			reminder, status, reason = nil, ImportStatusSkipped, "an identical reminder already exists"
+		}
+
+		if status == ImportStatusCreated {
+			seen[reminderImportKey(reminder)] = true
+			if !req.DryRun {
+				reminder, err = r.reminderStore.CreateReminder(ctx, reminder)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		result.Add(i, entry, status, reason, reminder)
+	}
+
+	return result, nil
+}
+
+// newImportedReminder converts a calendar entry to a reminder, or explains
+// why it is skipped or rejected.
+func newImportedReminder(userID string, entry *domain.CalendarEntryDomain, limits recurrence.Limits) (*models.Reminder, string, string) {
+	switch {
+	case entry.ParseError != nil:
+		return nil, ImportStatusRejected, entry.ParseError.Error()
+	case entry.Overrides:
+		return nil, ImportStatusSkipped, "changes to a single occurrence are not supported"
+	case entry.Status == "CANCELLED":
+		return nil, ImportStatusSkipped, "entry is cancelled"
+	case entry.Status == "COMPLETED":
+		return nil, ImportStatusSkipped, "to-do is completed"
+	}
+
+	reminder, err := reminderFromCalendarEntry(userID, entry, limits)
+	if err != nil {
+		return nil, ImportStatusRejected, err.Error()
+	}
+	reminder.Id = uuid.New().String()
+
+	return reminder, ImportStatusCreated, ""
+}
+
+// reminderFromCalendarEntry converts a calendar entry to a reminder without
+// an id. Entries without an RRULE become one-off reminders.
+func reminderFromCalendarEntry(userID string, entry *domain.CalendarEntryDomain, limits recurrence.Limits) (*models.Reminder, error) {
+	if entry.ParseError != nil {
+		return nil, &ErrInvalidCalendarEntry{Reason: entry.ParseError.Error()}
+	}
+	if entry.StartAt == nil {
+		return nil, &ErrInvalidCalendarEntry{Reason: "entry has no DTSTART"}
+	}
+
+	rruleStr := entry.RRule
+	if rruleStr == "" {
+		rruleStr = "FREQ=DAILY;COUNT=1"
+	}
+	if !utils.IsValidRRule(rruleStr) {
+		return nil, &ErrInvalidCalendarEntry{Reason: "RRULE is not supported: " + rruleStr}
+	}
+	if err := recurrence.Check(rruleStr, *entry.StartAt, limits); err != nil {
+		return nil, &ErrInvalidCalendarEntry{Reason: "RRULE is not supported: " + err.Error()}
+	}
+
+	reminder := &models.Reminder{
+		UserId:  userID,
+		RRule:   rruleStr,
+		StartAt: *entry.StartAt,
+	}
+	if entry.Summary != "" {
+		summary := entry.Summary
+		reminder.Description = &summary
+	}
+
+	// Reminders cannot skip single occurrences, so an EXDATE that removes
+	// one cannot be represented.
+	for _, exdate := range entry.ExDates {
+		if isReminderOccurrence(reminder, exdate) {
+			return nil, &ErrInvalidCalendarEntry{Reason: "EXDATE exceptions are not supported"}
+		}
+	}
+
+	return reminder, nil
+}
+
+func reminderImportKey(reminder *models.Reminder) string {
+	description := ""
+	if reminder.Description != nil {
+		description = *reminder.Description
+	}
+	return reminder.RRule + "\x00" + reminder.StartAt.UTC().Format(time.RFC3339) + "\x00" + description
+}
+
+func newMedication(medication *domain.MedicationDomain) *models.Medication {
+	if medication == nil {
+		return nil
+	}
+	return &models.Medication{
+		Name:         medication.Name,
+		Strength:     medication.Strength,
+		DoseQuantity: medication.DoseQuantity,
+		DoseUnit:     medication.DoseUnit,
+		Route:        medication.Route,
+		Instructions: medication.Instructions,
+	}
+}
+
+// isReminderOccurrence reports whether t is one of the reminder's occurrences.
+func isReminderOccurrence(reminder *models.Reminder, t time.Time) bool {
+	next, err := reminder.NextOccurrence(t)
+	return err == nil && next != nil && next.Equal(t)
+}
+
+// reminderCursor is where a page of reminders ends. It records the order it
+// was issued for, and when sorting by next occurrence the time those are
+// found from, so that every page of a listing is sorted alike. A cursor
+// without an ID starts at the first reminder.
+type reminderCursor struct {
+	Sort       string          `json:"sort"`
+	Descending bool            `json:"desc,omitempty"`
+	AsOf       *time.Time      `json:"as_of,omitempty"`
+	Key        reminderSortKey `json:"key"`
+	ID         string          `json:"id"`
+}
+
+// reminderSortKey is the value a reminder sorts by. Reminders without a
+// time to sort by, such as series that have ended when sorting by next
+// occurrence, come after the others.
+type reminderSortKey struct {
+	Time *time.Time `json:"time,omitempty"`
+	Text string     `json:"text,omitempty"`
+	// Rank is the search rank when sorting by relevance, lower first.
+	Rank *float64 `json:"rank,omitempty"`
+}
+
+// newReminderCursor decodes the request's cursor, or starts one for the
+// first page.
+func newReminderCursor(req *domain.ReminderListDomain) (*reminderCursor, error) {
+	cursor := &reminderCursor{Sort: req.Sort, Descending: req.Descending}
+	if cursor.Sort == "" {
+		cursor.Sort = models.ReminderSortStartAt
+	}
+
+	if req.Cursor != nil {
+		encoded, err := base64.RawURLEncoding.DecodeString(*req.Cursor)
+		if err != nil {
+			return nil, &ErrInvalidCursor{Reason: "it is not a cursor returned as next_cursor"}
+		}
+		var decoded reminderCursor
+		if err := json.Unmarshal(encoded, &decoded); err != nil || decoded.ID == "" {
+			return nil, &ErrInvalidCursor{Reason: "it is not a cursor returned as next_cursor"}
+		}
+		if decoded.Sort != cursor.Sort || decoded.Descending != cursor.Descending {
+			return nil, &ErrInvalidCursor{Reason: "it was returned for a different sort or order"}
+		}
+		cursor = &decoded
+	}
+
+	if cursor.Sort == models.ReminderSortNextOccurrence && cursor.AsOf == nil {
+		asOf := asOfOrNow(req.AsOf)
+		cursor.AsOf = &asOf
+	}
+	return cursor, nil
+}
+
+func (c *reminderCursor) encode() string {
+	encoded, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// sortedByStore reports whether the store sorts reminders in the cursor's
+// order.
+func (c *reminderCursor) sortedByStore() bool {
+	return c.Sort != models.ReminderSortNextOccurrence && c.Sort != models.ReminderSortRelevance
+}
+
+// sortReminders sorts the reminders as the cursor says and returns those
+// after it.
+func sortReminders(reminders []models.Reminder, cursor *reminderCursor) []models.Reminder {
+	keys := make(map[string]reminderSortKey, len(reminders))
+	for i := range reminders {
+		keys[reminders[i].Id] = cursor.sortKey(&reminders[i])
+	}
+
+	compare := func(keyA reminderSortKey, idA string, keyB reminderSortKey, idB string) int {
+		order := keyA.compare(keyB)
+		if order == 0 {
+			order = strings.Compare(idA, idB)
+		}
+		if cursor.Descending {
+			return -order
+		}
+		return order
+	}
+	slices.SortFunc(reminders, func(a, b models.Reminder) int {
+		return compare(keys[a.Id], a.Id, keys[b.Id], b.Id)
+	})
+
+	if cursor.ID != "" {
+		first, _ := slices.BinarySearchFunc(reminders, cursor, func(reminder models.Reminder, cursor *reminderCursor) int {
+			if compare(keys[reminder.Id], reminder.Id, cursor.Key, cursor.ID) <= 0 {
+				return -1
+			}
+			return 1
+		})
+		reminders = reminders[first:]
+	}
+	return reminders
+}
+
+// pageReminders returns up to limit of the reminders, which follow the
+// cursor in its order. The returned cursor continues after the page, and is
+// nil when nothing follows it.
+func pageReminders(reminders []models.Reminder, cursor *reminderCursor, limit int) ([]models.Reminder, *string) {
+	if len(reminders) <= limit {
+		return reminders, nil
+	}
+	reminders = reminders[:limit]
+	last := reminders[len(reminders)-1]
+	next := &reminderCursor{
+		Sort:       cursor.Sort,
+		Descending: cursor.Descending,
+		AsOf:       cursor.AsOf,
+		Key:        cursor.sortKey(&last),
+		ID:         last.Id,
+	}
+	nextCursor := next.encode()
+	return reminders, &nextCursor
+}
+
+// sortKey returns the reminder's key in the cursor's order. Descriptions
+// are kept as they are, since the store sorts them ignoring case.
+func (c *reminderCursor) sortKey(reminder *models.Reminder) reminderSortKey {
+	switch c.Sort {
+	case models.ReminderSortCreatedAt:
+		return reminderSortKey{Time: reminder.CreatedAt}
+	case models.ReminderSortDescription:
+		description := ""
+		if reminder.Description != nil {
+			description = *reminder.Description
+		}
+		return reminderSortKey{Text: description}
+	case models.ReminderSortNextOccurrence:
+		next, _ := reminder.NextOccurrence(*c.AsOf)
+		return reminderSortKey{Time: next}
+	case models.ReminderSortRelevance:
+		return reminderSortKey{Rank: reminder.SearchRank}
+	default:
+		startAt := reminder.StartAt
+		return reminderSortKey{Time: &startAt}
+	}
+}
+
+func (k reminderSortKey) compare(other reminderSortKey) int {
+	switch {
+	case k.Time != nil && other.Time != nil:
+		if order := k.Time.Compare(*other.Time); order != 0 {
+			return order
+		}
+	case k.Time != nil:
+		return -1
+	case other.Time != nil:
+		return 1
+	}
+	switch {
+	case k.Rank != nil && other.Rank != nil:
+		if order := cmp.Compare(*k.Rank, *other.Rank); order != 0 {
+			return order
+		}
+	case k.Rank != nil:
+		return -1
+	case other.Rank != nil:
+		return 1
+	}
+	return strings.Compare(k.Text, other.Text)
+}
+
+// maxOccurrenceTime is past the last occurrence of any series.
+var maxOccurrenceTime = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+
+// asOfOrNow returns the reference time of a request, now when it has none.
+func asOfOrNow(asOf *time.Time) time.Time {
+	if asOf != nil {
+		return *asOf
+	}
+	return time.Now().UTC()
+}
+
+// mergeOccurrences returns up to limit occurrences of the reminders from
+// from until end, earliest first and after the cursor when there is one.
+func mergeOccurrences(reminders []models.Reminder, from, end time.Time, cursor *agendaCursor, limit int) []AgendaItemResult {
+	merge := newOccurrenceMerge(reminders, from, end)
+	var items []AgendaItemResult
+	for len(items) < limit {
+		reminder, occurrence, ok := merge.next()
+		if !ok {
+			break
+		}
+		if cursor != nil && !cursor.before(occurrence, reminder.Id) {
+			continue
+		}
+		items = append(items, AgendaItemResult{
+			ReminderId:  &reminder.Id,
+			Description: reminder.Description,
+			Critical:    &reminder.Critical,
+			At:          occurrence,
+		})
+	}
+	return items
+}
+
+// occurrenceMerge merges the occurrences of reminders from a time until an
+// end, earliest first. Each reminder's occurrences are expanded only as far
+// as they are taken, so the cost follows the occurrences taken rather than
+// the occurrences in the range.
+type occurrenceMerge struct {
+	streams agendaStreams
+	end     time.Time
+}
+
+func newOccurrenceMerge(reminders []models.Reminder, from, end time.Time) *occurrenceMerge {
+	merge := &occurrenceMerge{streams: make(agendaStreams, 0, len(reminders)), end: end}
+	for i := range reminders {
+		next, err := recurrence.From(reminders[i].RRule, reminders[i].StartAt, from)
+		if err != nil {
+			continue
+		}
+		stream := &agendaStream{reminder: &reminders[i], next: next}
+		if stream.advance(end) {
+			merge.streams = append(merge.streams, stream)
+		}
+	}
+	heap.Init(&merge.streams)
+	return merge
 }
 
-func validateReminderOccurrences(rruleStr string, startAt time.Time) bool {
-	rruleObj, _ := rrule.StrToRRule(rruleStr)
+// next returns the earliest occurrence not yet taken and its reminder.
+// Occurrences at the same time come in order of reminder id.
+func (m *occurrenceMerge) next() (*models.Reminder, time.Time, bool) {
+	if len(m.streams) == 0 {
+		return nil, time.Time{}, false
+	}
+	stream := m.streams[0]
+	reminder, occurrence := stream.reminder, stream.at
+	if stream.advance(m.end) {
+		heap.Fix(&m.streams, 0)
+	} else {
+		heap.Pop(&m.streams)
+	}
+	return reminder, occurrence, true
+}
+
+// agendaCursor is the last occurrence of a page of the agenda. Occurrences
+// at the same time are ordered by reminder id.
+type agendaCursor struct {
+	At time.Time `json:"at"`
+	ID string    `json:"id"`
+}
+
+func newAgendaCursor(encoded *string) (*agendaCursor, error) {
+	if encoded == nil {
+		return nil, nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(*encoded)
+	if err != nil {
+		return nil, &ErrInvalidCursor{Reason: "it is not a cursor returned as next_cursor"}
+	}
+	var cursor agendaCursor
+	if err := json.Unmarshal(decoded, &cursor); err != nil || cursor.ID == "" {
+		return nil, &ErrInvalidCursor{Reason: "it is not a cursor returned as next_cursor"}
+	}
+	return &cursor, nil
+}
+
+func (c *agendaCursor) encode() string {
+	encoded, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// before reports whether the cursor comes before the occurrence of the
+// reminder at t.
+func (c *agendaCursor) before(t time.Time, reminderID string) bool {
+	if order := c.At.Compare(t); order != 0 {
+		return order < 0
+	}
+	return c.ID < reminderID
+}
+
+// agendaStream is a reminder's occurrences, at the earliest not yet listed.
+type agendaStream struct {
+	reminder *models.Reminder
+	next     recurrence.Next
+	at       time.Time
+}
+
+// advance moves the stream to its next occurrence, reporting false once
+// none are left by end.
+func (s *agendaStream) advance(end time.Time) bool {
+	at, ok := s.next()
+	if !ok || at.After(end) {
+		return false
+	}
+	s.at = at
+	return true
+}
+
+// agendaStreams is a heap of streams by their next occurrence, then id.
+type agendaStreams []*agendaStream
+
+func (h agendaStreams) Len() int { return len(h) }
+
+func (h agendaStreams) Less(i, j int) bool {
+	if order := h[i].at.Compare(h[j].at); order != 0 {
+		return order < 0
+	}
+	return h[i].reminder.Id < h[j].reminder.Id
+}
 
-	rruleObj.DTStart(startAt)
+func (h agendaStreams) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
 
-	occurrences := rruleObj.All()
+func (h *agendaStreams) Push(x any) { *h = append(*h, x.(*agendaStream)) }
 
-	return len(occurrences) > 0
+func (h *agendaStreams) Pop() any {
+	old := *h
+	stream := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return stream
 }
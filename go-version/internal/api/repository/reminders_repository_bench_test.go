@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/dal"
+	"go-version/internal/recurrence"
+
+	"github.com/google/uuid"
+)
+
+// BenchmarkReminderRepository_ListReminders lists a week of occurrences for
+// a user whose reminders are mostly finished courses, as builds up over
+// years of use. "bounded" prunes finished series by their stored occurrence
+// bounds; "unbounded" clears the bounds, as for reminders created before
+// they were stored, so every series is loaded and expanded.
+func BenchmarkReminderRepository_ListReminders(b *testing.B) {
+	for _, count := range []int{1000, 5000} {
+		repo, db, userID := seedReminderBenchmark(b, count)
+		startDate := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+		endDate := startDate.AddDate(0, 0, 7)
+		req := &domain.ReminderListDomain{UserID: userID, StartDate: &startDate, EndDate: &endDate, Limit: 100}
+
+		run := func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := repo.ListReminders(context.Background(), req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+
+		b.Run(fmt.Sprintf("reminders=%d/bounded", count), run)
+
+		if _, err := db.Exec(`UPDATE reminders SET first_occurrence_at = NULL, last_occurrence_at = NULL`); err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("reminders=%d/unbounded", count), run)
+	}
+}
+
+// seedReminderBenchmark creates a user with count reminders, one in ten of
+// which is still running in June 2025.
+func seedReminderBenchmark(b *testing.B, count int) (*ReminderRepository, *sql.DB, string) {
+	b.Helper()
+	ctx := context.Background()
+
+	db, err := dal.NewDatabaseConn(ctx, dal.DriverSQLite, filepath.Join(b.TempDir(), "bench.sqlite"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Cleanup(func() { db.Close() })
+	if _, err := db.Exec(`PRAGMA synchronous = OFF`); err != nil {
+		b.Fatal(err)
+	}
+	migrator, err := dal.NewMigrator(db, dal.DriverSQLite)
+	if err != nil {
+		b.Fatal(err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		b.Fatal(err)
+	}
+
+	userID := uuid.New().String()
+	if _, err := db.Exec(`INSERT INTO users (id, name, email, password, api_key) VALUES ($1, 'Ada', 'ada@example.com', 'hash', $2)`, userID, userID); err != nil {
+		b.Fatal(err)
+	}
+
+	reminderStore, _ := store.NewReminderStore(db)
+	quietHoursStore, _ := store.NewQuietHoursStore(db)
+	caregiverStore, _ := store.NewCaregiverStore(db)
+	repo, _ := NewReminderRepository(reminderStore, quietHoursStore, caregiverStore, recurrence.DefaultLimits(), 1000)
+
+	for i := 0; i < count; i++ {
+		reminder := &models.Reminder{
+			Id:      uuid.New().String(),
+			UserId:  userID,
+			RRule:   "FREQ=DAILY;BYHOUR=8,20;COUNT=28",
+			StartAt: time.Date(2020, 1, 1, 8, 0, 0, 0, time.UTC).AddDate(0, 0, i%1900),
+		}
+		if i%10 == 0 {
+			reminder.RRule = "FREQ=DAILY;BYHOUR=8,20;COUNT=2000"
+			reminder.StartAt = time.Date(2025, 1, 1, 8, 0, 0, 0, time.UTC)
+		}
+		if _, err := reminderStore.CreateReminder(ctx, reminder); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	return repo, db, userID
+}
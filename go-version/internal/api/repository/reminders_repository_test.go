@@ -3,6 +3,9 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"slices"
 	"testing"
 	"time"
 
@@ -11,6 +14,7 @@ import (
 	"go-version/internal/api/store"
 	"go-version/internal/api/store/mocks"
 	"go-version/internal/api/utils"
+	"go-version/internal/recurrence"
 
 	"go.uber.org/mock/gomock"
 )
@@ -20,8 +24,11 @@ func TestNewReminderRepository(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	mockQuietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
 
-	repo, err := NewReminderRepository(mockStore)
+	mockCaregiverStore := mocks.NewMockCaregiverStoreInterface(ctrl)
+
+	repo, err := NewReminderRepository(mockStore, mockQuietHoursStore, mockCaregiverStore, recurrence.DefaultLimits(), 1000)
 	if err != nil {
 		t.Errorf("NewReminderRepository() returned unexpected error: %v", err)
 	}
@@ -38,10 +45,14 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	mockQuietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
 
 	startDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
 	endDate := time.Date(2023, 10, 31, 23, 59, 59, 0, time.UTC)
 	search := "test"
+	// A page of 10 is read from the store with one reminder more, to know
+	// whether another page follows.
+	storeLimit := 11
 
 	testCases := []struct {
 		name          string
@@ -57,6 +68,7 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 				StartDate: &startDate,
 				EndDate:   &endDate,
 				Search:    &search,
+				Limit:     10,
 			},
 			setupMock: func() {
 				expectedFilters := &store.ReminderListFilters{
@@ -64,6 +76,8 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 					StartDate: &startDate,
 					EndDate:   &endDate,
 					Search:    &search,
+					Sort:      models.ReminderSortStartAt,
+					Limit:     &storeLimit,
 				}
 				mockStore.EXPECT().
 					ListReminders(gomock.Any(), expectedFilters).
@@ -84,6 +98,10 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 						},
 					}, nil).
 					Times(1)
+				mockQuietHoursStore.EXPECT().
+					GetQuietHours(gomock.Any(), "user-123").
+					Return(nil, &store.NoQuietHoursFoundError{UserID: "user-123"}).
+					Times(1)
 			},
 			expectedError: false,
 			expectedCount: 2,
@@ -92,6 +110,7 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 			name: "successful list without filters",
 			request: &domain.ReminderListDomain{
 				UserID: "user-123",
+				Limit:  10,
 			},
 			setupMock: func() {
 				expectedFilters := &store.ReminderListFilters{
@@ -99,6 +118,8 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 					StartDate: nil,
 					EndDate:   nil,
 					Search:    nil,
+					Sort:      models.ReminderSortStartAt,
+					Limit:     &storeLimit,
 				}
 				mockStore.EXPECT().
 					ListReminders(gomock.Any(), expectedFilters).
@@ -118,6 +139,7 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 			name: "store error",
 			request: &domain.ReminderListDomain{
 				UserID: "user-123",
+				Limit:  10,
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
@@ -133,7 +155,7 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMock()
 
-			repo := &ReminderRepository{reminderStore: mockStore}
+			repo := &ReminderRepository{reminderStore: mockStore, quietHoursStore: mockQuietHoursStore}
 
 			result, err := repo.ListReminders(context.Background(), tc.request)
 
@@ -158,6 +180,564 @@ func TestReminderRepository_ListReminders(t *testing.T) {
 	}
 }
 
+func TestReminderRepository_ListRemindersDeliveryTimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	mockQuietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+
+	startDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 10, 2, 23, 59, 59, 0, time.UTC)
+
+	mockStore.EXPECT().
+		ListReminders(gomock.Any(), gomock.Any()).
+		Return([]models.Reminder{
+			{Id: "critical", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=2", StartAt: time.Date(2023, 10, 1, 23, 30, 0, 0, time.UTC), Critical: true},
+			{Id: "night", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=2", StartAt: time.Date(2023, 10, 1, 23, 30, 0, 0, time.UTC)},
+		}, nil).
+		Times(1)
+	mockQuietHoursStore.EXPECT().
+		GetQuietHours(gomock.Any(), "user-123").
+		Return(&models.QuietHours{UserId: "user-123", StartTime: "22:00", EndTime: "07:00", TimeZone: "UTC"}, nil).
+		Times(1)
+
+	repo := &ReminderRepository{reminderStore: mockStore, quietHoursStore: mockQuietHoursStore}
+
+	result, err := repo.ListReminders(context.Background(), &domain.ReminderListDomain{
+		UserID:    "user-123",
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Limit:     10,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	critical, night := result.Reminders[0], result.Reminders[1]
+	if len(night.DeliveryTimes) != 2 || len(critical.DeliveryTimes) != 2 {
+		t.Fatalf("Expected a delivery time per occurrence, got %v and %v", night.DeliveryTimes, critical.DeliveryTimes)
+	}
+	if want := time.Date(2023, 10, 2, 7, 0, 0, 0, time.UTC); !night.DeliveryTimes[0].Equal(want) {
+		t.Errorf("Expected delivery deferred to %v, got %v", want, night.DeliveryTimes[0])
+	}
+	if !critical.DeliveryTimes[0].Equal(critical.Occurrences[0]) {
+		t.Errorf("Expected critical reminder to be delivered on time, got %v", critical.DeliveryTimes[0])
+	}
+}
+
+func TestReminderRepository_ListRemindersPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	repo := &ReminderRepository{reminderStore: mockStore}
+
+	now := time.Now().UTC().Truncate(time.Hour)
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	reminder := func(id, description string, startAt time.Time, rrule string, createdAfter time.Duration) models.Reminder {
+		createdAt := created.Add(createdAfter)
+		return models.Reminder{Id: id, UserId: "user-123", RRule: rrule, Description: utils.StringPtr(description), StartAt: startAt, CreatedAt: &createdAt}
+	}
+	reminders := []models.Reminder{
+		reminder("e", "echo", now.AddDate(0, 0, -10), "FREQ=DAILY;COUNT=2", 3*time.Hour),
+		reminder("a", "Alpha", now.Add(-time.Hour), "FREQ=WEEKLY", 4*time.Hour),
+		reminder("c", "charlie", now.Add(2*time.Hour), "FREQ=DAILY", time.Hour),
+		reminder("b", "bravo", now.Add(-time.Hour), "FREQ=HOURLY;INTERVAL=5", 2*time.Hour),
+		reminder("d", "Delta", now.Add(time.Hour), "FREQ=DAILY", 5*time.Hour),
+	}
+	for i, rank := range []float64{-3, -10, -10, -1} {
+		reminders[i].SearchRank = &rank
+	}
+
+	// list returns every page of the listing. After the first page, f is
+	// created as though by another request.
+	list := func(t *testing.T, sort string, descending bool) []string {
+		var ids []string
+		var cursor *string
+		limit := 2
+		stored := slices.Clone(reminders)
+		for page := 0; ; page++ {
+			if page == 1 {
+				f := reminder("f", "foxtrot", now.Add(-21*time.Hour), "FREQ=DAILY", 6*time.Hour)
+				rank := -20.0
+				f.SearchRank = &rank
+				stored = append(stored, f)
+			}
+			mockStore.EXPECT().ListReminders(gomock.Any(), gomock.Any()).Return(slices.Clone(stored), nil)
+
+			result, err := repo.ListReminders(context.Background(), &domain.ReminderListDomain{
+				UserID:     "user-123",
+				Sort:       sort,
+				Descending: descending,
+				Limit:      limit,
+				Cursor:     cursor,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(result.Reminders) > 2 {
+				t.Fatalf("Expected at most 2 reminders, got %d", len(result.Reminders))
+			}
+			for _, r := range result.Reminders {
+				ids = append(ids, r.Id)
+			}
+			if result.NextCursor == nil {
+				return ids
+			}
+			cursor = result.NextCursor
+		}
+	}
+
+	testCases := []struct {
+		sort       string
+		descending bool
+		expected   []string
+	}{
+		{sort: models.ReminderSortNextOccurrence, expected: []string{"d", "c", "f", "b", "a", "e"}},
+		{sort: models.ReminderSortNextOccurrence, descending: true, expected: []string{"e", "a", "b", "f", "c", "d"}},
+		{sort: models.ReminderSortRelevance, expected: []string{"a", "c", "e", "b", "d"}},
+	}
+
+	for _, tc := range testCases {
+		name := tc.sort
+		if tc.descending {
+			name += " desc"
+		}
+		t.Run(name, func(t *testing.T) {
+			if ids := list(t, tc.sort, tc.descending); !reflect.DeepEqual(ids, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, ids)
+			}
+		})
+	}
+}
+
+func TestReminderRepository_ListRemindersStorePages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	repo := &ReminderRepository{reminderStore: mockStore}
+
+	startAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	reminder := func(id, description string) models.Reminder {
+		return models.Reminder{Id: id, UserId: "user-123", RRule: "FREQ=DAILY;COUNT=2", Description: utils.StringPtr(description), StartAt: startAt}
+	}
+	ids := func(reminders []models.Reminder) []string {
+		var ids []string
+		for _, r := range reminders {
+			ids = append(ids, r.Id)
+		}
+		return ids
+	}
+	storeLimit := 3
+
+	mockStore.EXPECT().
+		ListReminders(gomock.Any(), &store.ReminderListFilters{UserID: "user-123", Sort: models.ReminderSortDescription, Descending: true, Limit: &storeLimit}).
+		Return([]models.Reminder{reminder("c", "Charlie"), reminder("b", "Bravo"), reminder("a", "alpha")}, nil)
+	first, err := repo.ListReminders(context.Background(), &domain.ReminderListDomain{
+		UserID:     "user-123",
+		Sort:       models.ReminderSortDescription,
+		Descending: true,
+		Limit:      2,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := ids(first.Reminders); !reflect.DeepEqual(got, []string{"c", "b"}) {
+		t.Errorf("Expected the first page to hold [c b], got %v", got)
+	}
+	if first.NextCursor == nil {
+		t.Fatal("Expected a cursor for the next page")
+	}
+
+	mockStore.EXPECT().
+		ListReminders(gomock.Any(), &store.ReminderListFilters{
+			UserID:     "user-123",
+			Sort:       models.ReminderSortDescription,
+			Descending: true,
+			After:      &store.ReminderListKey{Text: "Bravo", ID: "b"},
+			Limit:      &storeLimit,
+		}).
+		Return([]models.Reminder{reminder("a", "alpha")}, nil)
+	second, err := repo.ListReminders(context.Background(), &domain.ReminderListDomain{
+		UserID:     "user-123",
+		Sort:       models.ReminderSortDescription,
+		Descending: true,
+		Limit:      2,
+		Cursor:     first.NextCursor,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := ids(second.Reminders); !reflect.DeepEqual(got, []string{"a"}) {
+		t.Errorf("Expected the last page to hold [a], got %v", got)
+	}
+	if second.NextCursor != nil {
+		t.Errorf("Expected no cursor after the last page, got %s", *second.NextCursor)
+	}
+}
+
+func TestReminderRepository_ListRemindersInvalidCursor(t *testing.T) {
+	otherSort := (&reminderCursor{Sort: models.ReminderSortDescription, ID: "a"}).encode()
+
+	testCases := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "not base64", cursor: "not a cursor!"},
+		{name: "not a cursor", cursor: "eyJmb28iOjF9"},
+		{name: "another sort", cursor: otherSort},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &ReminderRepository{}
+
+			_, err := repo.ListReminders(context.Background(), &domain.ReminderListDomain{
+				UserID: "user-123",
+				Sort:   models.ReminderSortStartAt,
+				Cursor: &tc.cursor,
+			})
+
+			var invalidCursorErr *ErrInvalidCursor
+			if !errors.As(err, &invalidCursorErr) {
+				t.Errorf("Expected ErrInvalidCursor, got %v", err)
+			}
+		})
+	}
+}
+
+func TestReminderRepository_ListAgenda(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	mockQuietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+	repo := &ReminderRepository{reminderStore: mockStore, quietHoursStore: mockQuietHoursStore}
+
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	reminders := []models.Reminder{
+		{Id: "c", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=2", StartAt: time.Date(2024, 1, 2, 23, 30, 0, 0, time.UTC)},
+		{Id: "b", UserId: "user-123", RRule: "FREQ=HOURLY;INTERVAL=12", StartAt: time.Date(2023, 12, 31, 8, 0, 0, 0, time.UTC)},
+		{Id: "a", UserId: "user-123", RRule: "FREQ=DAILY", StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)},
+		{Id: "broken", UserId: "user-123", RRule: "FREQ=NEVER", StartAt: startDate},
+	}
+	mockStore.EXPECT().ListReminders(gomock.Any(), &store.ReminderListFilters{
+		UserID:    "user-123",
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	}).Return(reminders, nil).AnyTimes()
+
+	// list returns the occurrences of every page, as reminder id and local
+	// time, by local date.
+	list := func(t *testing.T, timeZone string, limit int) (string, map[string][]string) {
+		t.Helper()
+		days := map[string][]string{}
+		var cursor *string
+		for {
+			result, err := repo.ListAgenda(context.Background(), &domain.AgendaListDomain{
+				UserID:    "user-123",
+				StartDate: startDate,
+				EndDate:   endDate,
+				TimeZone:  timeZone,
+				Limit:     limit,
+				Cursor:    cursor,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			items := 0
+			for _, day := range result.Days {
+				for _, item := range day.Items {
+					days[day.Date] = append(days[day.Date], *item.ReminderId+" "+item.At.Format("15:04"))
+					items++
+				}
+			}
+			if items > limit {
+				t.Fatalf("Expected at most %d occurrences, got %d", limit, items)
+			}
+			if result.NextCursor == nil {
+				return result.TimeZone, days
+			}
+			cursor = result.NextCursor
+		}
+	}
+
+	t.Run("pages in the requested time zone", func(t *testing.T) {
+		for _, limit := range []int{1, 3, 100} {
+			timeZone, days := list(t, "Europe/Amsterdam", limit)
+			expected := map[string][]string{
+				"2024-01-01": {"a 09:00", "b 09:00", "b 21:00"},
+				"2024-01-02": {"a 09:00", "b 09:00", "b 21:00"},
+				"2024-01-03": {"c 00:30"},
+			}
+			if timeZone != "Europe/Amsterdam" || !reflect.DeepEqual(days, expected) {
+				t.Errorf("With limit %d, expected %v, got %s %v", limit, expected, timeZone, days)
+			}
+		}
+	})
+
+	t.Run("in the quiet hours time zone", func(t *testing.T) {
+		mockQuietHoursStore.EXPECT().
+			GetQuietHours(gomock.Any(), "user-123").
+			Return(&models.QuietHours{UserId: "user-123", StartTime: "22:00", EndTime: "07:00", TimeZone: "America/New_York"}, nil)
+
+		timeZone, days := list(t, "", 100)
+		expected := []string{"a 03:00", "b 03:00", "b 15:00", "c 18:30"}
+		if timeZone != "America/New_York" || !reflect.DeepEqual(days["2024-01-02"], expected) {
+			t.Errorf("Expected %v on 2024-01-02 in New York, got %s %v", expected, timeZone, days)
+		}
+	})
+
+	t.Run("in UTC without quiet hours", func(t *testing.T) {
+		mockQuietHoursStore.EXPECT().
+			GetQuietHours(gomock.Any(), "user-123").
+			Return(nil, &store.NoQuietHoursFoundError{UserID: "user-123"})
+
+		timeZone, days := list(t, "", 100)
+		if timeZone != "UTC" || len(days["2024-01-02"]) != 4 {
+			t.Errorf("Expected four occurrences on 2024-01-02 in UTC, got %s %v", timeZone, days)
+		}
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		cursor := "eyJmb28iOjF9"
+		_, err := repo.ListAgenda(context.Background(), &domain.AgendaListDomain{
+			UserID:    "user-123",
+			StartDate: startDate,
+			EndDate:   endDate,
+			Limit:     10,
+			Cursor:    &cursor,
+		})
+		var invalidCursorErr *ErrInvalidCursor
+		if !errors.As(err, &invalidCursorErr) {
+			t.Errorf("Expected ErrInvalidCursor, got %v", err)
+		}
+	})
+}
+
+func TestReminderRepository_StreamReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	mockQuietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	mockStore.EXPECT().
+		ListReminders(gomock.Any(), gomock.Any()).
+		Return([]models.Reminder{
+			{Id: "a", UserId: "user-123", RRule: "FREQ=HOURLY;INTERVAL=8;COUNT=10", StartAt: time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC)},
+			{Id: "b", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=10", StartAt: time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)},
+		}, nil).
+		AnyTimes()
+	mockQuietHoursStore.EXPECT().
+		GetQuietHours(gomock.Any(), "user-123").
+		Return(&models.QuietHours{UserId: "user-123", StartTime: "22:00", EndTime: "07:00", TimeZone: "UTC"}, nil).
+		AnyTimes()
+
+	// stream returns the lines of the stream, as their type and the id or
+	// times they hold.
+	stream := func(t *testing.T, ctx context.Context, maxOccurrences int) ([]string, error) {
+		t.Helper()
+		repo := &ReminderRepository{reminderStore: mockStore, quietHoursStore: mockQuietHoursStore, maxStreamedOccurrences: maxOccurrences}
+		var lines []string
+		err := repo.StreamReminders(ctx, &domain.ReminderListDomain{
+			UserID:    "user-123",
+			StartDate: &startDate,
+			EndDate:   &endDate,
+			Sort:      models.ReminderSortStartAt,
+			Limit:     10,
+		}, func(line *StreamLineResult) error {
+			switch line.Type {
+			case StreamLineReminder:
+				lines = append(lines, line.Type+" "+line.Reminder.Id)
+			case StreamLineOccurrence:
+				lines = append(lines, line.Type+" "+line.Occurrence.At.Format("15:04")+" "+line.Occurrence.DeliveryAt.Format("15:04"))
+			case StreamLineTruncated:
+				lines = append(lines, fmt.Sprintf("%s %d", line.Type, *line.MaxOccurrences))
+			default:
+				lines = append(lines, line.Type)
+			}
+			return nil
+		})
+		return lines, err
+	}
+
+	t.Run("streams every occurrence", func(t *testing.T) {
+		lines, err := stream(t, context.Background(), 10)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := []string{"reminder a", "occurrence 06:00 07:00", "occurrence 14:00 14:00", "occurrence 22:00 07:00", "reminder b", "occurrence 23:00 07:00", "end"}
+		if !reflect.DeepEqual(lines, expected) {
+			t.Errorf("Expected %v, got %v", expected, lines)
+		}
+	})
+
+	t.Run("truncates at the cap", func(t *testing.T) {
+		lines, err := stream(t, context.Background(), 3)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		expected := []string{"reminder a", "occurrence 06:00 07:00", "occurrence 14:00 14:00", "occurrence 22:00 07:00", "reminder b", "truncated 3"}
+		if !reflect.DeepEqual(lines, expected) {
+			t.Errorf("Expected %v, got %v", expected, lines)
+		}
+	})
+
+	t.Run("stops when cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := stream(t, ctx, 10); !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected context.Canceled, got %v", err)
+		}
+	})
+}
+
+func TestReminderRepository_StreamAgenda(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	mockStore.EXPECT().
+		ListReminders(gomock.Any(), gomock.Any()).
+		Return([]models.Reminder{
+			{Id: "a", RRule: "FREQ=HOURLY;INTERVAL=12", StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)},
+			{Id: "b", RRule: "FREQ=DAILY", StartAt: time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)},
+			{Id: "c", RRule: "FREQ=DAILY;INTERVAL=3", StartAt: time.Date(2023, 12, 30, 23, 0, 0, 0, time.UTC)},
+		}, nil).
+		AnyTimes()
+
+	req := func(limit int, cursor *string) *domain.AgendaListDomain {
+		return &domain.AgendaListDomain{UserID: "user-123", StartDate: startDate, EndDate: endDate, TimeZone: "Asia/Tokyo", Limit: limit, Cursor: cursor}
+	}
+
+	var expected []string
+	listed, err := (&ReminderRepository{reminderStore: mockStore}).ListAgenda(context.Background(), req(1000, nil))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, day := range listed.Days {
+		for _, item := range day.Items {
+			expected = append(expected, day.Date+" "+*item.ReminderId+" "+item.At.Format(time.RFC3339))
+		}
+	}
+
+	// Streams capped at 4 occurrences carry on from their truncation
+	// marker's cursor until the whole agenda has been streamed.
+	repo := &ReminderRepository{reminderStore: mockStore, maxStreamedOccurrences: 4}
+	var streamed []string
+	var cursor *string
+	for streams := 1; ; streams++ {
+		var last *StreamLineResult
+		err := repo.StreamAgenda(context.Background(), req(1, cursor), func(line *StreamLineResult) error {
+			if line.Type == StreamLineOccurrence {
+				streamed = append(streamed, line.Occurrence.Date+" "+*line.Occurrence.ReminderId+" "+line.Occurrence.At.Format(time.RFC3339))
+			}
+			last = line
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if last.Type == StreamLineEnd {
+			break
+		}
+		if last.Type != StreamLineTruncated || last.NextCursor == nil || *last.MaxOccurrences != 4 {
+			t.Fatalf("Expected a truncation marker with a cursor, got %+v", last)
+		}
+		if streams > len(expected) {
+			t.Fatal("Expected the streams to reach the end")
+		}
+		cursor = last.NextCursor
+	}
+
+	if len(expected) != 23 || !reflect.DeepEqual(streamed, expected) {
+		t.Errorf("Expected %v, got %v", expected, streamed)
+	}
+}
+
+func TestReminderRepository_GetReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	repo := &ReminderRepository{reminderStore: mockStore}
+
+	startAt := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	asOf := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	mockStore.EXPECT().
+		GetAccessibleReminder(gomock.Any(), "user-123", "reminder-1", models.CaregiverPermissionView).
+		Return(&models.Reminder{Id: "reminder-1", UserId: "owner-456", RRule: "FREQ=DAILY;COUNT=5", StartAt: startAt}, nil)
+	mockStore.EXPECT().
+		GetAccessibleReminder(gomock.Any(), "user-123", "missing", models.CaregiverPermissionView).
+		Return(nil, &store.NoReminderFoundError{ID: "missing"})
+
+	reminder, err := repo.GetReminder(context.Background(), &domain.ReminderGetDomain{UserID: "user-123", ReminderID: "reminder-1", AsOf: &asOf})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := startAt.AddDate(0, 0, 2); !equalTime(reminder.NextOccurrenceAt, want) {
+		t.Errorf("Expected next occurrence %v, got %v", want, reminder.NextOccurrenceAt)
+	}
+	if want := startAt.AddDate(0, 0, 1); !equalTime(reminder.PreviousOccurrenceAt, want) {
+		t.Errorf("Expected previous occurrence %v, got %v", want, reminder.PreviousOccurrenceAt)
+	}
+
+	_, err = repo.GetReminder(context.Background(), &domain.ReminderGetDomain{UserID: "user-123", ReminderID: "missing"})
+	var noResourceErr *NoResourceFoundError
+	if !errors.As(err, &noResourceErr) {
+		t.Errorf("Expected NoResourceFoundError, got %v", err)
+	}
+}
+
+func TestReminderRepository_ListUpcoming(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	repo := &ReminderRepository{reminderStore: mockStore}
+
+	asOf := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	mockStore.EXPECT().
+		ListReminders(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filters *store.ReminderListFilters) ([]models.Reminder, error) {
+			if filters.StartDate == nil || !filters.StartDate.Equal(asOf) {
+				t.Errorf("Expected reminders ending before %v to be skipped, got %v", asOf, filters.StartDate)
+			}
+			return []models.Reminder{
+				{Id: "weekly", RRule: "FREQ=WEEKLY", StartAt: time.Date(2023, 6, 1, 9, 0, 0, 0, time.UTC)},
+				{Id: "hourly", RRule: "FREQ=HOURLY;INTERVAL=6", StartAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+				{Id: "once", RRule: "FREQ=DAILY;COUNT=1", StartAt: time.Date(2024, 1, 1, 18, 0, 0, 0, time.UTC)},
+				{Id: "ended", RRule: "FREQ=DAILY;COUNT=1", StartAt: time.Date(2023, 1, 1, 18, 0, 0, 0, time.UTC)},
+			}, nil
+		})
+
+	result, err := repo.ListUpcoming(context.Background(), &domain.ReminderUpcomingDomain{UserID: "user-123", AsOf: &asOf, Limit: 5})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var got []string
+	for _, occurrence := range result.Occurrences {
+		got = append(got, *occurrence.ReminderId+" "+occurrence.At.Format("01-02 15:04"))
+	}
+	expected := []string{"hourly 01-01 12:00", "hourly 01-01 18:00", "once 01-01 18:00", "hourly 01-02 00:00", "hourly 01-02 06:00"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func equalTime(got *time.Time, want time.Time) bool {
+	return got != nil && got.Equal(want)
+}
+
 func TestReminderRepository_CreateReminder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -218,6 +798,34 @@ func TestReminderRepository_CreateReminder(t *testing.T) {
 				return ok
 			},
 		},
+		{
+			name: "rrule that never reaches its hours",
+			request: &domain.ReminderCreateDomain{
+				UserID:  "user-123",
+				RRule:   "FREQ=HOURLY;INTERVAL=2;BYHOUR=3;COUNT=1",
+				StartAt: startTime,
+			},
+			setupMock:     func() {},
+			expectedError: true,
+			validateError: func(err error) bool {
+				_, ok := err.(*ErrInvalidRRule)
+				return ok
+			},
+		},
+		{
+			name: "rrule more frequent than allowed",
+			request: &domain.ReminderCreateDomain{
+				UserID:  "user-123",
+				RRule:   "FREQ=SECONDLY;COUNT=100",
+				StartAt: startTime,
+			},
+			setupMock:     func() {},
+			expectedError: true,
+			validateError: func(err error) bool {
+				_, ok := err.(*ErrInvalidRRule)
+				return ok
+			},
+		},
 		{
 			name: "store creation error",
 			request: &domain.ReminderCreateDomain{
@@ -302,7 +910,7 @@ func TestReminderRepository_UpdateReminder(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					GetReminderByID(gomock.Any(), "user-123", "reminder-123").
+					GetAccessibleReminder(gomock.Any(), "user-123", "reminder-123", models.CaregiverPermissionManage).
 					Return(existingReminder, nil).
 					Times(1)
 
@@ -332,7 +940,7 @@ func TestReminderRepository_UpdateReminder(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					GetReminderByID(gomock.Any(), "user-123", "nonexistent").
+					GetAccessibleReminder(gomock.Any(), "user-123", "nonexistent", models.CaregiverPermissionManage).
 					Return(nil, errors.New("reminder not found")).
 					Times(1)
 			},
@@ -351,7 +959,7 @@ func TestReminderRepository_UpdateReminder(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					GetReminderByID(gomock.Any(), "user-123", "reminder-123").
+					GetAccessibleReminder(gomock.Any(), "user-123", "reminder-123", models.CaregiverPermissionManage).
 					Return(existingReminder, nil).
 					Times(1)
 			},
@@ -370,7 +978,7 @@ func TestReminderRepository_UpdateReminder(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					GetReminderByID(gomock.Any(), "user-123", "reminder-123").
+					GetAccessibleReminder(gomock.Any(), "user-123", "reminder-123", models.CaregiverPermissionManage).
 					Return(existingReminder, nil).
 					Times(1)
 
@@ -417,12 +1025,87 @@ func TestReminderRepository_UpdateReminder(t *testing.T) {
 	}
 }
 
+func TestReminderRepository_UpdateReminderMedication(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existingReminder := &models.Reminder{
+		Id:         "reminder-123",
+		UserId:     "user-123",
+		RRule:      "FREQ=DAILY;COUNT=5",
+		StartAt:    time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC),
+		Medication: &models.Medication{Name: "Metformin", Strength: utils.StringPtr("500 mg")},
+	}
+
+	testCases := []struct {
+		name     string
+		request  *domain.ReminderUpdateDomain
+		expected *models.Medication
+	}{
+		{
+			name: "keeps the medication when it is not updated",
+			request: &domain.ReminderUpdateDomain{
+				UserID:      "user-123",
+				ReminderID:  "reminder-123",
+				Description: utils.StringPtr("Updated reminder"),
+			},
+			expected: existingReminder.Medication,
+		},
+		{
+			name: "replaces the medication",
+			request: &domain.ReminderUpdateDomain{
+				UserID:     "user-123",
+				ReminderID: "reminder-123",
+				Medication: &domain.MedicationDomain{Name: "Ibuprofen", Route: utils.StringPtr("oral")},
+			},
+			expected: &models.Medication{Name: "Ibuprofen", Route: utils.StringPtr("oral")},
+		},
+		{
+			name: "removes the medication",
+			request: &domain.ReminderUpdateDomain{
+				UserID:           "user-123",
+				ReminderID:       "reminder-123",
+				RemoveMedication: true,
+			},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+			mockStore.EXPECT().GetAccessibleReminder(gomock.Any(), "user-123", "reminder-123", models.CaregiverPermissionManage).Return(existingReminder, nil).Times(1)
+			mockStore.EXPECT().
+				UpdateReminder(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error) {
+					if !reflect.DeepEqual(reminder.Medication, tc.expected) {
+						t.Errorf("Expected medication %+v, got %+v", tc.expected, reminder.Medication)
+					}
+					return reminder, nil
+				}).
+				Times(1)
+
+			repo := &ReminderRepository{reminderStore: mockStore}
+
+			result, err := repo.UpdateReminder(context.Background(), tc.request)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(result.Medication, tc.expected) {
+				t.Errorf("Expected the result to include medication %+v, got %+v", tc.expected, result.Medication)
+			}
+		})
+	}
+}
+
 func TestReminderRepository_DeleteReminder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
 
+	owned := &models.Reminder{Id: "reminder-123", UserId: "user-123"}
+
 	testCases := []struct {
 		name          string
 		request       *domain.ReminderDeleteDomain
@@ -438,7 +1121,11 @@ func TestReminderRepository_DeleteReminder(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					DeleteReminder(gomock.Any(), "user-123", "reminder-123").
+					GetAccessibleReminder(gomock.Any(), "user-123", "reminder-123", models.CaregiverPermissionManage).
+					Return(owned, nil).
+					Times(1)
+				mockStore.EXPECT().
+					DeleteReminder(gomock.Any(), "user-123", "reminder-123", 0).
 					Return(nil).
 					Times(1)
 			},
@@ -452,8 +1139,8 @@ func TestReminderRepository_DeleteReminder(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					DeleteReminder(gomock.Any(), "user-123", "nonexistent").
-					Return(errors.New("reminder not found")).
+					GetAccessibleReminder(gomock.Any(), "user-123", "nonexistent", models.CaregiverPermissionManage).
+					Return(nil, errors.New("reminder not found")).
 					Times(1)
 			},
 			expectedError: true,
@@ -470,7 +1157,11 @@ func TestReminderRepository_DeleteReminder(t *testing.T) {
 			},
 			setupMock: func() {
 				mockStore.EXPECT().
-					DeleteReminder(gomock.Any(), "user-123", "reminder-123").
+					GetAccessibleReminder(gomock.Any(), "user-123", "reminder-123", models.CaregiverPermissionManage).
+					Return(owned, nil).
+					Times(1)
+				mockStore.EXPECT().
+					DeleteReminder(gomock.Any(), "user-123", "reminder-123", 0).
 					Return(errors.New("database error")).
 					Times(1)
 			},
@@ -506,41 +1197,121 @@ func TestReminderRepository_DeleteReminder(t *testing.T) {
 	}
 }
 
-func TestValidateReminderOccurrences(t *testing.T) {
-	startTime := time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC)
+func TestReminderRepository_CaregiverChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	testCases := []struct {
-		name     string
-		rrule    string
-		startAt  time.Time
-		expected bool
-	}{
-		{
-			name:     "valid rrule with occurrences",
-			rrule:    "FREQ=DAILY;COUNT=5",
-			startAt:  startTime,
-			expected: true,
-		},
-		{
-			name:     "rrule with no occurrences",
-			rrule:    "FREQ=DAILY;UNTIL=20200101T000000Z",
-			startAt:  startTime,
-			expected: false,
-		},
-		{
-			name:     "weekly rrule with occurrences",
-			rrule:    "FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=3",
-			startAt:  startTime,
-			expected: true,
-		},
+	shared := &models.Reminder{
+		Id:      "reminder-123",
+		UserId:  "owner-1",
+		RRule:   "FREQ=DAILY;COUNT=5",
+		StartAt: time.Date(2023, 10, 1, 10, 0, 0, 0, time.UTC),
 	}
 
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			result := validateReminderOccurrences(tc.rrule, tc.startAt)
-			if result != tc.expected {
-				t.Errorf("validateReminderOccurrences(%s, %v) = %v; want %v", tc.rrule, tc.startAt, result, tc.expected)
-			}
+	expectAction := func(caregiverStore *mocks.MockCaregiverStoreInterface, action string) {
+		caregiverStore.EXPECT().
+			RecordAction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, recorded *models.CaregiverAction) error {
+				if recorded.OwnerId != "owner-1" || recorded.CaregiverId != "caregiver-1" || recorded.ReminderId != "reminder-123" || recorded.Action != action {
+					t.Errorf("Expected %s by caregiver-1 on owner-1's reminder, got %+v", action, recorded)
+				}
+				return nil
+			}).
+			Times(1)
+	}
+
+	t.Run("update", func(t *testing.T) {
+		mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+		mockCaregiverStore := mocks.NewMockCaregiverStoreInterface(ctrl)
+		mockStore.EXPECT().GetAccessibleReminder(gomock.Any(), "caregiver-1", "reminder-123", models.CaregiverPermissionManage).Return(shared, nil).Times(1)
+		mockStore.EXPECT().
+			UpdateReminder(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error) {
+				if reminder.UserId != "owner-1" {
+					t.Errorf("Expected the reminder to stay with its owner, got %s", reminder.UserId)
+				}
+				return reminder, nil
+			}).
+			Times(1)
+		expectAction(mockCaregiverStore, models.CaregiverActionReminderUpdated)
+
+		repo := &ReminderRepository{reminderStore: mockStore, caregiverStore: mockCaregiverStore}
+
+		_, err := repo.UpdateReminder(context.Background(), &domain.ReminderUpdateDomain{
+			UserID:      "caregiver-1",
+			ReminderID:  "reminder-123",
+			Description: utils.StringPtr("Take with food"),
+		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+		mockCaregiverStore := mocks.NewMockCaregiverStoreInterface(ctrl)
+		mockStore.EXPECT().GetAccessibleReminder(gomock.Any(), "caregiver-1", "reminder-123", models.CaregiverPermissionManage).Return(shared, nil).Times(1)
+		mockStore.EXPECT().DeleteReminder(gomock.Any(), "owner-1", "reminder-123", 0).Return(nil).Times(1)
+		expectAction(mockCaregiverStore, models.CaregiverActionReminderDeleted)
+
+		repo := &ReminderRepository{reminderStore: mockStore, caregiverStore: mockCaregiverStore}
+
+		err := repo.DeleteReminder(context.Background(), &domain.ReminderDeleteDomain{
+			UserID:     "caregiver-1",
+			ReminderID: "reminder-123",
 		})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	})
+}
+
+func TestReminderRepository_ListSharedReminders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	mockQuietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+
+	startDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 10, 2, 23, 59, 59, 0, time.UTC)
+
+	storeLimit := 11
+	mockStore.EXPECT().
+		ListReminders(gomock.Any(), &store.ReminderListFilters{UserID: "caregiver-1", IncludeShared: true, StartDate: &startDate, EndDate: &endDate, Sort: models.ReminderSortStartAt, Limit: &storeLimit}).
+		Return([]models.Reminder{
+			{Id: "own", UserId: "caregiver-1", RRule: "FREQ=DAILY;COUNT=2", StartAt: time.Date(2023, 10, 1, 23, 30, 0, 0, time.UTC)},
+			{Id: "shared", UserId: "owner-1", RRule: "FREQ=DAILY;COUNT=2", StartAt: time.Date(2023, 10, 1, 23, 30, 0, 0, time.UTC)},
+			{Id: "shared-too", UserId: "owner-1", RRule: "FREQ=DAILY;COUNT=2", StartAt: time.Date(2023, 10, 1, 23, 30, 0, 0, time.UTC)},
+		}, nil).
+		Times(1)
+	mockQuietHoursStore.EXPECT().
+		GetQuietHours(gomock.Any(), "caregiver-1").
+		Return(nil, &store.NoQuietHoursFoundError{}).
+		Times(1)
+	mockQuietHoursStore.EXPECT().
+		GetQuietHours(gomock.Any(), "owner-1").
+		Return(&models.QuietHours{UserId: "owner-1", StartTime: "22:00", EndTime: "07:00", TimeZone: "UTC"}, nil).
+		Times(1)
+
+	repo := &ReminderRepository{reminderStore: mockStore, quietHoursStore: mockQuietHoursStore}
+
+	result, err := repo.ListReminders(context.Background(), &domain.ReminderListDomain{
+		UserID:        "caregiver-1",
+		IncludeShared: true,
+		StartDate:     &startDate,
+		EndDate:       &endDate,
+		Limit:         10,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	own, shared := result.Reminders[0], result.Reminders[1]
+	if !own.DeliveryTimes[0].Equal(own.Occurrences[0]) {
+		t.Errorf("Expected the caregiver's own reminder to be delivered on time, got %v", own.DeliveryTimes[0])
+	}
+	if want := time.Date(2023, 10, 2, 7, 0, 0, 0, time.UTC); !shared.DeliveryTimes[0].Equal(want) {
+		t.Errorf("Expected the shared reminder to follow its owner's quiet hours until %v, got %v", want, shared.DeliveryTimes[0])
 	}
 }
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"go-version/internal/api/domain"
 	"go-version/internal/api/models"
+	"go-version/internal/recurrence"
 	"time"
 )
 
@@ -20,20 +22,371 @@ type UserGetResult struct {
 
 type ReminderListResult struct {
 	Reminders []models.Reminder `json:"reminders"`
+	// NextCursor fetches the next page, and is nil on the last one.
+	NextCursor *string `json:"next_cursor"`
+}
+
+// AgendaResult lists occurrences earliest first, grouped by day in
+// TimeZone.
+type AgendaResult struct {
+	TimeZone string            `json:"time_zone"`
+	Days     []AgendaDayResult `json:"days"`
+	// NextCursor fetches the next page, and is nil on the last one.
+	NextCursor *string `json:"next_cursor"`
+}
+
+type AgendaDayResult struct {
+	// Date is the day, as YYYY-MM-DD.
+	Date  string             `json:"date"`
+	Items []AgendaItemResult `json:"items"`
+}
+
+type AgendaItemResult struct {
+	ReminderId  *string   `json:"reminder_id"`
+	Description *string   `json:"description"`
+	Critical    *bool     `json:"critical"`
+	At          time.Time `json:"at"`
+}
+
+// UpcomingResult lists the soonest occurrences across reminders, earliest
+// first.
+type UpcomingResult struct {
+	Occurrences []AgendaItemResult `json:"occurrences"`
+}
+
+// Types of the lines of an NDJSON stream. A stream that ran to completion
+// ends with an end line, or a truncated line when it held more occurrences
+// than may be streamed.
+const (
+	StreamLineReminder   = "reminder"
+	StreamLineOccurrence = "occurrence"
+	StreamLineTruncated  = "truncated"
+	StreamLineEnd        = "end"
+)
+
+// StreamLineResult is a line of an NDJSON stream, holding what Type says.
+type StreamLineResult struct {
+	Type       string            `json:"type"`
+	Reminder   *models.Reminder  `json:"reminder,omitempty"`
+	Occurrence *OccurrenceResult `json:"occurrence,omitempty"`
+	// MaxOccurrences is the cap a truncated stream reached.
+	MaxOccurrences *int `json:"max_occurrences,omitempty"`
+	// NextCursor carries on the listing after the stream.
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// OccurrenceResult is a streamed occurrence. Listed reminders' occurrences
+// follow their reminder and hold when they are delivered; agenda
+// occurrences describe their reminder and are dated in the agenda's time
+// zone.
+type OccurrenceResult struct {
+	ReminderId  *string    `json:"reminder_id"`
+	Description *string    `json:"description,omitempty"`
+	Critical    *bool      `json:"critical,omitempty"`
+	At          time.Time  `json:"at"`
+	Date        string     `json:"date,omitempty"`
+	DeliveryAt  *time.Time `json:"delivery_at,omitempty"`
 }
 
 type ReminderCreateResult struct {
-	Id          *string    `json:"id"`
-	RRule       *string    `json:"rrule"`
-	Description *string    `json:"description"`
-	StartAt     *time.Time `json:"startAt"`
+	Id          *string            `json:"id"`
+	RRule       *string            `json:"rrule"`
+	Description *string            `json:"description"`
+	StartAt     *time.Time         `json:"startAt"`
+	Critical    *bool              `json:"critical"`
+	Medication  *models.Medication `json:"medication,omitempty"`
+}
+
+const (
+	ImportStatusCreated  = "created"
+	ImportStatusSkipped  = "skipped"
+	ImportStatusRejected = "rejected"
+)
+
+// ReminderImportResult reports the outcome of importing each calendar
+// entry. In a dry run, created entries are those that would be created.
+type ReminderImportResult struct {
+	DryRun   bool                       `json:"dry_run"`
+	Created  int                        `json:"created"`
+	Skipped  int                        `json:"skipped"`
+	Rejected int                        `json:"rejected"`
+	Items    []ReminderImportItemResult `json:"items"`
+}
+
+type ReminderImportItemResult struct {
+	Index      int     `json:"index"`
+	Component  *string `json:"component"`
+	UID        *string `json:"uid,omitempty"`
+	Summary    *string `json:"summary,omitempty"`
+	Status     *string `json:"status"`
+	Reason     *string `json:"reason,omitempty"`
+	ReminderId *string `json:"reminder_id,omitempty"`
 }
 
 type ReminderUpdateResult struct {
-	Id          *string    `json:"id"`
-	RRule       *string    `json:"rrule"`
-	Description *string    `json:"description"`
-	StartAt     *time.Time `json:"startAt"`
+	Id          *string            `json:"id"`
+	RRule       *string            `json:"rrule"`
+	Description *string            `json:"description"`
+	StartAt     *time.Time         `json:"startAt"`
+	Critical    *bool              `json:"critical"`
+	Medication  *models.Medication `json:"medication,omitempty"`
+}
+
+type PushSubscriptionCreateResult struct {
+	Id        *string `json:"id"`
+	Endpoint  *string `json:"endpoint"`
+	UserAgent *string `json:"user_agent"`
+}
+
+type PushSubscriptionListResult struct {
+	Subscriptions []models.PushSubscription `json:"subscriptions"`
+}
+
+type VapidPublicKeyResult struct {
+	PublicKey string `json:"public_key"`
+}
+
+type QuietHoursResult struct {
+	StartTime *string `json:"start_time"`
+	EndTime   *string `json:"end_time"`
+	TimeZone  *string `json:"time_zone"`
+}
+
+type DigestSubscriptionResult struct {
+	Frequency  *string    `json:"frequency"`
+	SendTime   *string    `json:"send_time"`
+	Weekday    *string    `json:"weekday,omitempty"`
+	TimeZone   *string    `json:"time_zone"`
+	Channel    *string    `json:"channel"`
+	LastSentAt *time.Time `json:"last_sent_at"`
+}
+
+type CalendarFeedResult struct {
+	Token     *string    `json:"token"`
+	URL       *string    `json:"url"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// CalendarRenderResult is a rendered iCalendar feed with a strong ETag.
+type CalendarRenderResult struct {
+	Body []byte
+	ETag string
+}
+
+// CalendarObjectResult is a reminder rendered as a CalDAV calendar object.
+type CalendarObjectResult struct {
+	ReminderID string
+	ETag       string
+	Body       []byte
+}
+
+type CalendarObjectListResult struct {
+	CTag    string
+	Objects []CalendarObjectResult
+}
+
+type CalendarObjectPutResult struct {
+	Created bool
+}
+
+type DeviceCreateResult struct {
+	Id         *string `json:"id"`
+	Platform   *string `json:"platform"`
+	AppVersion *string `json:"app_version"`
+}
+
+type DeviceListResult struct {
+	Devices []models.Device `json:"devices"`
+}
+
+type ContactCreateResult struct {
+	Id    *string `json:"id"`
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+}
+
+type ContactListResult struct {
+	Contacts []models.Contact `json:"contacts"`
+}
+
+type EscalationPolicyResult struct {
+	ReminderId *string                 `json:"reminder_id"`
+	Steps      []models.EscalationStep `json:"steps"`
+}
+
+type EscalationEventListResult struct {
+	Escalations []models.EscalationEvent `json:"escalations"`
+}
+
+type ReminderAcknowledgeResult struct {
+	ReminderId     *string    `json:"reminder_id"`
+	OccurrenceAt   *time.Time `json:"occurrence_at"`
+	AcknowledgedAt *time.Time `json:"acknowledged_at"`
+}
+
+type ReminderSupplyResult struct {
+	ReminderId          *string    `json:"reminder_id"`
+	Quantity            *float64   `json:"quantity"`
+	DosePerOccurrence   *float64   `json:"dose_per_occurrence"`
+	RefillThresholdDays *int       `json:"refill_threshold_days"`
+	DosesRemaining      *int       `json:"doses_remaining"`
+	RunOutAt            *time.Time `json:"run_out_at"`
+	RefillAt            *time.Time `json:"refill_at"`
+	RefillReminderId    *string    `json:"refill_reminder_id"`
+}
+
+type DoseLogResult struct {
+	Id       *string               `json:"id"`
+	Quantity *float64              `json:"quantity"`
+	TakenAt  *time.Time            `json:"taken_at"`
+	Supply   *ReminderSupplyResult `json:"supply"`
+}
+
+type CheckInQuestionnaireResult struct {
+	ReminderId *string                  `json:"reminder_id"`
+	Questions  []models.CheckInQuestion `json:"questions"`
+}
+
+type CheckInResponseResult struct {
+	Id           *string                `json:"id"`
+	ReminderId   *string                `json:"reminder_id"`
+	OccurrenceAt *time.Time             `json:"occurrence_at"`
+	Answers      []models.CheckInAnswer `json:"answers"`
+	SubmittedAt  *time.Time             `json:"submitted_at"`
+}
+
+type CheckInResponseListResult struct {
+	CheckIns []models.CheckInResponse `json:"check_ins"`
+}
+
+type JournalEntryResult struct {
+	Id           *string                     `json:"id"`
+	OccurredAt   *time.Time                  `json:"occurred_at"`
+	Text         *string                     `json:"text"`
+	Tags         []string                    `json:"tags"`
+	Measurements []models.JournalMeasurement `json:"measurements"`
+	CreatedAt    *time.Time                  `json:"created_at"`
+	UpdatedAt    *time.Time                  `json:"updated_at"`
+}
+
+type JournalEntryListResult struct {
+	Entries []models.JournalEntry `json:"entries"`
+}
+
+const (
+	TimelineItemJournalEntry       = "journal_entry"
+	TimelineItemReminderOccurrence = "reminder_occurrence"
+)
+
+// TimelineItemResult is a journal entry or a reminder occurrence, whichever
+// Type says.
+type TimelineItemResult struct {
+	Type         string                  `json:"type"`
+	At           time.Time               `json:"at"`
+	JournalEntry *models.JournalEntry    `json:"journal_entry,omitempty"`
+	Reminder     *TimelineReminderResult `json:"reminder,omitempty"`
+}
+
+type TimelineReminderResult struct {
+	Id          *string            `json:"id"`
+	Description *string            `json:"description"`
+	Critical    *bool              `json:"critical"`
+	Medication  *models.Medication `json:"medication,omitempty"`
+}
+
+type TimelineResult struct {
+	Items []TimelineItemResult `json:"items"`
+}
+
+type CaregiverGrantResult struct {
+	Id           *string    `json:"id"`
+	OwnerId      *string    `json:"owner_id"`
+	Email        *string    `json:"email"`
+	CaregiverId  *string    `json:"caregiver_id"`
+	Permission   *string    `json:"permission"`
+	AllReminders *bool      `json:"all_reminders"`
+	ReminderIds  []string   `json:"reminder_ids"`
+	Status       *string    `json:"status"`
+	CreatedAt    *time.Time `json:"created_at"`
+	AcceptedAt   *time.Time `json:"accepted_at"`
+}
+
+type CaregiverListResult struct {
+	Caregivers []models.CaregiverGrant `json:"caregivers"`
+}
+
+type CaregiverInvitationListResult struct {
+	Invitations []models.CaregiverGrant `json:"invitations"`
+}
+
+type CaregiverActivityResult struct {
+	Actions []models.CaregiverAction `json:"actions"`
+}
+
+type OrganizationResult struct {
+	Id        *string    `json:"id"`
+	Name      *string    `json:"name"`
+	Role      *string    `json:"role"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+type OrganizationListResult struct {
+	Organizations []models.Organization `json:"organizations"`
+}
+
+type OrganizationMemberResult struct {
+	OrganizationId *string    `json:"organization_id"`
+	UserId         *string    `json:"user_id"`
+	Name           *string    `json:"name"`
+	Email          *string    `json:"email"`
+	Role           *string    `json:"role"`
+	CreatedAt      *time.Time `json:"created_at"`
+}
+
+type OrganizationMemberListResult struct {
+	Members []models.OrganizationMember `json:"members"`
+}
+
+type PatientEnrollmentResult struct {
+	Id               *string    `json:"id"`
+	OrganizationId   *string    `json:"organization_id"`
+	OrganizationName *string    `json:"organization_name"`
+	PatientId        *string    `json:"patient_id"`
+	PatientName      *string    `json:"patient_name"`
+	PatientEmail     *string    `json:"patient_email"`
+	Status           *string    `json:"status"`
+	EnrolledBy       *string    `json:"enrolled_by"`
+	CreatedAt        *time.Time `json:"created_at"`
+	ConsentedAt      *time.Time `json:"consented_at"`
+}
+
+type PatientListResult struct {
+	Patients []models.PatientEnrollment `json:"patients"`
+}
+
+type EnrollmentListResult struct {
+	Enrollments []models.PatientEnrollment `json:"enrollments"`
+}
+
+// AdherenceResult summarises how many of a patient's occurrences were
+// acknowledged. Rate is nil when nothing was scheduled.
+type AdherenceResult struct {
+	PatientId    string                    `json:"patient_id"`
+	StartDate    time.Time                 `json:"start_date"`
+	EndDate      time.Time                 `json:"end_date"`
+	Scheduled    int                       `json:"scheduled"`
+	Acknowledged int                       `json:"acknowledged"`
+	Rate         *float64                  `json:"rate"`
+	Reminders    []ReminderAdherenceResult `json:"reminders"`
+}
+
+type ReminderAdherenceResult struct {
+	ReminderId   string             `json:"reminder_id"`
+	Description  *string            `json:"description"`
+	Medication   *models.Medication `json:"medication,omitempty"`
+	Scheduled    int                `json:"scheduled"`
+	Acknowledged int                `json:"acknowledged"`
+	Rate         *float64           `json:"rate"`
 }
 
 // Model -> Result converters
@@ -60,15 +413,58 @@ func NewReminderCreateResult(reminder *models.Reminder) *ReminderCreateResult {
 		RRule:       &reminder.RRule,
 		Description: reminder.Description,
 		StartAt:     &reminder.StartAt,
+		Critical:    &reminder.Critical,
+		Medication:  reminder.Medication,
 	}
 }
 
+func NewReminderImportResult(dryRun bool) *ReminderImportResult {
+	return &ReminderImportResult{
+		DryRun: dryRun,
+		Items:  []ReminderImportItemResult{},
+	}
+}
+
+// Add records the outcome of the entry at index. reminder is the created
+// reminder, if any; its id is left out of dry runs.
+func (r *ReminderImportResult) Add(index int, entry *domain.CalendarEntryDomain, status, reason string, reminder *models.Reminder) {
+	item := ReminderImportItemResult{
+		Index:     index,
+		Component: &entry.Component,
+		Status:    &status,
+	}
+	if entry.UID != "" {
+		item.UID = &entry.UID
+	}
+	if entry.Summary != "" {
+		item.Summary = &entry.Summary
+	}
+	if reason != "" {
+		item.Reason = &reason
+	}
+	if reminder != nil && !r.DryRun {
+		item.ReminderId = &reminder.Id
+	}
+
+	switch status {
+	case ImportStatusCreated:
+		r.Created++
+	case ImportStatusSkipped:
+		r.Skipped++
+	case ImportStatusRejected:
+		r.Rejected++
+	}
+	r.Items = append(r.Items, item)
+}
+
 func NewReminderUpdateResult(reminder *models.Reminder) *ReminderUpdateResult {
 	return &ReminderUpdateResult{
 		Id:          &reminder.Id,
 		RRule:       &reminder.RRule,
 		Description: reminder.Description,
 		StartAt:     &reminder.StartAt,
+		Critical:    &reminder.Critical,
+		Medication:  reminder.Medication,
 	}
 }
 
@@ -80,3 +476,324 @@ func NewReminderListResult(reminders []models.Reminder) *ReminderListResult {
 		Reminders: reminders,
 	}
 }
+
+func NewPushSubscriptionCreateResult(subscription *models.PushSubscription) *PushSubscriptionCreateResult {
+	return &PushSubscriptionCreateResult{
+		Id:        &subscription.Id,
+		Endpoint:  &subscription.Endpoint,
+		UserAgent: subscription.UserAgent,
+	}
+}
+
+func NewPushSubscriptionListResult(subscriptions []models.PushSubscription) *PushSubscriptionListResult {
+	if subscriptions == nil {
+		subscriptions = []models.PushSubscription{}
+	}
+	return &PushSubscriptionListResult{
+		Subscriptions: subscriptions,
+	}
+}
+
+func NewQuietHoursResult(quietHours *models.QuietHours) *QuietHoursResult {
+	return &QuietHoursResult{
+		StartTime: &quietHours.StartTime,
+		EndTime:   &quietHours.EndTime,
+		TimeZone:  &quietHours.TimeZone,
+	}
+}
+
+func NewDigestSubscriptionResult(subscription *models.DigestSubscription) *DigestSubscriptionResult {
+	result := &DigestSubscriptionResult{
+		Frequency:  &subscription.Frequency,
+		SendTime:   &subscription.SendTime,
+		TimeZone:   &subscription.TimeZone,
+		Channel:    &subscription.Channel,
+		LastSentAt: subscription.LastSentAt,
+	}
+	if subscription.Weekday != "" {
+		result.Weekday = &subscription.Weekday
+	}
+	return result
+}
+
+func NewCalendarFeedResult(feed *models.CalendarFeed, token string) *CalendarFeedResult {
+	url := "/api/calendar/" + token + ".ics"
+	return &CalendarFeedResult{
+		Token:     &token,
+		URL:       &url,
+		CreatedAt: feed.CreatedAt,
+	}
+}
+
+func NewDeviceCreateResult(device *models.Device) *DeviceCreateResult {
+	return &DeviceCreateResult{
+		Id:         &device.Id,
+		Platform:   &device.Platform,
+		AppVersion: device.AppVersion,
+	}
+}
+
+func NewDeviceListResult(devices []models.Device) *DeviceListResult {
+	if devices == nil {
+		devices = []models.Device{}
+	}
+	return &DeviceListResult{
+		Devices: devices,
+	}
+}
+
+func NewContactCreateResult(contact *models.Contact) *ContactCreateResult {
+	return &ContactCreateResult{
+		Id:    &contact.Id,
+		Name:  &contact.Name,
+		Email: &contact.Email,
+	}
+}
+
+func NewContactListResult(contacts []models.Contact) *ContactListResult {
+	if contacts == nil {
+		contacts = []models.Contact{}
+	}
+	return &ContactListResult{
+		Contacts: contacts,
+	}
+}
+
+func NewEscalationPolicyResult(policy *models.EscalationPolicy) *EscalationPolicyResult {
+	steps := policy.Steps
+	if steps == nil {
+		steps = []models.EscalationStep{}
+	}
+	return &EscalationPolicyResult{
+		ReminderId: &policy.ReminderId,
+		Steps:      steps,
+	}
+}
+
+func NewEscalationEventListResult(events []models.EscalationEvent) *EscalationEventListResult {
+	if events == nil {
+		events = []models.EscalationEvent{}
+	}
+	return &EscalationEventListResult{
+		Escalations: events,
+	}
+}
+
+func NewReminderAcknowledgeResult(ack *models.ReminderAcknowledgement) *ReminderAcknowledgeResult {
+	return &ReminderAcknowledgeResult{
+		ReminderId:     &ack.ReminderId,
+		OccurrenceAt:   &ack.OccurrenceAt,
+		AcknowledgedAt: ack.AcknowledgedAt,
+	}
+}
+
+// NewReminderSupplyResult projects the supply over the reminder's
+// occurrences after now.
+func NewReminderSupplyResult(reminder *models.Reminder, supply *models.ReminderSupply, now time.Time, limits recurrence.Limits) (*ReminderSupplyResult, error) {
+	runOutAt, err := supply.RunOutAt(reminder, now, limits)
+	if err != nil {
+		return nil, err
+	}
+	refillAt, err := supply.RefillAt(reminder, now, limits)
+	if err != nil {
+		return nil, err
+	}
+
+	dosesRemaining := supply.DosesRemaining()
+	return &ReminderSupplyResult{
+		ReminderId:          &supply.ReminderId,
+		Quantity:            &supply.Quantity,
+		DosePerOccurrence:   &supply.DosePerOccurrence,
+		RefillThresholdDays: &supply.RefillThresholdDays,
+		DosesRemaining:      &dosesRemaining,
+		RunOutAt:            runOutAt,
+		RefillAt:            refillAt,
+		RefillReminderId:    supply.RefillReminderId,
+	}, nil
+}
+
+func NewDoseLogResult(dose *models.DoseLog, supply *ReminderSupplyResult) *DoseLogResult {
+	return &DoseLogResult{
+		Id:       &dose.Id,
+		Quantity: &dose.Quantity,
+		TakenAt:  &dose.TakenAt,
+		Supply:   supply,
+	}
+}
+
+func NewCheckInQuestionnaireResult(questionnaire *models.CheckInQuestionnaire) *CheckInQuestionnaireResult {
+	questions := questionnaire.Questions
+	if questions == nil {
+		questions = []models.CheckInQuestion{}
+	}
+	return &CheckInQuestionnaireResult{
+		ReminderId: &questionnaire.ReminderId,
+		Questions:  questions,
+	}
+}
+
+func NewCheckInResponseResult(response *models.CheckInResponse) *CheckInResponseResult {
+	answers := response.Answers
+	if answers == nil {
+		answers = []models.CheckInAnswer{}
+	}
+	return &CheckInResponseResult{
+		Id:           &response.Id,
+		ReminderId:   &response.ReminderId,
+		OccurrenceAt: &response.OccurrenceAt,
+		Answers:      answers,
+		SubmittedAt:  response.SubmittedAt,
+	}
+}
+
+func NewCheckInResponseListResult(responses []models.CheckInResponse) *CheckInResponseListResult {
+	if responses == nil {
+		responses = []models.CheckInResponse{}
+	}
+	return &CheckInResponseListResult{
+		CheckIns: responses,
+	}
+}
+
+func NewJournalEntryResult(entry *models.JournalEntry) *JournalEntryResult {
+	return &JournalEntryResult{
+		Id:           &entry.Id,
+		OccurredAt:   &entry.OccurredAt,
+		Text:         &entry.Text,
+		Tags:         entry.Tags,
+		Measurements: entry.Measurements,
+		CreatedAt:    entry.CreatedAt,
+		UpdatedAt:    entry.UpdatedAt,
+	}
+}
+
+func NewJournalEntryListResult(entries []models.JournalEntry) *JournalEntryListResult {
+	if entries == nil {
+		entries = []models.JournalEntry{}
+	}
+	return &JournalEntryListResult{
+		Entries: entries,
+	}
+}
+
+func NewTimelineReminderResult(reminder *models.Reminder) *TimelineReminderResult {
+	return &TimelineReminderResult{
+		Id:          &reminder.Id,
+		Description: reminder.Description,
+		Critical:    &reminder.Critical,
+		Medication:  reminder.Medication,
+	}
+}
+
+func NewCaregiverGrantResult(grant *models.CaregiverGrant) *CaregiverGrantResult {
+	return &CaregiverGrantResult{
+		Id:           &grant.Id,
+		OwnerId:      &grant.OwnerId,
+		Email:        &grant.Email,
+		CaregiverId:  grant.CaregiverId,
+		Permission:   &grant.Permission,
+		AllReminders: &grant.AllReminders,
+		ReminderIds:  grant.ReminderIds,
+		Status:       &grant.Status,
+		CreatedAt:    grant.CreatedAt,
+		AcceptedAt:   grant.AcceptedAt,
+	}
+}
+
+func NewCaregiverListResult(grants []models.CaregiverGrant) *CaregiverListResult {
+	if grants == nil {
+		grants = []models.CaregiverGrant{}
+	}
+	return &CaregiverListResult{
+		Caregivers: grants,
+	}
+}
+
+func NewCaregiverInvitationListResult(grants []models.CaregiverGrant) *CaregiverInvitationListResult {
+	if grants == nil {
+		grants = []models.CaregiverGrant{}
+	}
+	return &CaregiverInvitationListResult{
+		Invitations: grants,
+	}
+}
+
+func NewCaregiverActivityResult(actions []models.CaregiverAction) *CaregiverActivityResult {
+	if actions == nil {
+		actions = []models.CaregiverAction{}
+	}
+	return &CaregiverActivityResult{
+		Actions: actions,
+	}
+}
+
+func NewOrganizationResult(organization *models.Organization) *OrganizationResult {
+	return &OrganizationResult{
+		Id:        &organization.Id,
+		Name:      &organization.Name,
+		Role:      &organization.Role,
+		CreatedAt: organization.CreatedAt,
+	}
+}
+
+func NewOrganizationListResult(organizations []models.Organization) *OrganizationListResult {
+	if organizations == nil {
+		organizations = []models.Organization{}
+	}
+	return &OrganizationListResult{
+		Organizations: organizations,
+	}
+}
+
+func NewOrganizationMemberResult(member *models.OrganizationMember) *OrganizationMemberResult {
+	return &OrganizationMemberResult{
+		OrganizationId: &member.OrganizationId,
+		UserId:         &member.UserId,
+		Name:           &member.Name,
+		Email:          &member.Email,
+		Role:           &member.Role,
+		CreatedAt:      member.CreatedAt,
+	}
+}
+
+func NewOrganizationMemberListResult(members []models.OrganizationMember) *OrganizationMemberListResult {
+	if members == nil {
+		members = []models.OrganizationMember{}
+	}
+	return &OrganizationMemberListResult{
+		Members: members,
+	}
+}
+
+func NewPatientEnrollmentResult(enrollment *models.PatientEnrollment) *PatientEnrollmentResult {
+	return &PatientEnrollmentResult{
+		Id:               &enrollment.Id,
+		OrganizationId:   &enrollment.OrganizationId,
+		OrganizationName: &enrollment.OrganizationName,
+		PatientId:        &enrollment.PatientId,
+		PatientName:      &enrollment.PatientName,
+		PatientEmail:     &enrollment.PatientEmail,
+		Status:           &enrollment.Status,
+		EnrolledBy:       &enrollment.EnrolledBy,
+		CreatedAt:        enrollment.CreatedAt,
+		ConsentedAt:      enrollment.ConsentedAt,
+	}
+}
+
+func NewPatientListResult(enrollments []models.PatientEnrollment) *PatientListResult {
+	if enrollments == nil {
+		enrollments = []models.PatientEnrollment{}
+	}
+	return &PatientListResult{
+		Patients: enrollments,
+	}
+}
+
+func NewEnrollmentListResult(enrollments []models.PatientEnrollment) *EnrollmentListResult {
+	if enrollments == nil {
+		enrollments = []models.PatientEnrollment{}
+	}
+	return &EnrollmentListResult{
+		Enrollments: enrollments,
+	}
+}
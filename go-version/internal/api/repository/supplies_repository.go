@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/recurrence"
+
+	"github.com/google/uuid"
+)
+
+// refillRRule makes a refill reminder go off once, at its start.
+const refillRRule = "FREQ=DAILY;COUNT=1"
+
+type SupplyRepositoryInterface interface {
+	GetSupply(ctx context.Context, params *domain.ReminderSupplyGetDomain) (*ReminderSupplyResult, error)
+	SetSupply(ctx context.Context, params *domain.ReminderSupplySetDomain) (*ReminderSupplyResult, error)
+	DeleteSupply(ctx context.Context, params *domain.ReminderSupplyDeleteDomain) error
+	LogDose(ctx context.Context, params *domain.ReminderDoseLogDomain) (*DoseLogResult, error)
+}
+
+type SupplyRepository struct {
+	supplyStore   store.SupplyStoreInterface
+	reminderStore store.ReminderStoreInterface
+	limits        recurrence.Limits
+}
+
+func NewSupplyRepository(supplyStore store.SupplyStoreInterface, reminderStore store.ReminderStoreInterface, limits recurrence.Limits) (*SupplyRepository, error) {
+	return &SupplyRepository{
+		supplyStore:   supplyStore,
+		reminderStore: reminderStore,
+		limits:        limits,
+	}, nil
+}
+
+func (r *SupplyRepository) GetSupply(ctx context.Context, req *domain.ReminderSupplyGetDomain) (*ReminderSupplyResult, error) {
+	reminder, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	supply, err := r.supplyStore.GetSupply(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	return NewReminderSupplyResult(reminder, supply, time.Now(), r.limits)
+}
+
+func (r *SupplyRepository) SetSupply(ctx context.Context, req *domain.ReminderSupplySetDomain) (*ReminderSupplyResult, error) {
+	reminder, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	supply, err := r.supplyStore.UpsertSupply(ctx, &models.ReminderSupply{
+		ReminderId:          reminder.Id,
+		UserId:              req.UserID,
+		Quantity:            req.Quantity,
+		DosePerOccurrence:   req.DosePerOccurrence,
+		RefillThresholdDays: req.RefillThresholdDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := r.rescheduleRefill(ctx, reminder, supply, now); err != nil {
+		return nil, err
+	}
+
+	return NewReminderSupplyResult(reminder, supply, now, r.limits)
+}
+
+// DeleteSupply stops tracking the reminder's supply and removes its refill
+// reminder.
+func (r *SupplyRepository) DeleteSupply(ctx context.Context, req *domain.ReminderSupplyDeleteDomain) error {
+	supply, err := r.supplyStore.GetSupply(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+
+	if err := r.supplyStore.DeleteSupply(ctx, req.UserID, req.ReminderID); err != nil {
+		return &NoResourceFoundError{Err: err}
+	}
+
+	if supply.RefillReminderId != nil {
+		return r.deleteRefillReminder(ctx, req.UserID, *supply.RefillReminderId)
+	}
+	return nil
+}
+
+// LogDose takes a dose from the reminder's supply and moves the refill
+// reminder to match what is left.
+func (r *SupplyRepository) LogDose(ctx context.Context, req *domain.ReminderDoseLogDomain) (*DoseLogResult, error) {
+	reminder, err := r.reminderStore.GetReminderByID(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	supply, err := r.supplyStore.GetSupply(ctx, req.UserID, req.ReminderID)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	dose := &models.DoseLog{
+		Id:         uuid.New().String(),
+		ReminderId: reminder.Id,
+		UserId:     req.UserID,
+		Quantity:   supply.DosePerOccurrence,
+		TakenAt:    req.TakenAt,
+	}
+	if req.Quantity != nil {
+		dose.Quantity = *req.Quantity
+	}
+
+	supply, err = r.supplyStore.LogDose(ctx, dose)
+	if err != nil {
+		return nil, &NoResourceFoundError{Err: err}
+	}
+
+	now := time.Now()
+	if err := r.rescheduleRefill(ctx, reminder, supply, now); err != nil {
+		return nil, err
+	}
+
+	supplyResult, err := NewReminderSupplyResult(reminder, supply, now, r.limits)
+	if err != nil {
+		return nil, err
+	}
+	return NewDoseLogResult(dose, supplyResult), nil
+}
+
+// rescheduleRefill makes the supply's refill reminder go off when the
+// refill is due, replacing it when the due date moves. A refill reminder
+// that has already gone off is kept while the refill is still due, so
+// logging doses before restocking does not repeat it.
+func (r *SupplyRepository) rescheduleRefill(ctx context.Context, reminder *models.Reminder, supply *models.ReminderSupply, now time.Time) error {
+	refillAt, err := supply.RefillAt(reminder, now, r.limits)
+	if err != nil {
+		return err
+	}
+
+	var current *models.Reminder
+	if supply.RefillReminderId != nil {
+		current, err = r.reminderStore.GetReminderByID(ctx, supply.UserId, *supply.RefillReminderId)
+		if err != nil {
+			var notFoundErr *store.NoReminderFoundError
+			if !errors.As(err, &notFoundErr) {
+				return err
+			}
+			current = nil
+		}
+	}
+
+	startAt := refillStartAt(refillAt, now)
+	if current != nil && startAt != nil {
+		if current.StartAt.Equal(*startAt) {
+			return nil
+		}
+		if !current.StartAt.After(now) && !refillAt.After(now) {
+			return nil
+		}
+	}
+
+	if current != nil {
+		if err := r.deleteRefillReminder(ctx, supply.UserId, current.Id); err != nil {
+			return err
+		}
+	}
+
+	var refillReminderID *string
+	if startAt != nil {
+		description := "Refill " + supplyName(reminder)
+		created, err := r.reminderStore.CreateReminder(ctx, &models.Reminder{
+			Id:          uuid.New().String(),
+			UserId:      supply.UserId,
+			RRule:       refillRRule,
+			Description: &description,
+			StartAt:     *startAt,
+		})
+		if err != nil {
+			return err
+		}
+		refillReminderID = &created.Id
+	}
+
+	if supply.RefillReminderId == nil && refillReminderID == nil {
+		return nil
+	}
+	if err := r.supplyStore.SetRefillReminder(ctx, supply.UserId, supply.ReminderId, refillReminderID); err != nil {
+		return err
+	}
+	supply.RefillReminderId = refillReminderID
+	return nil
+}
+
+func (r *SupplyRepository) deleteRefillReminder(ctx context.Context, userID, reminderID string) error {
+	err := r.reminderStore.DeleteReminder(ctx, userID, reminderID, 0)
+	var notFoundErr *store.NoReminderFoundError
+	if err != nil && !errors.As(err, &notFoundErr) {
+		return err
+	}
+	return nil
+}
+
+// refillStartAt is when a refill reminder due at refillAt should go off.
+// A refill that is already due goes off on the next delivery.
+func refillStartAt(refillAt *time.Time, now time.Time) *time.Time {
+	if refillAt == nil {
+		return nil
+	}
+	startAt := refillAt.Truncate(time.Second)
+	if !startAt.After(now) {
+		startAt = now.Truncate(time.Second).Add(time.Second)
+	}
+	return &startAt
+}
+
+// supplyName names what a supply holds in its refill reminder.
+func supplyName(reminder *models.Reminder) string {
+	if reminder.Medication != nil {
+		return reminder.Medication.Name
+	}
+	if reminder.Description != nil && *reminder.Description != "" {
+		return *reminder.Description
+	}
+	return "medication"
+}
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/api/store/mocks"
+
+	"go.uber.org/mock/gomock"
+)
+
+func TestSupplyRepository_LogDose(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reminder := &models.Reminder{
+		Id:         "reminder-1",
+		UserId:     "user-123",
+		RRule:      "FREQ=DAILY;COUNT=1000",
+		StartAt:    time.Now().Add(-time.Hour),
+		Medication: &models.Medication{Name: "Metformin"},
+	}
+	supply := &models.ReminderSupply{
+		ReminderId:          "reminder-1",
+		UserId:              "user-123",
+		Quantity:            5,
+		DosePerOccurrence:   2,
+		RefillThresholdDays: 7,
+	}
+
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(reminder, nil).Times(1)
+	reminderStore.EXPECT().
+		CreateReminder(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, refill *models.Reminder) (*models.Reminder, error) {
+			if *refill.Description != "Refill Metformin" || refill.RRule != refillRRule {
+				t.Errorf("Unexpected refill reminder %+v", refill)
+			}
+			if !refill.StartAt.After(time.Now().Add(-time.Second)) {
+				t.Errorf("Expected a refill that is already due to start now, got %v", refill.StartAt)
+			}
+			return refill, nil
+		}).
+		Times(1)
+
+	supplyStore := mocks.NewMockSupplyStoreInterface(ctrl)
+	supplyStore.EXPECT().GetSupply(gomock.Any(), "user-123", "reminder-1").Return(supply, nil).Times(1)
+	supplyStore.EXPECT().
+		LogDose(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, dose *models.DoseLog) (*models.ReminderSupply, error) {
+			if dose.Quantity != 2 {
+				t.Errorf("Expected the dose to default to the dose per occurrence, got %v", dose.Quantity)
+			}
+			logged := *supply
+			logged.Quantity -= dose.Quantity
+			return &logged, nil
+		}).
+		Times(1)
+	supplyStore.EXPECT().SetRefillReminder(gomock.Any(), "user-123", "reminder-1", gomock.Not(gomock.Nil())).Return(nil).Times(1)
+
+	repo := &SupplyRepository{supplyStore: supplyStore, reminderStore: reminderStore}
+
+	result, err := repo.LogDose(context.Background(), &domain.ReminderDoseLogDomain{
+		UserID:     "user-123",
+		ReminderID: "reminder-1",
+		TakenAt:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if *result.Supply.Quantity != 3 || *result.Supply.DosesRemaining != 1 {
+		t.Errorf("Expected 3 left for 1 dose, got %v for %d", *result.Supply.Quantity, *result.Supply.DosesRemaining)
+	}
+	if result.Supply.RunOutAt == nil || result.Supply.RefillReminderId == nil {
+		t.Errorf("Expected a run out date and a refill reminder, got %+v", result.Supply)
+	}
+}
+
+func TestSupplyRepository_SetSupplyKeepsRefillThatWentOff(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reminder := &models.Reminder{
+		Id:      "reminder-1",
+		UserId:  "user-123",
+		RRule:   "FREQ=DAILY;COUNT=1000",
+		StartAt: time.Now().Add(-time.Hour),
+	}
+	refillID := "refill-1"
+	refill := &models.Reminder{Id: refillID, UserId: "user-123", RRule: refillRRule, StartAt: time.Now().Add(-time.Hour)}
+
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(reminder, nil).Times(1)
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", refillID).Return(refill, nil).Times(1)
+
+	supplyStore := mocks.NewMockSupplyStoreInterface(ctrl)
+	supplyStore.EXPECT().
+		UpsertSupply(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, supply *models.ReminderSupply) (*models.ReminderSupply, error) {
+			supply.RefillReminderId = &refillID
+			return supply, nil
+		}).
+		Times(1)
+
+	repo := &SupplyRepository{supplyStore: supplyStore, reminderStore: reminderStore}
+
+	result, err := repo.SetSupply(context.Background(), &domain.ReminderSupplySetDomain{
+		UserID:              "user-123",
+		ReminderID:          "reminder-1",
+		Quantity:            2,
+		DosePerOccurrence:   1,
+		RefillThresholdDays: 7,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.RefillReminderId == nil || *result.RefillReminderId != refillID {
+		t.Errorf("Expected the refill reminder to be kept, got %v", result.RefillReminderId)
+	}
+}
+
+func TestSupplyRepository_SetSupplyRemovesRefillAfterRestock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reminder := &models.Reminder{
+		Id:      "reminder-1",
+		UserId:  "user-123",
+		RRule:   "FREQ=DAILY;COUNT=10",
+		StartAt: time.Now().Add(-time.Hour),
+	}
+	refillID := "refill-1"
+
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(reminder, nil).Times(1)
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", refillID).Return(nil, &store.NoReminderFoundError{ID: refillID}).Times(1)
+
+	supplyStore := mocks.NewMockSupplyStoreInterface(ctrl)
+	supplyStore.EXPECT().
+		UpsertSupply(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, supply *models.ReminderSupply) (*models.ReminderSupply, error) {
+			supply.RefillReminderId = &refillID
+			return supply, nil
+		}).
+		Times(1)
+	supplyStore.EXPECT().SetRefillReminder(gomock.Any(), "user-123", "reminder-1", nil).Return(nil).Times(1)
+
+	repo := &SupplyRepository{supplyStore: supplyStore, reminderStore: reminderStore}
+
+	result, err := repo.SetSupply(context.Background(), &domain.ReminderSupplySetDomain{
+		UserID:              "user-123",
+		ReminderID:          "reminder-1",
+		Quantity:            30,
+		DosePerOccurrence:   1,
+		RefillThresholdDays: 7,
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.RunOutAt != nil || result.RefillAt != nil || result.RefillReminderId != nil {
+		t.Errorf("Expected the supply to last without a refill, got %+v", result)
+	}
+}
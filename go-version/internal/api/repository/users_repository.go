@@ -17,11 +17,12 @@ type UserRepositoryInterface interface {
 }
 
 type UserRepository struct {
-	store store.UserStoreInterface
+	store  store.UserStoreInterface
+	tokens *auth.Tokens
 }
 
-func NewUserRepository(store store.UserStoreInterface) (*UserRepository, error) {
-	return &UserRepository{store: store}, nil
+func NewUserRepository(store store.UserStoreInterface, tokens *auth.Tokens) (*UserRepository, error) {
+	return &UserRepository{store: store, tokens: tokens}, nil
 }
 
 func (r *UserRepository) GetUser(ctx context.Context, req *domain.UserGetDomain) (*UserGetResult, error) {
@@ -44,7 +45,7 @@ func (r *UserRepository) CreateUser(ctx context.Context, req *domain.UserCreateD
 		UpdatedAt: nil,
 	}
 
-	apiKey, err := auth.GenerateToken(newUser.Id)
+	apiKey, err := r.tokens.GenerateToken(newUser.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"go-version/internal/api/auth"
 	"go-version/internal/api/domain"
 	"go-version/internal/api/models"
 	"go-version/internal/api/store/mocks"
@@ -19,7 +20,7 @@ func TestNewUserRepository(t *testing.T) {
 
 	mockStore := mocks.NewMockUserStoreInterface(ctrl)
 
-	repo, err := NewUserRepository(mockStore)
+	repo, err := NewUserRepository(mockStore, auth.NewTokens("test-signing-key"))
 	if err != nil {
 		t.Errorf("NewUserRepository() returned unexpected error: %v", err)
 	}
@@ -129,6 +130,7 @@ func TestUserRepository_CreateUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockStore := mocks.NewMockUserStoreInterface(ctrl)
+	tokens := auth.NewTokens("test-signing-key")
 
 	testCases := []struct {
 		name          string
@@ -159,6 +161,8 @@ func TestUserRepository_CreateUser(t *testing.T) {
 						}
 						if user.ApiKey == nil {
 							t.Error("Expected ApiKey to be set")
+						} else if token, err := tokens.ParseToken(*user.ApiKey); err != nil || !token.Valid {
+							t.Errorf("Expected ApiKey to be signed with the configured key: %v", err)
 						}
 						// Return the same user (simulating database insert)
 						return user, nil
@@ -188,7 +192,7 @@ func TestUserRepository_CreateUser(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.setupMock()
 
-			repo := &UserRepository{store: mockStore}
+			repo := &UserRepository{store: mockStore, tokens: tokens}
 
 			result, err := repo.CreateUser(context.Background(), tc.inputDomain)
 
@@ -1,35 +1,154 @@
 package api
 
 import (
+	"context"
+	"crypto/ecdsa"
 	"database/sql"
+	"fmt"
+	"os"
+	"time"
 
+	"go-version/internal/api/auth"
+	"go-version/internal/api/authz"
 	"go-version/internal/api/handlers"
+	"go-version/internal/api/models"
 	"go-version/internal/api/repository"
 	"go-version/internal/api/store"
+	"go-version/internal/config"
+	"go-version/internal/dal"
+	"go-version/internal/digest"
+	"go-version/internal/escalation"
+	"go-version/internal/notify"
+	"go-version/internal/push"
+	"go-version/internal/webpush"
 
 	"github.com/go-chi/chi/v5"
 )
 
+// Worker is a long running background job started alongside the HTTP server.
+type Worker interface {
+	Run(ctx context.Context)
+}
+
 type ApiService struct {
 	handlers map[string]handlers.HttpHandler
+	workers  []Worker
 }
 
-func NewService(db *sql.DB) *ApiService {
+// NewService wires the API against db, which was opened with the
+// configured database driver. The driver selects the store implementations
+// to use.
+func NewService(db *sql.DB, cfg *config.Config) *ApiService {
 
 	handlersMap := make(map[string]handlers.HttpHandler)
+	var workers []Worker
+
+	var userStore store.UserStoreInterface
+	var reminderStore store.ReminderStoreInterface
+	if cfg.Database.Driver == dal.DriverPostgres {
+		userStore, _ = store.NewPostgresUserStore(db)
+		reminderStore, _ = store.NewPostgresReminderStore(db)
+	} else {
+		userStore, _ = store.NewUserStore(db)
+		reminderStore, _ = store.NewReminderStore(db)
+	}
 
-	userStore, _ := store.NewUserStore(db)
-	userRepository, _ := repository.NewUserRepository(userStore)
-	userHandler, _ := handlers.NewUserHandler(userRepository)
+	tokens := auth.NewTokens(cfg.Auth.JWTSigningKey)
+
+	userRepository, _ := repository.NewUserRepository(userStore, tokens)
+	userHandler, _ := handlers.NewUserHandler(userRepository, tokens)
 	handlersMap["users"] = userHandler
 
-	reminderStore, _ := store.NewReminderStore(db)
-	reminderRepository, _ := repository.NewReminderRepository(reminderStore)
-	reminderHandler, _ := handlers.NewReminderHandler(reminderRepository)
+	quietHoursStore, _ := store.NewQuietHoursStore(db)
+	quietHoursRepository, _ := repository.NewQuietHoursRepository(quietHoursStore)
+	quietHoursHandler, _ := handlers.NewQuietHoursHandler(quietHoursRepository, tokens)
+	handlersMap["quiet-hours"] = quietHoursHandler
+
+	caregiverStore, _ := store.NewCaregiverStore(db)
+	reminderRepository, _ := repository.NewReminderRepository(reminderStore, quietHoursStore, caregiverStore, cfg.Recurrence.Limits(), cfg.Recurrence.MaxStreamedOccurrences)
+	reminderHandler, _ := handlers.NewReminderHandler(reminderRepository, tokens)
 	handlersMap["reminders"] = reminderHandler
 
+	pushStore, _ := store.NewPushSubscriptionStore(db)
+	var webPushSender repository.WebPushSender
+	vapidKeys, err := repository.LoadVAPIDKeys(context.Background(), pushStore, cfg.WebPush.PublicKey, cfg.WebPush.PrivateKey)
+	if err != nil {
+		fmt.Println("Web push disabled, unable to load VAPID keys:", err)
+	} else {
+		webPushSender = webpush.NewClient(vapidKeys, cfg.WebPush.Subject, nil)
+	}
+	pushRepository, _ := repository.NewPushSubscriptionRepository(pushStore, webPushSender)
+	pushHandler, _ := handlers.NewPushHandler(pushRepository, tokens)
+	handlersMap["push"] = pushHandler
+
+	deviceStore, _ := store.NewDeviceStore(db)
+	deviceRepository, _ := repository.NewDeviceRepository(deviceStore, pushProviders(cfg.APNs, cfg.FCM)...)
+	deviceHandler, _ := handlers.NewDeviceHandler(deviceRepository, tokens)
+	handlersMap["devices"] = deviceHandler
+
+	contactStore, _ := store.NewContactStore(db)
+	contactRepository, _ := repository.NewContactRepository(contactStore)
+	contactHandler, _ := handlers.NewContactHandler(contactRepository, tokens)
+	handlersMap["contacts"] = contactHandler
+
+	escalationStore, _ := store.NewEscalationStore(db)
+	escalationRepository, _ := repository.NewEscalationRepository(escalationStore, reminderStore, contactStore)
+	escalationHandler, _ := handlers.NewEscalationHandler(escalationRepository, tokens)
+	handlersMap["escalations"] = escalationHandler
+
+	digestStore, _ := store.NewDigestSubscriptionStore(db)
+	digestRepository, _ := repository.NewDigestSubscriptionRepository(digestStore)
+	digestHandler, _ := handlers.NewDigestSubscriptionHandler(digestRepository, tokens)
+	handlersMap["digests"] = digestHandler
+
+	calendarFeedStore, _ := store.NewCalendarFeedStore(db)
+	calendarRepository, _ := repository.NewCalendarRepository(calendarFeedStore, reminderStore, reminderRepository, cfg.Recurrence.Limits())
+	calendarHandler, _ := handlers.NewCalendarHandler(calendarRepository, tokens)
+	handlersMap["calendar"] = calendarHandler
+	caldavHandler, _ := handlers.NewCalDAVHandler(calendarRepository, tokens)
+	handlersMap["caldav"] = caldavHandler
+
+	fhirRepository, _ := repository.NewFHIRRepository(reminderStore, reminderRepository, cfg.Recurrence.Limits())
+	fhirHandler, _ := handlers.NewFHIRHandler(fhirRepository, tokens)
+	handlersMap["fhir"] = fhirHandler
+
+	supplyStore, _ := store.NewSupplyStore(db)
+	supplyRepository, _ := repository.NewSupplyRepository(supplyStore, reminderStore, cfg.Recurrence.Limits())
+	supplyHandler, _ := handlers.NewSupplyHandler(supplyRepository, tokens)
+	handlersMap["supplies"] = supplyHandler
+
+	checkInStore, _ := store.NewCheckInStore(db)
+	checkInRepository, _ := repository.NewCheckInRepository(checkInStore, reminderStore)
+	checkInHandler, _ := handlers.NewCheckInHandler(checkInRepository, tokens)
+	handlersMap["check-ins"] = checkInHandler
+
+	journalStore, _ := store.NewJournalStore(db)
+	journalRepository, _ := repository.NewJournalRepository(journalStore, reminderStore, cfg.Recurrence.Limits())
+	journalHandler, _ := handlers.NewJournalHandler(journalRepository, tokens)
+	handlersMap["journal"] = journalHandler
+
+	caregiverRepository, _ := repository.NewCaregiverRepository(caregiverStore, reminderStore, userStore)
+	caregiverHandler, _ := handlers.NewCaregiverHandler(caregiverRepository, tokens)
+	handlersMap["caregivers"] = caregiverHandler
+
+	organizationStore, _ := store.NewOrganizationStore(db)
+	authorizer, _ := authz.NewAuthorizer(organizationStore)
+	organizationRepository, _ := repository.NewOrganizationRepository(organizationStore, userStore, reminderStore, escalationStore, authorizer, cfg.Recurrence.Limits())
+	organizationHandler, _ := handlers.NewOrganizationHandler(organizationRepository, tokens)
+	handlersMap["organizations"] = organizationHandler
+
+	channels := map[string]notify.Sender{
+		models.ChannelWebPush:    pushRepository,
+		models.ChannelMobilePush: deviceRepository,
+	}
+
+	workers = append(workers, notify.NewDispatcher(reminderStore, quietHoursStore, cfg.Recurrence.Limits(), time.Minute, pushRepository, deviceRepository))
+	workers = append(workers, escalation.NewWorker(escalationStore, reminderStore, contactStore, userStore, quietHoursStore, cfg.Recurrence.Limits(), channels, time.Minute))
+	workers = append(workers, digest.NewWorker(digestStore, reminderStore, cfg.Recurrence.Limits(), channels, time.Minute))
+
 	return &ApiService{
 		handlers: handlersMap,
+		workers:  workers,
 	}
 
 }
@@ -47,3 +166,47 @@ func (s *ApiService) RegisterRoutes(r chi.Router) {
 
 	r.Mount("/api", apiRouter)
 }
+
+// pushProviders returns the mobile push providers that have been
+// configured. Their base URLs can point at a local stand-in.
+func pushProviders(apns config.APNsConfig, fcm config.FCMConfig) []push.PushProvider {
+	var providers []push.PushProvider
+
+	if apns.PrivateKeyPath != "" {
+		keyBytes, err := os.ReadFile(apns.PrivateKeyPath)
+		if err == nil {
+			var privateKey *ecdsa.PrivateKey
+			privateKey, err = push.ParseAPNsPrivateKey(keyBytes)
+			if err == nil {
+				providers = append(providers, push.NewAPNsProvider(push.APNsConfig{
+					BaseURL:    apns.BaseURL,
+					Topic:      apns.Topic,
+					KeyID:      apns.KeyID,
+					TeamID:     apns.TeamID,
+					PrivateKey: privateKey,
+				}, nil))
+			}
+		}
+		if err != nil {
+			fmt.Println("APNs disabled, unable to load signing key:", err)
+		}
+	}
+
+	if fcm.ProjectID != "" {
+		providers = append(providers, push.NewFCMProvider(push.FCMConfig{
+			BaseURL:     fcm.BaseURL,
+			ProjectID:   fcm.ProjectID,
+			TokenSource: push.StaticTokenSource(fcm.AccessToken),
+		}, nil))
+	}
+
+	return providers
+}
+
+// StartWorkers launches every background worker. They stop when ctx is
+// cancelled.
+func (s *ApiService) StartWorkers(ctx context.Context) {
+	for _, worker := range s.workers {
+		go worker.Run(ctx)
+	}
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go-version/internal/api/auth"
+	"go-version/internal/config"
+	"go-version/internal/dal"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func TestService_CreateReminderWithoutEnd(t *testing.T) {
+	ctx := context.Background()
+	db, err := dal.NewDatabaseConn(ctx, dal.DriverSQLite, filepath.Join(t.TempDir(), "api.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	migrator, err := dal.NewMigrator(db, dal.DriverSQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Default()
+	cfg.Auth.JWTSigningKey = "test-signing-key"
+	userID := "6f1c2d3e-4a5b-4c6d-8e9f-0a1b2c3d4e5f"
+	token, err := auth.NewTokens(cfg.Auth.JWTSigningKey).GenerateToken(userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO users (id, email, name, password, api_key) VALUES ($1, 'ada@example.com', 'Ada', 'x', $2)`, userID, token); err != nil {
+		t.Fatal(err)
+	}
+
+	router := chi.NewRouter()
+	NewService(db, cfg).RegisterRoutes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	do := func(method, path, body string) (int, map[string]any) {
+		t.Helper()
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var decoded map[string]any
+		json.NewDecoder(resp.Body).Decode(&decoded)
+		return resp.StatusCode, decoded
+	}
+
+	status, created := do(http.MethodPost, "/api/reminders", `{"rrule": "FREQ=DAILY;BYHOUR=8", "start_at": "2024-01-01T08:00:00Z"}`)
+	if status != http.StatusCreated {
+		t.Fatalf("POST /api/reminders = %d %v, want %d", status, created, http.StatusCreated)
+	}
+	id, _ := created["id"].(string)
+
+	status, fetched := do(http.MethodGet, "/api/reminders/"+id+"?as_of=2124-06-01T12:00:00Z", "")
+	if status != http.StatusOK {
+		t.Fatalf("GET /api/reminders/%s = %d %v, want %d", id, status, fetched, http.StatusOK)
+	}
+	if got, want := fetched["next_occurrence_at"], "2124-06-02T08:00:00Z"; got != want {
+		t.Errorf("next_occurrence_at = %v, want %v", got, want)
+	}
+
+	status, rejected := do(http.MethodPost, "/api/reminders", `{"rrule": "FREQ=DAILY;INTERVAL=0", "start_at": "2024-01-01T08:00:00Z"}`)
+	if status != http.StatusBadRequest {
+		t.Errorf("POST /api/reminders with INTERVAL=0 = %d %v, want %d", status, rejected, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+type CalendarFeedStoreInterface interface {
+	GetFeedByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error)
+	ReplaceFeed(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error)
+	DeleteFeed(ctx context.Context, userID string) error
+}
+
+type CalendarFeedStore struct {
+	db *sql.DB
+}
+
+func NewCalendarFeedStore(db *sql.DB) (*CalendarFeedStore, error) {
+	return &CalendarFeedStore{db: db}, nil
+}
+
+func (s *CalendarFeedStore) GetFeedByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error) {
+	query := `
+		SELECT user_id, token_hash, created_at
+		FROM calendar_feeds
+		WHERE token_hash=$1
+	`
+
+	var feed models.CalendarFeed
+	err := s.db.QueryRowContext(ctx, query, tokenHash).Scan(&feed.UserId, &feed.TokenHash, &feed.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoCalendarFeedFoundError{}
+		}
+		return nil, err
+	}
+
+	return &feed, nil
+}
+
+// ReplaceFeed stores the user's feed, revoking the token of any feed they
+// had before.
+func (s *CalendarFeedStore) ReplaceFeed(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+	query := `
+		INSERT INTO calendar_feeds (user_id, token_hash)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			token_hash = excluded.token_hash,
+			created_at = CURRENT_TIMESTAMP
+		RETURNING user_id, token_hash, created_at
+	`
+
+	var saved models.CalendarFeed
+	err := s.db.QueryRowContext(ctx, query, feed.UserId, feed.TokenHash).Scan(&saved.UserId, &saved.TokenHash, &saved.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+func (s *CalendarFeedStore) DeleteFeed(ctx context.Context, userID string) error {
+	query := `DELETE FROM calendar_feeds WHERE user_id=$1`
+	result, err := s.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoCalendarFeedFoundError{}
+	}
+
+	return nil
+}
@@ -0,0 +1,272 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"go-version/internal/api/models"
+)
+
+type CaregiverStoreInterface interface {
+	UpsertGrant(ctx context.Context, grant *models.CaregiverGrant) (*models.CaregiverGrant, error)
+	ListGrantsByOwner(ctx context.Context, ownerID string) ([]models.CaregiverGrant, error)
+	ListGrantsForCaregiver(ctx context.Context, caregiverID, email string) ([]models.CaregiverGrant, error)
+	AcceptGrant(ctx context.Context, grantID, caregiverID, email string) (*models.CaregiverGrant, error)
+	DeleteGrant(ctx context.Context, ownerID, grantID string) error
+	DeleteGrantForCaregiver(ctx context.Context, caregiverID, email, grantID string) error
+	RecordAction(ctx context.Context, action *models.CaregiverAction) error
+	ListActions(ctx context.Context, ownerID string) ([]models.CaregiverAction, error)
+}
+
+type CaregiverStore struct {
+	db *sql.DB
+}
+
+func NewCaregiverStore(db *sql.DB) (*CaregiverStore, error) {
+	return &CaregiverStore{db: db}, nil
+}
+
+const caregiverGrantColumns = `id, owner_id, email, caregiver_id, permission, all_reminders, status, created_at, accepted_at`
+
+func scanCaregiverGrant(row rowScanner) (*models.CaregiverGrant, error) {
+	var grant models.CaregiverGrant
+	err := row.Scan(&grant.Id, &grant.OwnerId, &grant.Email, &grant.CaregiverId, &grant.Permission,
+		&grant.AllReminders, &grant.Status, &grant.CreatedAt, &grant.AcceptedAt)
+	if err != nil {
+		return nil, err
+	}
+	grant.ReminderIds = []string{}
+	return &grant, nil
+}
+
+// sharedReminderCondition matches reminders r that their owner has shared
+// with the caregiver whose id is in argument caregiverArg. Only manage
+// grants match when permission is manage.
+func sharedReminderCondition(caregiverArg int, permission string) string {
+	condition := fmt.Sprintf(`EXISTS (
+		SELECT 1 FROM caregiver_grants g
+		WHERE g.owner_id = r.user_id AND g.caregiver_id = $%d AND g.status = '%s'`,
+		caregiverArg, models.CaregiverGrantAccepted)
+	if permission == models.CaregiverPermissionManage {
+		condition += fmt.Sprintf(` AND g.permission = '%s'`, models.CaregiverPermissionManage)
+	}
+	return condition + ` AND (g.all_reminders OR EXISTS (
+			SELECT 1 FROM caregiver_grant_reminders gr WHERE gr.grant_id = g.id AND gr.reminder_id = r.id
+		)))`
+}
+
+// UpsertGrant invites the grant's email, or changes the permission and
+// reminders of the owner's existing grant for that email without changing
+// whether it was accepted.
+func (s *CaregiverStore) UpsertGrant(ctx context.Context, grant *models.CaregiverGrant) (*models.CaregiverGrant, error) {
+	query := `
+		INSERT INTO caregiver_grants (id, owner_id, email, permission, all_reminders, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (owner_id, email) DO UPDATE SET
+			permission = excluded.permission,
+			all_reminders = excluded.all_reminders
+		RETURNING ` + caregiverGrantColumns
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	saved, err := scanCaregiverGrant(tx.QueryRowContext(ctx, query, grant.Id, grant.OwnerId, grant.Email,
+		grant.Permission, grant.AllReminders, models.CaregiverGrantPending))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM caregiver_grant_reminders WHERE grant_id=$1`, saved.Id); err != nil {
+		return nil, err
+	}
+	for _, reminderID := range grant.ReminderIds {
+		_, err := tx.ExecContext(ctx, `INSERT INTO caregiver_grant_reminders (grant_id, reminder_id) VALUES ($1, $2)`, saved.Id, reminderID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if grant.ReminderIds != nil {
+		saved.ReminderIds = grant.ReminderIds
+	}
+	return saved, nil
+}
+
+func (s *CaregiverStore) ListGrantsByOwner(ctx context.Context, ownerID string) ([]models.CaregiverGrant, error) {
+	query := `SELECT ` + caregiverGrantColumns + ` FROM caregiver_grants WHERE owner_id=$1 ORDER BY created_at, id`
+	return s.listGrants(ctx, query, ownerID)
+}
+
+// ListGrantsForCaregiver returns the grants the caregiver has accepted and
+// the invitations still pending for their email.
+func (s *CaregiverStore) ListGrantsForCaregiver(ctx context.Context, caregiverID, email string) ([]models.CaregiverGrant, error) {
+	query := `
+		SELECT ` + caregiverGrantColumns + ` FROM caregiver_grants
+		WHERE caregiver_id=$1 OR (status=$2 AND email=$3)
+		ORDER BY created_at, id
+	`
+	return s.listGrants(ctx, query, caregiverID, models.CaregiverGrantPending, email)
+}
+
+func (s *CaregiverStore) listGrants(ctx context.Context, query string, args ...interface{}) ([]models.CaregiverGrant, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	grants := []models.CaregiverGrant{}
+	for rows.Next() {
+		grant, err := scanCaregiverGrant(rows)
+		if err != nil {
+			return nil, err
+		}
+		grants = append(grants, *grant)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.loadReminderIds(ctx, grants); err != nil {
+		return nil, err
+	}
+	return grants, nil
+}
+
+// AcceptGrant makes the caregiver the grantee of a pending invitation sent
+// to their email.
+func (s *CaregiverStore) AcceptGrant(ctx context.Context, grantID, caregiverID, email string) (*models.CaregiverGrant, error) {
+	query := `
+		UPDATE caregiver_grants
+		SET caregiver_id = $1, status = $2, accepted_at = CURRENT_TIMESTAMP
+		WHERE id = $3 AND email = $4 AND status = $5
+		RETURNING ` + caregiverGrantColumns
+
+	grant, err := scanCaregiverGrant(s.db.QueryRowContext(ctx, query, caregiverID, models.CaregiverGrantAccepted,
+		grantID, email, models.CaregiverGrantPending))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoCaregiverGrantFoundError{ID: grantID}
+		}
+		return nil, err
+	}
+
+	grants := []models.CaregiverGrant{*grant}
+	if err := s.loadReminderIds(ctx, grants); err != nil {
+		return nil, err
+	}
+	return &grants[0], nil
+}
+
+// DeleteGrant revokes one of the owner's grants, accepted or not.
+func (s *CaregiverStore) DeleteGrant(ctx context.Context, ownerID, grantID string) error {
+	query := `DELETE FROM caregiver_grants WHERE id=$1 AND owner_id=$2`
+	return s.deleteGrant(ctx, grantID, query, grantID, ownerID)
+}
+
+// DeleteGrantForCaregiver declines an invitation sent to the caregiver's
+// email or gives up a grant they accepted.
+func (s *CaregiverStore) DeleteGrantForCaregiver(ctx context.Context, caregiverID, email, grantID string) error {
+	query := `DELETE FROM caregiver_grants WHERE id=$1 AND (caregiver_id=$2 OR (status=$3 AND email=$4))`
+	return s.deleteGrant(ctx, grantID, query, grantID, caregiverID, models.CaregiverGrantPending, email)
+}
+
+func (s *CaregiverStore) deleteGrant(ctx context.Context, grantID, query string, args ...interface{}) error {
+	result, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoCaregiverGrantFoundError{ID: grantID}
+	}
+
+	return nil
+}
+
+func (s *CaregiverStore) RecordAction(ctx context.Context, action *models.CaregiverAction) error {
+	query := `
+		INSERT INTO caregiver_actions (id, owner_id, caregiver_id, reminder_id, action)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+	_, err := s.db.ExecContext(ctx, query, action.Id, action.OwnerId, action.CaregiverId, action.ReminderId, action.Action)
+	return err
+}
+
+// ListActions returns what caregivers did to the owner's reminders, most
+// recent first.
+func (s *CaregiverStore) ListActions(ctx context.Context, ownerID string) ([]models.CaregiverAction, error) {
+	query := `
+		SELECT id, owner_id, caregiver_id, reminder_id, action, created_at
+		FROM caregiver_actions
+		WHERE owner_id=$1
+		ORDER BY created_at DESC, id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	actions := []models.CaregiverAction{}
+	for rows.Next() {
+		var action models.CaregiverAction
+		if err := rows.Scan(&action.Id, &action.OwnerId, &action.CaregiverId, &action.ReminderId, &action.Action, &action.CreatedAt); err != nil {
+			return nil, err
+		}
+		actions = append(actions, action)
+	}
+
+	return actions, rows.Err()
+}
+
+// loadReminderIds fills in the reminders shared by grants that do not
+// cover every reminder.
+func (s *CaregiverStore) loadReminderIds(ctx context.Context, grants []models.CaregiverGrant) error {
+	if len(grants) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*models.CaregiverGrant, len(grants))
+	placeholders := make([]string, len(grants))
+	args := make([]interface{}, len(grants))
+	for i := range grants {
+		byID[grants[i].Id] = &grants[i]
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = grants[i].Id
+	}
+
+	rows, err := s.db.QueryContext(ctx, `
+		SELECT grant_id, reminder_id FROM caregiver_grant_reminders
+		WHERE grant_id IN (`+strings.Join(placeholders, ", ")+`)
+		ORDER BY reminder_id
+	`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var grantID, reminderID string
+		if err := rows.Scan(&grantID, &reminderID); err != nil {
+			return err
+		}
+		byID[grantID].ReminderIds = append(byID[grantID].ReminderIds, reminderID)
+	}
+	return rows.Err()
+}
@@ -0,0 +1,275 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go-version/internal/api/models"
+)
+
+type CheckInStoreInterface interface {
+	GetQuestionnaire(ctx context.Context, userID, reminderID string) (*models.CheckInQuestionnaire, error)
+	ReplaceQuestionnaire(ctx context.Context, questionnaire *models.CheckInQuestionnaire) (*models.CheckInQuestionnaire, error)
+	DeleteQuestionnaire(ctx context.Context, userID, reminderID string) error
+	ReplaceResponse(ctx context.Context, response *models.CheckInResponse) (*models.CheckInResponse, error)
+	ListResponses(ctx context.Context, filters *CheckInResponseListFilters) ([]models.CheckInResponse, error)
+}
+
+type CheckInStore struct {
+	db *sql.DB
+}
+
+func NewCheckInStore(db *sql.DB) (*CheckInStore, error) {
+	return &CheckInStore{db: db}, nil
+}
+
+func (s *CheckInStore) GetQuestionnaire(ctx context.Context, userID, reminderID string) (*models.CheckInQuestionnaire, error) {
+	query := `
+		SELECT id, reminder_id, user_id, created_at, updated_at
+		FROM check_in_questionnaires
+		WHERE reminder_id=$1 AND user_id=$2
+	`
+
+	var questionnaire models.CheckInQuestionnaire
+	err := s.db.QueryRowContext(ctx, query, reminderID, userID).
+		Scan(&questionnaire.Id, &questionnaire.ReminderId, &questionnaire.UserId, &questionnaire.CreatedAt, &questionnaire.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoCheckInQuestionnaireFoundError{ReminderID: reminderID}
+		}
+		return nil, err
+	}
+
+	questionnaire.Questions, err = s.listQuestions(ctx, questionnaire.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &questionnaire, nil
+}
+
+// ReplaceQuestionnaire creates the reminder's questionnaire or replaces the
+// questions of its existing one. Responses already given are kept.
+func (s *CheckInStore) ReplaceQuestionnaire(ctx context.Context, questionnaire *models.CheckInQuestionnaire) (*models.CheckInQuestionnaire, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO check_in_questionnaires (id, reminder_id, user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (reminder_id) DO UPDATE SET user_id = excluded.user_id
+		RETURNING id, reminder_id, user_id, created_at, updated_at
+	`
+
+	var saved models.CheckInQuestionnaire
+	err = tx.QueryRowContext(ctx, query, questionnaire.Id, questionnaire.ReminderId, questionnaire.UserId).
+		Scan(&saved.Id, &saved.ReminderId, &saved.UserId, &saved.CreatedAt, &saved.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM check_in_questions WHERE questionnaire_id=$1`, saved.Id); err != nil {
+		return nil, err
+	}
+
+	for _, question := range questionnaire.Questions {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO check_in_questions (questionnaire_id, position, key, type, prompt, required, min, max, unit)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`, saved.Id, question.Position, question.Key, question.Type, question.Prompt, question.Required,
+			question.Min, question.Max, question.Unit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	saved.Questions = questionnaire.Questions
+	return &saved, nil
+}
+
+func (s *CheckInStore) DeleteQuestionnaire(ctx context.Context, userID, reminderID string) error {
+	query := `DELETE FROM check_in_questionnaires WHERE reminder_id=$1 AND user_id=$2`
+	result, err := s.db.ExecContext(ctx, query, reminderID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoCheckInQuestionnaireFoundError{ReminderID: reminderID}
+	}
+
+	return nil
+}
+
+func (s *CheckInStore) listQuestions(ctx context.Context, questionnaireID string) ([]models.CheckInQuestion, error) {
+	query := `
+		SELECT position, key, type, prompt, required, min, max, unit
+		FROM check_in_questions
+		WHERE questionnaire_id=$1
+		ORDER BY position
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, questionnaireID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	questions := []models.CheckInQuestion{}
+	for rows.Next() {
+		var question models.CheckInQuestion
+		if err := rows.Scan(&question.Position, &question.Key, &question.Type, &question.Prompt, &question.Required,
+			&question.Min, &question.Max, &question.Unit); err != nil {
+			return nil, err
+		}
+		questions = append(questions, question)
+	}
+
+	return questions, rows.Err()
+}
+
+// ReplaceResponse saves the answers given for an occurrence. Answering the
+// same occurrence again replaces the earlier answers.
+func (s *CheckInStore) ReplaceResponse(ctx context.Context, response *models.CheckInResponse) (*models.CheckInResponse, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO check_in_responses (id, reminder_id, user_id, occurrence_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (reminder_id, occurrence_at) DO UPDATE SET submitted_at = CURRENT_TIMESTAMP
+		RETURNING id, reminder_id, user_id, occurrence_at, submitted_at
+	`
+
+	var saved models.CheckInResponse
+	err = tx.QueryRowContext(ctx, query, response.Id, response.ReminderId, response.UserId, response.OccurrenceAt.UTC()).
+		Scan(&saved.Id, &saved.ReminderId, &saved.UserId, &saved.OccurrenceAt, &saved.SubmittedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM check_in_answers WHERE response_id=$1`, saved.Id); err != nil {
+		return nil, err
+	}
+
+	for _, answer := range response.Answers {
+		number, text, boolean := answerColumns(answer.Value)
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO check_in_answers (response_id, question_key, value_number, value_text, value_boolean)
+			VALUES ($1, $2, $3, $4, $5)
+		`, saved.Id, answer.QuestionKey, number, text, boolean)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	saved.Answers = response.Answers
+	return &saved, nil
+}
+
+// ListResponses returns the responses to a reminder's check-ins, most
+// recent occurrence first.
+func (s *CheckInStore) ListResponses(ctx context.Context, filters *CheckInResponseListFilters) ([]models.CheckInResponse, error) {
+	query := `
+		SELECT r.id, r.reminder_id, r.user_id, r.occurrence_at, r.submitted_at,
+			a.question_key, a.value_number, a.value_text, a.value_boolean
+		FROM check_in_responses r
+		LEFT JOIN check_in_answers a ON a.response_id = r.id
+		WHERE r.user_id=$1 AND r.reminder_id=$2
+	`
+
+	args := []interface{}{filters.UserID, filters.ReminderID}
+	argIdx := 3
+
+	if filters.StartDate != nil {
+		query += fmt.Sprintf(` AND r.occurrence_at >= $%d`, argIdx)
+		args = append(args, filters.StartDate.UTC())
+		argIdx++
+	}
+
+	if filters.EndDate != nil {
+		query += fmt.Sprintf(` AND r.occurrence_at <= $%d`, argIdx)
+		args = append(args, filters.EndDate.UTC())
+		argIdx++
+	}
+
+	query += ` ORDER BY r.occurrence_at DESC, a.question_key`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	responses := []models.CheckInResponse{}
+	for rows.Next() {
+		var response models.CheckInResponse
+		var questionKey, text sql.NullString
+		var number sql.NullFloat64
+		var boolean sql.NullBool
+		if err := rows.Scan(&response.Id, &response.ReminderId, &response.UserId, &response.OccurrenceAt, &response.SubmittedAt,
+			&questionKey, &number, &text, &boolean); err != nil {
+			return nil, err
+		}
+
+		n := len(responses)
+		if n == 0 || responses[n-1].Id != response.Id {
+			response.Answers = []models.CheckInAnswer{}
+			responses = append(responses, response)
+			n++
+		}
+		if questionKey.Valid {
+			responses[n-1].Answers = append(responses[n-1].Answers, models.CheckInAnswer{
+				QuestionKey: questionKey.String,
+				Value:       answerValue(number, text, boolean),
+			})
+		}
+	}
+
+	return responses, rows.Err()
+}
+
+// answerColumns splits an answer value into the column holding its type.
+func answerColumns(value any) (*float64, *string, *bool) {
+	switch v := value.(type) {
+	case float64:
+		return &v, nil, nil
+	case string:
+		return nil, &v, nil
+	case bool:
+		return nil, nil, &v
+	}
+	return nil, nil, nil
+}
+
+func answerValue(number sql.NullFloat64, text sql.NullString, boolean sql.NullBool) any {
+	switch {
+	case number.Valid:
+		return number.Float64
+	case text.Valid:
+		return text.String
+	case boolean.Valid:
+		return boolean.Bool
+	}
+	return nil
+}
@@ -0,0 +1,716 @@
+package store_test
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/dal"
+
+	"github.com/google/uuid"
+)
+
+// The conformance suite checks that every backend behaves the same through
+// the store interfaces. SQLite always runs; PostgreSQL runs when
+// TEST_POSTGRES_URL points at a database the tests may create schemas in.
+
+type storeBackend struct {
+	users     store.UserStoreInterface
+	reminders store.ReminderStoreInterface
+	supplies  store.SupplyStoreInterface
+}
+
+func TestStoreConformance_SQLite(t *testing.T) {
+	db, err := dal.NewDatabaseConn(context.Background(), dal.DriverSQLite, filepath.Join(t.TempDir(), "conformance.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	migrateToLatest(t, db, dal.DriverSQLite)
+
+	userStore, _ := store.NewUserStore(db)
+	reminderStore, _ := store.NewReminderStore(db)
+	supplyStore, _ := store.NewSupplyStore(db)
+	runStoreConformance(t, storeBackend{users: userStore, reminders: reminderStore, supplies: supplyStore})
+}
+
+// TestStoreConformance_SQLiteLikeSearch checks the search of backends
+// without a full-text index against SQLite.
+func TestStoreConformance_SQLiteLikeSearch(t *testing.T) {
+	db, err := dal.NewDatabaseConn(context.Background(), dal.DriverSQLite, filepath.Join(t.TempDir(), "conformance.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	migrateToLatest(t, db, dal.DriverSQLite)
+
+	userStore, _ := store.NewUserStore(db)
+	supplyStore, _ := store.NewSupplyStore(db)
+	runStoreConformance(t, storeBackend{users: userStore, reminders: store.NewLikeSearchReminderStore(db), supplies: supplyStore})
+}
+
+func TestStoreConformance_Postgres(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_URL")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_URL is not set")
+	}
+
+	// Each run gets its own schema so that it starts empty and leaves
+	// nothing behind.
+	admin, err := dal.NewDatabaseConn(context.Background(), dal.DriverPostgres, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := fmt.Sprintf("conformance_%d", time.Now().UnixNano())
+	if _, err := admin.Exec(`CREATE SCHEMA ` + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		admin.Exec(`DROP SCHEMA ` + schema + ` CASCADE`)
+		admin.Close()
+	})
+
+	db, err := dal.NewDatabaseConn(context.Background(), dal.DriverPostgres, withSearchPath(dsn, schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	migrateToLatest(t, db, dal.DriverPostgres)
+
+	userStore, _ := store.NewPostgresUserStore(db)
+	reminderStore, _ := store.NewPostgresReminderStore(db)
+	supplyStore, _ := store.NewSupplyStore(db)
+	runStoreConformance(t, storeBackend{users: userStore, reminders: reminderStore, supplies: supplyStore})
+}
+
+// withSearchPath adds search_path to a URL or key=value connection string.
+func withSearchPath(dsn, schema string) string {
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		if strings.Contains(dsn, "?") {
+			return dsn + "&search_path=" + schema
+		}
+		return dsn + "?search_path=" + schema
+	}
+	return dsn + " search_path=" + schema
+}
+
+func migrateToLatest(t *testing.T, db *sql.DB, driver string) {
+	t.Helper()
+
+	migrator, err := dal.NewMigrator(db, driver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func runStoreConformance(t *testing.T, b storeBackend) {
+	t.Run("users", func(t *testing.T) { testUserStore(t, b) })
+	t.Run("users/email case", func(t *testing.T) { testUserEmailCase(t, b) })
+	t.Run("reminders/create and get", func(t *testing.T) { testReminderCreateAndGet(t, b) })
+	t.Run("reminders/update", func(t *testing.T) { testReminderUpdate(t, b) })
+	t.Run("reminders/conditional writes", func(t *testing.T) { testReminderConditionalWrites(t, b) })
+	t.Run("reminders/list", func(t *testing.T) { testReminderList(t, b) })
+	t.Run("reminders/list pages", func(t *testing.T) { testReminderListPages(t, b) })
+	t.Run("reminders/search", func(t *testing.T) { testReminderSearch(t, b) })
+	t.Run("reminders/delete", func(t *testing.T) { testReminderDelete(t, b) })
+	t.Run("reminders/delete refill", func(t *testing.T) { testReminderDeleteRefill(t, b) })
+}
+
+func strPtr(s string) *string { return &s }
+
+func floatPtr(f float64) *float64 { return &f }
+
+func createTestUser(t *testing.T, b storeBackend) *models.User {
+	t.Helper()
+
+	id := uuid.New().String()
+	user, err := b.users.CreateUser(context.Background(), &models.User{
+		Id:       id,
+		Name:     "Ada",
+		Email:    id + "@example.com",
+		Password: "hash",
+		ApiKey:   strPtr("key-" + id),
+	})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	return user
+}
+
+func createTestReminder(t *testing.T, b storeBackend, reminder *models.Reminder) *models.Reminder {
+	t.Helper()
+
+	reminder.Id = uuid.New().String()
+	if reminder.RRule == "" {
+		reminder.RRule = "FREQ=DAILY;COUNT=5"
+	}
+	created, err := b.reminders.CreateReminder(context.Background(), reminder)
+	if err != nil {
+		t.Fatalf("CreateReminder: %v", err)
+	}
+	return created
+}
+
+func testUserStore(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	user := createTestUser(t, b)
+
+	got, err := b.users.GetUser(ctx, user.Id)
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if got.Email != user.Email || got.Name != "Ada" || got.Password != "hash" || *got.ApiKey != *user.ApiKey {
+		t.Errorf("GetUser returned %+v, want %+v", got, user)
+	}
+	if got.CreatedAt == nil || got.UpdatedAt == nil {
+		t.Errorf("Expected timestamps to be set, got %+v", got)
+	}
+
+	byEmail, err := b.users.GetUserByEmail(ctx, user.Email)
+	if err != nil || byEmail.Id != user.Id {
+		t.Errorf("GetUserByEmail returned %+v, %v", byEmail, err)
+	}
+
+	_, err = b.users.GetUserByEmail(ctx, "missing-"+user.Email)
+	var noUserErr *store.NoUserFoundError
+	if !errors.As(err, &noUserErr) {
+		t.Errorf("Expected NoUserFoundError for an unknown email, got %v", err)
+	}
+
+	_, err = b.users.CreateUser(ctx, &models.User{Id: uuid.New().String(), Name: "Bob", Email: user.Email, Password: "hash", ApiKey: strPtr(uuid.New().String())})
+	if err == nil {
+		t.Errorf("Expected a second user with the same email to be rejected")
+	}
+}
+
+func testUserEmailCase(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	local := uuid.New().String()
+
+	alice, err := b.users.CreateUser(ctx, &models.User{Id: uuid.New().String(), Name: "Alice", Email: " " + strings.ToUpper(local) + "@Example.com", Password: "hash", ApiKey: strPtr(uuid.New().String())})
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	if alice.Email != local+"@example.com" {
+		t.Errorf("Expected the email to be stored normalized, got %q", alice.Email)
+	}
+
+	// An account differing only in case would receive the first one's
+	// caregiver invitations.
+	_, err = b.users.CreateUser(ctx, &models.User{Id: uuid.New().String(), Name: "Mallory", Email: local + "@example.com", Password: "hash", ApiKey: strPtr(uuid.New().String())})
+	if err == nil {
+		t.Errorf("Expected an email differing only in case to be rejected")
+	}
+
+	got, err := b.users.GetUserByEmail(ctx, strings.ToUpper(local)+"@EXAMPLE.COM")
+	if err != nil || got.Id != alice.Id {
+		t.Errorf("Expected the lookup to ignore case, got %+v, %v", got, err)
+	}
+}
+
+func testReminderCreateAndGet(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	owner := createTestUser(t, b)
+	stranger := createTestUser(t, b)
+
+	startAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	created := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Morning pills"),
+		StartAt:     startAt,
+		Critical:    true,
+		Medication:  &models.Medication{Name: "Metformin", DoseQuantity: floatPtr(500), DoseUnit: strPtr("mg")},
+	})
+	if !created.StartAt.Equal(startAt) || created.CreatedAt == nil || created.Medication == nil {
+		t.Errorf("CreateReminder returned %+v", created)
+	}
+	if created.FirstOccurrenceAt == nil || !created.FirstOccurrenceAt.Equal(startAt) ||
+		created.LastOccurrenceAt == nil || !created.LastOccurrenceAt.Equal(startAt.AddDate(0, 0, 4)) {
+		t.Errorf("Expected the occurrence bounds to be stored, got %v and %v", created.FirstOccurrenceAt, created.LastOccurrenceAt)
+	}
+
+	got, err := b.reminders.GetReminderByID(ctx, owner.Id, created.Id)
+	if err != nil {
+		t.Fatalf("GetReminderByID: %v", err)
+	}
+	if got.RRule != created.RRule || *got.Description != "Morning pills" || !got.StartAt.Equal(startAt) || !got.Critical {
+		t.Errorf("GetReminderByID returned %+v", got)
+	}
+	if got.Medication == nil || got.Medication.Name != "Metformin" || *got.Medication.DoseQuantity != 500 || *got.Medication.DoseUnit != "mg" {
+		t.Errorf("Expected the medication to be stored, got %+v", got.Medication)
+	}
+
+	var notFoundErr *store.NoReminderFoundError
+	if _, err := b.reminders.GetReminderByID(ctx, stranger.Id, created.Id); !errors.As(err, &notFoundErr) {
+		t.Errorf("Expected NoReminderFoundError for another user, got %v", err)
+	}
+	if _, err := b.reminders.GetAccessibleReminder(ctx, owner.Id, created.Id, models.CaregiverPermissionManage); err != nil {
+		t.Errorf("Expected the owner to have access, got %v", err)
+	}
+	if _, err := b.reminders.GetAccessibleReminder(ctx, stranger.Id, created.Id, models.CaregiverPermissionView); !errors.As(err, &notFoundErr) {
+		t.Errorf("Expected NoReminderFoundError for a user it is not shared with, got %v", err)
+	}
+}
+
+func testReminderUpdate(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	owner := createTestUser(t, b)
+
+	created := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Morning pills"),
+		StartAt:     time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		Medication:  &models.Medication{Name: "Metformin"},
+	})
+
+	startAt := time.Date(2024, 2, 1, 20, 30, 0, 0, time.UTC)
+	updated, err := b.reminders.UpdateReminder(ctx, &models.Reminder{
+		Id:          created.Id,
+		UserId:      owner.Id,
+		RRule:       "FREQ=WEEKLY;COUNT=3",
+		Description: strPtr("Evening pills"),
+		StartAt:     startAt,
+		Critical:    true,
+	})
+	if err != nil {
+		t.Fatalf("UpdateReminder: %v", err)
+	}
+	if updated.RRule != "FREQ=WEEKLY;COUNT=3" || *updated.Description != "Evening pills" || !updated.StartAt.Equal(startAt) || !updated.Critical {
+		t.Errorf("UpdateReminder returned %+v", updated)
+	}
+	if updated.FirstOccurrenceAt == nil || !updated.FirstOccurrenceAt.Equal(startAt) ||
+		updated.LastOccurrenceAt == nil || !updated.LastOccurrenceAt.Equal(startAt.AddDate(0, 0, 14)) {
+		t.Errorf("Expected the occurrence bounds to follow the new rule, got %v and %v", updated.FirstOccurrenceAt, updated.LastOccurrenceAt)
+	}
+	if updated.UpdatedAt == nil || updated.UpdatedAt.Before(*updated.CreatedAt) {
+		t.Errorf("Expected updated_at to be refreshed, got %v (created %v)", updated.UpdatedAt, updated.CreatedAt)
+	}
+
+	got, err := b.reminders.GetReminderByID(ctx, owner.Id, created.Id)
+	if err != nil {
+		t.Fatalf("GetReminderByID: %v", err)
+	}
+	if got.Medication != nil {
+		t.Errorf("Expected the medication to be removed, got %+v", got.Medication)
+	}
+
+	_, err = b.reminders.UpdateReminder(ctx, &models.Reminder{Id: uuid.New().String(), UserId: owner.Id, RRule: "FREQ=DAILY;COUNT=1", StartAt: startAt})
+	var notFoundErr *store.NoReminderFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("Expected NoReminderFoundError for a missing reminder, got %v", err)
+	}
+}
+
+func testReminderConditionalWrites(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	owner := createTestUser(t, b)
+	stranger := createTestUser(t, b)
+
+	created := createTestReminder(t, b, &models.Reminder{UserId: owner.Id, StartAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)})
+
+	// Two writers that both read the created reminder: the first wins and
+	// the second finds it changed.
+	first := *created
+	first.Description = strPtr("First")
+	updated, err := b.reminders.UpdateReminder(ctx, &first)
+	if err != nil {
+		t.Fatalf("UpdateReminder: %v", err)
+	}
+	if updated.Version <= created.Version {
+		t.Errorf("Expected the version to be bumped from %d, got %d", created.Version, updated.Version)
+	}
+
+	second := *created
+	second.Description = strPtr("Second")
+	var changedErr *store.ReminderChangedError
+	if _, err := b.reminders.UpdateReminder(ctx, &second); !errors.As(err, &changedErr) {
+		t.Errorf("Expected ReminderChangedError updating a stale version, got %v", err)
+	}
+	if err := b.reminders.DeleteReminder(ctx, owner.Id, created.Id, created.Version); !errors.As(err, &changedErr) {
+		t.Errorf("Expected ReminderChangedError deleting a stale version, got %v", err)
+	}
+
+	got, err := b.reminders.GetReminderByID(ctx, owner.Id, created.Id)
+	if err != nil {
+		t.Fatalf("GetReminderByID: %v", err)
+	}
+	if *got.Description != "First" || got.Version != updated.Version {
+		t.Errorf("Expected the first write to be kept, got %+v", got)
+	}
+
+	var existsErr *store.ReminderExistsError
+	_, err = b.reminders.CreateReminder(ctx, &models.Reminder{Id: created.Id, UserId: stranger.Id, RRule: "FREQ=DAILY;COUNT=1", StartAt: created.StartAt})
+	if !errors.As(err, &existsErr) {
+		t.Errorf("Expected ReminderExistsError creating over another user's reminder, got %v", err)
+	}
+
+	if err := b.reminders.DeleteReminder(ctx, owner.Id, created.Id, updated.Version); err != nil {
+		t.Errorf("DeleteReminder: %v", err)
+	}
+}
+
+func testReminderList(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	owner := createTestUser(t, b)
+	other := createTestUser(t, b)
+
+	pills := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Morning pills"),
+		StartAt:     time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		Medication:  &models.Medication{Name: "Metformin"},
+	})
+	walk := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Evening walk"),
+		StartAt:     time.Date(2024, 3, 1, 18, 0, 0, 0, time.UTC),
+	})
+	later := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Later pills"),
+		StartAt:     time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+	})
+	finished := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Finished course"),
+		StartAt:     time.Date(2023, 12, 1, 9, 0, 0, 0, time.UTC),
+	})
+	ongoing := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Ongoing check"),
+		RRule:       "FREQ=WEEKLY",
+		StartAt:     time.Date(2023, 12, 1, 9, 0, 0, 0, time.UTC),
+	})
+	createTestReminder(t, b, &models.Reminder{
+		UserId:      other.Id,
+		Description: strPtr("Someone else's pills"),
+		StartAt:     time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+	})
+
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		filters store.ReminderListFilters
+		want    []string
+	}{
+		{name: "all of the user's reminders", filters: store.ReminderListFilters{}, want: []string{pills.Id, walk.Id, later.Id, finished.Id, ongoing.Id}},
+		{name: "search ignores case", filters: store.ReminderListFilters{Search: strPtr("PILLS")}, want: []string{pills.Id, later.Id}},
+		{name: "search matches the medication", filters: store.ReminderListFilters{Search: strPtr("metf")}, want: []string{pills.Id}},
+		{name: "medication", filters: store.ReminderListFilters{Medication: strPtr("FORMIN")}, want: []string{pills.Id}},
+		{name: "date range skips finished series", filters: store.ReminderListFilters{StartDate: &startDate, EndDate: &endDate}, want: []string{pills.Id, walk.Id, ongoing.Id}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.filters.UserID = owner.Id
+			reminders, err := b.reminders.ListReminders(ctx, &tc.filters)
+			if err != nil {
+				t.Fatalf("ListReminders: %v", err)
+			}
+			if got := reminderIDs(reminders); !sameIDs(got, tc.want) {
+				t.Errorf("ListReminders returned %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	active, err := b.reminders.ListActiveReminders(ctx, time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ListActiveReminders: %v", err)
+	}
+	ids := reminderIDs(active)
+	if !slices.Contains(ids, pills.Id) || !slices.Contains(ids, walk.Id) || slices.Contains(ids, later.Id) {
+		t.Errorf("Expected only the reminders started by June, got %v", ids)
+	}
+}
+
+func testReminderListPages(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	owner := createTestUser(t, b)
+
+	amsterdam := time.FixedZone("CEST", 2*60*60)
+	create := func(description string, startAt time.Time) *models.Reminder {
+		return createTestReminder(t, b, &models.Reminder{UserId: owner.Id, Description: strPtr(description), StartAt: startAt})
+	}
+	// Start times are written in different offsets, and two are equal, so
+	// that ids break the tie. Descriptions differ in case.
+	create("delta", time.Date(2024, 1, 1, 9, 0, 0, 0, amsterdam))
+	create("Alpha", time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC))
+	create("charlie", time.Date(2024, 1, 1, 10, 0, 0, 0, amsterdam))
+	create("Bravo", time.Date(2024, 1, 1, 7, 30, 0, 0, time.UTC))
+	create("echo", time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC))
+
+	all, err := b.reminders.ListReminders(ctx, &store.ReminderListFilters{UserID: owner.Id})
+	if err != nil {
+		t.Fatalf("ListReminders: %v", err)
+	}
+	compareKeys := map[string]func(a, b *models.Reminder) int{
+		models.ReminderSortStartAt:   func(a, b *models.Reminder) int { return a.StartAt.Compare(b.StartAt) },
+		models.ReminderSortCreatedAt: func(a, b *models.Reminder) int { return a.CreatedAt.Compare(*b.CreatedAt) },
+		models.ReminderSortDescription: func(a, b *models.Reminder) int {
+			return strings.Compare(strings.ToLower(*a.Description), strings.ToLower(*b.Description))
+		},
+	}
+
+	for sort, compareKey := range compareKeys {
+		for _, descending := range []bool{false, true} {
+			name := sort
+			if descending {
+				name += " desc"
+			}
+			t.Run(name, func(t *testing.T) {
+				want := slices.Clone(all)
+				slices.SortFunc(want, func(a, b models.Reminder) int {
+					order := cmp.Or(compareKey(&a, &b), strings.Compare(a.Id, b.Id))
+					if descending {
+						return -order
+					}
+					return order
+				})
+
+				var got []string
+				limit := 2
+				filters := &store.ReminderListFilters{UserID: owner.Id, Sort: sort, Descending: descending, Limit: &limit}
+				for {
+					page, err := b.reminders.ListReminders(ctx, filters)
+					if err != nil {
+						t.Fatalf("ListReminders: %v", err)
+					}
+					got = append(got, reminderIDs(page)...)
+					if len(page) < limit {
+						break
+					}
+					last := page[len(page)-1]
+					filters.After = &store.ReminderListKey{Text: *last.Description, ID: last.Id}
+					if sort == models.ReminderSortStartAt {
+						filters.After.Time = &last.StartAt
+					} else if sort == models.ReminderSortCreatedAt {
+						filters.After.Time = last.CreatedAt
+					}
+				}
+				if !slices.Equal(got, reminderIDs(want)) {
+					t.Errorf("Pages held %v, want %v", got, reminderIDs(want))
+				}
+			})
+		}
+	}
+
+	// A search may reject rows its query matched, as LIKE does "ill" within
+	// "chill", and pages are still filled.
+	t.Run("search", func(t *testing.T) {
+		searcher := createTestUser(t, b)
+		var want []string
+		for i, description := range []string{"chill", "ill today", "chill", "chill", "ill again"} {
+			reminder := createTestReminder(t, b, &models.Reminder{
+				UserId:      searcher.Id,
+				Description: strPtr(description),
+				StartAt:     time.Date(2024, 1, 1+i, 9, 0, 0, 0, time.UTC),
+			})
+			if !strings.HasPrefix(description, "chill") {
+				want = append(want, reminder.Id)
+			}
+		}
+
+		limit := 1
+		filters := &store.ReminderListFilters{UserID: searcher.Id, Search: strPtr("ill"), Sort: models.ReminderSortStartAt, Limit: &limit}
+		var got []string
+		for range 3 {
+			page, err := b.reminders.ListReminders(ctx, filters)
+			if err != nil {
+				t.Fatalf("ListReminders: %v", err)
+			}
+			if len(page) == 0 {
+				break
+			}
+			got = append(got, reminderIDs(page)...)
+			filters.After = &store.ReminderListKey{Time: &page[0].StartAt, ID: page[0].Id}
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("Pages held %v, want %v", got, want)
+		}
+	})
+}
+
+func testReminderSearch(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	owner := createTestUser(t, b)
+	startAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	pills := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Take blood pressure pills"),
+		StartAt:     startAt,
+		Medication:  &models.Medication{Name: "Lisinopril", Instructions: strPtr("With water")},
+	})
+	check := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Pressure check"),
+		StartAt:     startAt,
+	})
+	plants := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Water the <b>plants</b>"),
+		StartAt:     startAt,
+		Medication:  &models.Medication{Name: "Vitamin D"},
+	})
+	effort := createTestReminder(t, b, &models.Reminder{
+		UserId:      owner.Id,
+		Description: strPtr("Give 100% effort_day"),
+		StartAt:     startAt,
+	})
+
+	search := func(t *testing.T, search string) []models.Reminder {
+		t.Helper()
+		reminders, err := b.reminders.ListReminders(ctx, &store.ReminderListFilters{UserID: owner.Id, Search: &search})
+		if err != nil {
+			t.Fatalf("ListReminders(%q): %v", search, err)
+		}
+		return reminders
+	}
+
+	testCases := []struct {
+		search string
+		want   []string
+	}{
+		{search: "pressure", want: []string{pills.Id, check.Id}},
+		{search: "pressure check", want: []string{check.Id}},
+		{search: `"blood pressure"`, want: []string{pills.Id}},
+		{search: `"pressure pill"`, want: nil},
+		{search: `"pressure pill"*`, want: []string{pills.Id}},
+		{search: "pil", want: []string{pills.Id}},
+		{search: "lisin", want: []string{pills.Id}},
+		{search: "water", want: []string{pills.Id, plants.Id}},
+		{search: "100%", want: []string{effort.Id}},
+		{search: "effort_day", want: []string{effort.Id}},
+		{search: "pre%ure", want: nil},
+		{search: "p_lls", want: nil},
+		{search: `""`, want: nil},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.search, func(t *testing.T) {
+			if got := reminderIDs(search(t, tc.search)); !sameIDs(got, tc.want) {
+				t.Errorf("ListReminders returned %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("ranks and highlights matches", func(t *testing.T) {
+		found := search(t, "water")
+		slices.SortFunc(found, func(a, b models.Reminder) int { return cmp.Compare(*a.SearchRank, *b.SearchRank) })
+		if got := reminderIDs(found); !slices.Equal(got, []string{plants.Id, pills.Id}) {
+			t.Errorf("Expected the description match first, got %v", got)
+		}
+		if want := "<mark>Water</mark> the &lt;b&gt;plants&lt;/b&gt;"; found[0].Snippet == nil || *found[0].Snippet != want {
+			t.Errorf("Expected snippet %q, got %v", want, found[0].Snippet)
+		}
+
+		found = search(t, `"blood pressure"`)
+		if len(found) != 1 || found[0].Snippet == nil || !strings.Contains(*found[0].Snippet, "<mark>blood pressure</mark>") {
+			t.Errorf("Expected the phrase to be highlighted, got %v", found)
+		}
+	})
+
+	t.Run("follows changes", func(t *testing.T) {
+		check.Description = strPtr("Blood sugar")
+		if _, err := b.reminders.UpdateReminder(ctx, check); err != nil {
+			t.Fatalf("UpdateReminder: %v", err)
+		}
+		plants.Medication = &models.Medication{Name: "Folic acid"}
+		if _, err := b.reminders.UpdateReminder(ctx, plants); err != nil {
+			t.Fatalf("UpdateReminder: %v", err)
+		}
+		if err := b.reminders.DeleteReminder(ctx, owner.Id, pills.Id, 0); err != nil {
+			t.Fatalf("DeleteReminder: %v", err)
+		}
+
+		for text, want := range map[string][]string{
+			"pressure": nil,
+			"sugar":    {check.Id},
+			"vitamin":  nil,
+			"folic":    {plants.Id},
+		} {
+			if got := reminderIDs(search(t, text)); !sameIDs(got, want) {
+				t.Errorf("Searching %q returned %v, want %v", text, got, want)
+			}
+		}
+	})
+}
+
+func testReminderDelete(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	owner := createTestUser(t, b)
+	stranger := createTestUser(t, b)
+
+	created := createTestReminder(t, b, &models.Reminder{UserId: owner.Id, StartAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)})
+
+	var notFoundErr *store.NoReminderFoundError
+	if err := b.reminders.DeleteReminder(ctx, stranger.Id, created.Id, 0); !errors.As(err, &notFoundErr) {
+		t.Errorf("Expected NoReminderFoundError deleting another user's reminder, got %v", err)
+	}
+	if err := b.reminders.DeleteReminder(ctx, owner.Id, created.Id, 0); err != nil {
+		t.Fatalf("DeleteReminder: %v", err)
+	}
+	if _, err := b.reminders.GetReminderByID(ctx, owner.Id, created.Id); !errors.As(err, &notFoundErr) {
+		t.Errorf("Expected the reminder to be gone, got %v", err)
+	}
+	if err := b.reminders.DeleteReminder(ctx, owner.Id, created.Id, 0); !errors.As(err, &notFoundErr) {
+		t.Errorf("Expected NoReminderFoundError deleting twice, got %v", err)
+	}
+}
+
+func testReminderDeleteRefill(t *testing.T, b storeBackend) {
+	ctx := context.Background()
+	owner := createTestUser(t, b)
+
+	tracked := createTestReminder(t, b, &models.Reminder{UserId: owner.Id, StartAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)})
+	refill := createTestReminder(t, b, &models.Reminder{UserId: owner.Id, RRule: "FREQ=DAILY;COUNT=1", StartAt: time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC)})
+	other := createTestReminder(t, b, &models.Reminder{UserId: owner.Id, StartAt: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)})
+
+	if _, err := b.supplies.UpsertSupply(ctx, &models.ReminderSupply{ReminderId: tracked.Id, UserId: owner.Id, Quantity: 10, DosePerOccurrence: 1, RefillThresholdDays: 7}); err != nil {
+		t.Fatalf("UpsertSupply: %v", err)
+	}
+	if err := b.supplies.SetRefillReminder(ctx, owner.Id, tracked.Id, &refill.Id); err != nil {
+		t.Fatalf("SetRefillReminder: %v", err)
+	}
+
+	if err := b.reminders.DeleteReminder(ctx, owner.Id, tracked.Id, 0); err != nil {
+		t.Fatalf("DeleteReminder: %v", err)
+	}
+
+	var notFoundErr *store.NoReminderFoundError
+	if _, err := b.reminders.GetReminderByID(ctx, owner.Id, refill.Id); !errors.As(err, &notFoundErr) {
+		t.Errorf("Expected the refill reminder to be gone, got %v", err)
+	}
+	if _, err := b.reminders.GetReminderByID(ctx, owner.Id, other.Id); err != nil {
+		t.Errorf("Expected other reminders to stay, got %v", err)
+	}
+}
+
+func reminderIDs(reminders []models.Reminder) []string {
+	ids := make([]string, len(reminders))
+	for i, reminder := range reminders {
+		ids[i] = reminder.Id
+	}
+	return ids
+}
+
+func sameIDs(got, want []string) bool {
+	got, want = slices.Clone(got), slices.Clone(want)
+	slices.Sort(got)
+	slices.Sort(want)
+	return slices.Equal(got, want)
+}
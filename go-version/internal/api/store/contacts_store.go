@@ -0,0 +1,115 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+type ContactStoreInterface interface {
+	ListContacts(ctx context.Context, userID string) ([]models.Contact, error)
+	GetContact(ctx context.Context, userID, contactID string) (*models.Contact, error)
+	CreateContact(ctx context.Context, contact *models.Contact) (*models.Contact, error)
+	DeleteContact(ctx context.Context, userID, contactID string) error
+}
+
+type ContactStore struct {
+	db *sql.DB
+}
+
+func NewContactStore(db *sql.DB) (*ContactStore, error) {
+	return &ContactStore{db: db}, nil
+}
+
+func (s *ContactStore) ListContacts(ctx context.Context, userID string) ([]models.Contact, error) {
+	query := `
+		SELECT id, user_id, name, email, created_at, updated_at
+		FROM contacts
+		WHERE user_id=$1
+		ORDER BY name
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var contacts []models.Contact
+	for rows.Next() {
+		var contact models.Contact
+		if err := rows.Scan(&contact.Id, &contact.UserId, &contact.Name, &contact.Email, &contact.CreatedAt, &contact.UpdatedAt); err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, contact)
+	}
+
+	return contacts, rows.Err()
+}
+
+func (s *ContactStore) GetContact(ctx context.Context, userID, contactID string) (*models.Contact, error) {
+	query := `
+		SELECT id, user_id, name, email, created_at, updated_at
+		FROM contacts
+		WHERE id=$1 AND user_id=$2
+	`
+
+	var contact models.Contact
+	err := s.db.QueryRowContext(ctx, query, contactID, userID).Scan(&contact.Id, &contact.UserId, &contact.Name, &contact.Email, &contact.CreatedAt, &contact.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoContactFoundError{ID: contactID}
+		}
+		return nil, err
+	}
+	return &contact, nil
+}
+
+func (s *ContactStore) CreateContact(ctx context.Context, contact *models.Contact) (*models.Contact, error) {
+	query := `
+		INSERT INTO contacts (id, user_id, name, email)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, user_id, name, email, created_at, updated_at
+	`
+
+	var created models.Contact
+	err := s.db.QueryRowContext(ctx, query, contact.Id, contact.UserId, contact.Name, contact.Email).
+		Scan(&created.Id, &created.UserId, &created.Name, &created.Email, &created.CreatedAt, &created.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// DeleteContact removes the contact along with any escalation steps that
+// notify them, since foreign keys are not enforced on every connection.
+func (s *ContactStore) DeleteContact(ctx context.Context, userID, contactID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `DELETE FROM contacts WHERE id=$1 AND user_id=$2`
+	result, err := tx.ExecContext(ctx, query, contactID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoContactFoundError{ID: contactID}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM escalation_steps WHERE contact_id=$1`, contactID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+type DeviceStoreInterface interface {
+	ListDevices(ctx context.Context, userID string) ([]models.Device, error)
+	UpsertDevice(ctx context.Context, device *models.Device) (*models.Device, error)
+	DeleteDevice(ctx context.Context, userID, deviceID string) error
+	DeleteDeviceByToken(ctx context.Context, platform, token string) error
+}
+
+type DeviceStore struct {
+	db *sql.DB
+}
+
+func NewDeviceStore(db *sql.DB) (*DeviceStore, error) {
+	return &DeviceStore{db: db}, nil
+}
+
+func (s *DeviceStore) ListDevices(ctx context.Context, userID string) ([]models.Device, error) {
+	query := `
+		SELECT id, user_id, platform, token, app_version, created_at, updated_at
+		FROM devices
+		WHERE user_id=$1
+		ORDER BY created_at
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var devices []models.Device
+	for rows.Next() {
+		var device models.Device
+		if err := rows.Scan(&device.Id, &device.UserId, &device.Platform, &device.Token, &device.AppVersion, &device.CreatedAt, &device.UpdatedAt); err != nil {
+			return nil, err
+		}
+		devices = append(devices, device)
+	}
+
+	return devices, rows.Err()
+}
+
+// UpsertDevice registers the token, moving it to the given user and app
+// version if the same platform token was registered before. Tokens are
+// reissued to whoever is signed in on the device, so the latest owner wins.
+func (s *DeviceStore) UpsertDevice(ctx context.Context, device *models.Device) (*models.Device, error) {
+	query := `
+		INSERT INTO devices (id, user_id, platform, token, app_version)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (platform, token) DO UPDATE SET
+			user_id = excluded.user_id,
+			app_version = excluded.app_version
+		RETURNING id, user_id, platform, token, app_version, created_at, updated_at
+	`
+
+	var created models.Device
+	err := s.db.QueryRowContext(ctx, query,
+		device.Id,
+		device.UserId,
+		device.Platform,
+		device.Token,
+		device.AppVersion,
+	).Scan(&created.Id, &created.UserId, &created.Platform, &created.Token, &created.AppVersion, &created.CreatedAt, &created.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (s *DeviceStore) DeleteDevice(ctx context.Context, userID, deviceID string) error {
+	query := `DELETE FROM devices WHERE id=$1 AND user_id=$2`
+	result, err := s.db.ExecContext(ctx, query, deviceID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoDeviceFoundError{ID: deviceID}
+	}
+
+	return nil
+}
+
+func (s *DeviceStore) DeleteDeviceByToken(ctx context.Context, platform, token string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM devices WHERE platform=$1 AND token=$2`, platform, token)
+	return err
+}
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go-version/internal/api/models"
+)
+
+type DigestSubscriptionStoreInterface interface {
+	GetDigestSubscription(ctx context.Context, userID string) (*models.DigestSubscription, error)
+	UpsertDigestSubscription(ctx context.Context, subscription *models.DigestSubscription) (*models.DigestSubscription, error)
+	DeleteDigestSubscription(ctx context.Context, userID string) error
+	ListDigestSubscriptions(ctx context.Context) ([]models.DigestSubscription, error)
+	MarkDigestSent(ctx context.Context, userID string, sentAt time.Time) error
+}
+
+type DigestSubscriptionStore struct {
+	db *sql.DB
+}
+
+func NewDigestSubscriptionStore(db *sql.DB) (*DigestSubscriptionStore, error) {
+	return &DigestSubscriptionStore{db: db}, nil
+}
+
+func (s *DigestSubscriptionStore) GetDigestSubscription(ctx context.Context, userID string) (*models.DigestSubscription, error) {
+	query := `
+		SELECT user_id, frequency, send_time, weekday, time_zone, channel, last_sent_at, created_at, updated_at
+		FROM digest_subscriptions
+		WHERE user_id=$1
+	`
+
+	var sub models.DigestSubscription
+	err := s.db.QueryRowContext(ctx, query, userID).Scan(&sub.UserId, &sub.Frequency, &sub.SendTime, &sub.Weekday, &sub.TimeZone, &sub.Channel, &sub.LastSentAt, &sub.CreatedAt, &sub.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoDigestSubscriptionFoundError{UserID: userID}
+		}
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+// UpsertDigestSubscription saves the user's digest settings. The time the
+// last digest was sent is kept so changing the settings does not resend the
+// current period's digest.
+func (s *DigestSubscriptionStore) UpsertDigestSubscription(ctx context.Context, subscription *models.DigestSubscription) (*models.DigestSubscription, error) {
+	query := `
+		INSERT INTO digest_subscriptions (user_id, frequency, send_time, weekday, time_zone, channel)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO UPDATE SET
+			frequency = excluded.frequency,
+			send_time = excluded.send_time,
+			weekday = excluded.weekday,
+			time_zone = excluded.time_zone,
+			channel = excluded.channel
+		RETURNING user_id, frequency, send_time, weekday, time_zone, channel, last_sent_at, created_at, updated_at
+	`
+
+	var saved models.DigestSubscription
+	err := s.db.QueryRowContext(ctx, query,
+		subscription.UserId,
+		subscription.Frequency,
+		subscription.SendTime,
+		subscription.Weekday,
+		subscription.TimeZone,
+		subscription.Channel,
+	).Scan(&saved.UserId, &saved.Frequency, &saved.SendTime, &saved.Weekday, &saved.TimeZone, &saved.Channel, &saved.LastSentAt, &saved.CreatedAt, &saved.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+func (s *DigestSubscriptionStore) DeleteDigestSubscription(ctx context.Context, userID string) error {
+	query := `DELETE FROM digest_subscriptions WHERE user_id=$1`
+	result, err := s.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoDigestSubscriptionFoundError{UserID: userID}
+	}
+
+	return nil
+}
+
+// ListDigestSubscriptions returns every user's subscription. It is used by
+// the background digest job rather than by requests.
+func (s *DigestSubscriptionStore) ListDigestSubscriptions(ctx context.Context) ([]models.DigestSubscription, error) {
+	query := `
+		SELECT user_id, frequency, send_time, weekday, time_zone, channel, last_sent_at, created_at, updated_at
+		FROM digest_subscriptions
+	`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []models.DigestSubscription
+	for rows.Next() {
+		var sub models.DigestSubscription
+		if err := rows.Scan(&sub.UserId, &sub.Frequency, &sub.SendTime, &sub.Weekday, &sub.TimeZone, &sub.Channel, &sub.LastSentAt, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+func (s *DigestSubscriptionStore) MarkDigestSent(ctx context.Context, userID string, sentAt time.Time) error {
+	query := `UPDATE digest_subscriptions SET last_sent_at=$1 WHERE user_id=$2`
+	_, err := s.db.ExecContext(ctx, query, sentAt.UTC(), userID)
+	return err
+}
@@ -8,3 +8,149 @@ type NoReminderFoundError struct {
 func (e *NoReminderFoundError) Error() string {
 	return "no reminder found with ID " + e.ID
 }
+
+// ReminderExistsError is returned when a reminder is created with an id
+// that is already taken.
+type ReminderExistsError struct {
+	ID string
+}
+
+func (e *ReminderExistsError) Error() string {
+	return "a reminder with ID " + e.ID + " already exists"
+}
+
+// ReminderChangedError is returned by a conditional write when the reminder
+// is no longer at the version it was expected to be at.
+type ReminderChangedError struct {
+	ID string
+}
+
+func (e *ReminderChangedError) Error() string {
+	return "reminder " + e.ID + " was changed or deleted"
+}
+
+type NoPushSubscriptionFoundError struct {
+	ID string
+}
+
+func (e *NoPushSubscriptionFoundError) Error() string {
+	return "no push subscription found with ID " + e.ID
+}
+
+type NoVapidKeysFoundError struct{}
+
+func (e *NoVapidKeysFoundError) Error() string {
+	return "no vapid keys have been stored"
+}
+
+type NoDeviceFoundError struct {
+	ID string
+}
+
+func (e *NoDeviceFoundError) Error() string {
+	return "no device found with ID " + e.ID
+}
+
+type NoContactFoundError struct {
+	ID string
+}
+
+func (e *NoContactFoundError) Error() string {
+	return "no contact found with ID " + e.ID
+}
+
+type NoEscalationPolicyFoundError struct {
+	ReminderID string
+}
+
+func (e *NoEscalationPolicyFoundError) Error() string {
+	return "no escalation policy found for reminder " + e.ReminderID
+}
+
+type NoQuietHoursFoundError struct {
+	UserID string
+}
+
+func (e *NoQuietHoursFoundError) Error() string {
+	return "no quiet hours configured for user " + e.UserID
+}
+
+type NoDigestSubscriptionFoundError struct {
+	UserID string
+}
+
+func (e *NoDigestSubscriptionFoundError) Error() string {
+	return "no digest subscription found for user " + e.UserID
+}
+
+type NoCalendarFeedFoundError struct{}
+
+func (e *NoCalendarFeedFoundError) Error() string {
+	return "no calendar feed found"
+}
+
+type NoReminderSupplyFoundError struct {
+	ReminderID string
+}
+
+func (e *NoReminderSupplyFoundError) Error() string {
+	return "no supply is tracked for reminder " + e.ReminderID
+}
+
+type NoCheckInQuestionnaireFoundError struct {
+	ReminderID string
+}
+
+func (e *NoCheckInQuestionnaireFoundError) Error() string {
+	return "no check-in questionnaire found for reminder " + e.ReminderID
+}
+
+type NoJournalEntryFoundError struct {
+	ID string
+}
+
+func (e *NoJournalEntryFoundError) Error() string {
+	return "no journal entry found with ID " + e.ID
+}
+
+type NoCaregiverGrantFoundError struct {
+	ID string
+}
+
+func (e *NoCaregiverGrantFoundError) Error() string {
+	return "no caregiver grant found with ID " + e.ID
+}
+
+type NoUserFoundError struct {
+	Email string
+}
+
+func (e *NoUserFoundError) Error() string {
+	return "no user found with email " + e.Email
+}
+
+type NoOrganizationMemberFoundError struct {
+	OrganizationID string
+	UserID         string
+}
+
+func (e *NoOrganizationMemberFoundError) Error() string {
+	return "user " + e.UserID + " is not a member of organization " + e.OrganizationID
+}
+
+type NoPatientEnrollmentFoundError struct {
+	ID string
+}
+
+func (e *NoPatientEnrollmentFoundError) Error() string {
+	return "no patient enrollment found with ID " + e.ID
+}
+
+type PatientNotEnrolledError struct {
+	OrganizationID string
+	PatientID      string
+}
+
+func (e *PatientNotEnrolledError) Error() string {
+	return "patient " + e.PatientID + " is not enrolled in organization " + e.OrganizationID
+}
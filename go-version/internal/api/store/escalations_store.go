@@ -0,0 +1,308 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go-version/internal/api/models"
+)
+
+type EscalationStoreInterface interface {
+	GetPolicy(ctx context.Context, userID, reminderID string) (*models.EscalationPolicy, error)
+	ReplacePolicy(ctx context.Context, policy *models.EscalationPolicy) (*models.EscalationPolicy, error)
+	DeletePolicy(ctx context.Context, userID, reminderID string) error
+	ListPolicies(ctx context.Context) ([]models.EscalationPolicy, error)
+	CreateAcknowledgement(ctx context.Context, ack *models.ReminderAcknowledgement) (*models.ReminderAcknowledgement, error)
+	IsAcknowledged(ctx context.Context, reminderID string, occurrenceAt time.Time) (bool, error)
+	ListAcknowledgements(ctx context.Context, userID string, start, end time.Time) ([]models.ReminderAcknowledgement, error)
+	HasEvent(ctx context.Context, reminderID string, stepPosition int, occurrenceAt time.Time) (bool, error)
+	CreateEvent(ctx context.Context, event *models.EscalationEvent) (*models.EscalationEvent, error)
+	ListEvents(ctx context.Context, userID, reminderID string) ([]models.EscalationEvent, error)
+}
+
+type EscalationStore struct {
+	db *sql.DB
+}
+
+func NewEscalationStore(db *sql.DB) (*EscalationStore, error) {
+	return &EscalationStore{db: db}, nil
+}
+
+func (s *EscalationStore) GetPolicy(ctx context.Context, userID, reminderID string) (*models.EscalationPolicy, error) {
+	query := `
+		SELECT id, reminder_id, user_id, created_at, updated_at
+		FROM escalation_policies
+		WHERE reminder_id=$1 AND user_id=$2
+	`
+
+	var policy models.EscalationPolicy
+	err := s.db.QueryRowContext(ctx, query, reminderID, userID).Scan(&policy.Id, &policy.ReminderId, &policy.UserId, &policy.CreatedAt, &policy.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoEscalationPolicyFoundError{ReminderID: reminderID}
+		}
+		return nil, err
+	}
+
+	policy.Steps, err = s.listSteps(ctx, policy.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+// ReplacePolicy creates the reminder's policy or replaces the steps of its
+// existing one. The policy id and created_at survive replacement so that
+// occurrences due before the policy existed are never escalated.
+func (s *EscalationStore) ReplacePolicy(ctx context.Context, policy *models.EscalationPolicy) (*models.EscalationPolicy, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO escalation_policies (id, reminder_id, user_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (reminder_id) DO UPDATE SET user_id = excluded.user_id
+		RETURNING id, reminder_id, user_id, created_at, updated_at
+	`
+
+	var saved models.EscalationPolicy
+	err = tx.QueryRowContext(ctx, query, policy.Id, policy.ReminderId, policy.UserId).
+		Scan(&saved.Id, &saved.ReminderId, &saved.UserId, &saved.CreatedAt, &saved.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM escalation_steps WHERE policy_id=$1`, saved.Id); err != nil {
+		return nil, err
+	}
+
+	for _, step := range policy.Steps {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO escalation_steps (policy_id, position, delay_minutes, contact_id, channel)
+			VALUES ($1, $2, $3, $4, $5)
+		`, saved.Id, step.Position, step.DelayMinutes, step.ContactId, step.Channel)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	saved.Steps = policy.Steps
+	return &saved, nil
+}
+
+func (s *EscalationStore) DeletePolicy(ctx context.Context, userID, reminderID string) error {
+	query := `DELETE FROM escalation_policies WHERE reminder_id=$1 AND user_id=$2`
+	result, err := s.db.ExecContext(ctx, query, reminderID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoEscalationPolicyFoundError{ReminderID: reminderID}
+	}
+
+	return nil
+}
+
+// ListPolicies returns every user's policies with their steps. It is used by
+// the background escalation worker rather than by requests.
+func (s *EscalationStore) ListPolicies(ctx context.Context) ([]models.EscalationPolicy, error) {
+	query := `
+		SELECT p.id, p.reminder_id, p.user_id, p.created_at, p.updated_at,
+			s.position, s.delay_minutes, s.contact_id, s.channel
+		FROM escalation_policies p
+		JOIN escalation_steps s ON s.policy_id = p.id
+		ORDER BY p.id, s.position
+	`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var policies []models.EscalationPolicy
+	for rows.Next() {
+		var policy models.EscalationPolicy
+		var step models.EscalationStep
+		if err := rows.Scan(&policy.Id, &policy.ReminderId, &policy.UserId, &policy.CreatedAt, &policy.UpdatedAt,
+			&step.Position, &step.DelayMinutes, &step.ContactId, &step.Channel); err != nil {
+			return nil, err
+		}
+
+		if n := len(policies); n > 0 && policies[n-1].Id == policy.Id {
+			policies[n-1].Steps = append(policies[n-1].Steps, step)
+			continue
+		}
+		policy.Steps = []models.EscalationStep{step}
+		policies = append(policies, policy)
+	}
+
+	return policies, rows.Err()
+}
+
+func (s *EscalationStore) listSteps(ctx context.Context, policyID string) ([]models.EscalationStep, error) {
+	query := `
+		SELECT position, delay_minutes, contact_id, channel
+		FROM escalation_steps
+		WHERE policy_id=$1
+		ORDER BY position
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, policyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	steps := []models.EscalationStep{}
+	for rows.Next() {
+		var step models.EscalationStep
+		if err := rows.Scan(&step.Position, &step.DelayMinutes, &step.ContactId, &step.Channel); err != nil {
+			return nil, err
+		}
+		steps = append(steps, step)
+	}
+
+	return steps, rows.Err()
+}
+
+// CreateAcknowledgement marks an occurrence as done. Acknowledging the same
+// occurrence twice keeps the original acknowledgement.
+func (s *EscalationStore) CreateAcknowledgement(ctx context.Context, ack *models.ReminderAcknowledgement) (*models.ReminderAcknowledgement, error) {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO reminder_acknowledgements (id, reminder_id, user_id, occurrence_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (reminder_id, occurrence_at) DO NOTHING
+	`, ack.Id, ack.ReminderId, ack.UserId, ack.OccurrenceAt.UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		SELECT id, reminder_id, user_id, occurrence_at, acknowledged_at
+		FROM reminder_acknowledgements
+		WHERE reminder_id=$1 AND occurrence_at=$2
+	`
+
+	var saved models.ReminderAcknowledgement
+	err = s.db.QueryRowContext(ctx, query, ack.ReminderId, ack.OccurrenceAt.UTC()).
+		Scan(&saved.Id, &saved.ReminderId, &saved.UserId, &saved.OccurrenceAt, &saved.AcknowledgedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+func (s *EscalationStore) IsAcknowledged(ctx context.Context, reminderID string, occurrenceAt time.Time) (bool, error) {
+	query := `SELECT COUNT(1) FROM reminder_acknowledgements WHERE reminder_id=$1 AND occurrence_at=$2`
+
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, reminderID, occurrenceAt.UTC()).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// ListAcknowledgements returns the user's acknowledgements of occurrences
+// falling within the range.
+func (s *EscalationStore) ListAcknowledgements(ctx context.Context, userID string, start, end time.Time) ([]models.ReminderAcknowledgement, error) {
+	query := `
+		SELECT id, reminder_id, user_id, occurrence_at, acknowledged_at
+		FROM reminder_acknowledgements
+		WHERE user_id=$1 AND occurrence_at >= $2 AND occurrence_at <= $3
+		ORDER BY occurrence_at
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID, start.UTC(), end.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	acks := []models.ReminderAcknowledgement{}
+	for rows.Next() {
+		var ack models.ReminderAcknowledgement
+		if err := rows.Scan(&ack.Id, &ack.ReminderId, &ack.UserId, &ack.OccurrenceAt, &ack.AcknowledgedAt); err != nil {
+			return nil, err
+		}
+		acks = append(acks, ack)
+	}
+
+	return acks, rows.Err()
+}
+
+func (s *EscalationStore) HasEvent(ctx context.Context, reminderID string, stepPosition int, occurrenceAt time.Time) (bool, error) {
+	query := `
+		SELECT COUNT(1) FROM escalation_events
+		WHERE reminder_id=$1 AND step_position=$2 AND occurrence_at=$3
+	`
+
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, reminderID, stepPosition, occurrenceAt.UTC()).Scan(&count); err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (s *EscalationStore) CreateEvent(ctx context.Context, event *models.EscalationEvent) (*models.EscalationEvent, error) {
+	query := `
+		INSERT INTO escalation_events (id, policy_id, reminder_id, user_id, step_position, contact_id, channel, occurrence_at, status, error)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, policy_id, reminder_id, user_id, step_position, contact_id, channel, occurrence_at, status, error, created_at
+	`
+
+	var saved models.EscalationEvent
+	err := s.db.QueryRowContext(ctx, query,
+		event.Id, event.PolicyId, event.ReminderId, event.UserId, event.StepPosition,
+		event.ContactId, event.Channel, event.OccurrenceAt.UTC(), event.Status, event.Error,
+	).Scan(&saved.Id, &saved.PolicyId, &saved.ReminderId, &saved.UserId, &saved.StepPosition,
+		&saved.ContactId, &saved.Channel, &saved.OccurrenceAt, &saved.Status, &saved.Error, &saved.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+func (s *EscalationStore) ListEvents(ctx context.Context, userID, reminderID string) ([]models.EscalationEvent, error) {
+	query := `
+		SELECT id, policy_id, reminder_id, user_id, step_position, contact_id, channel, occurrence_at, status, error, created_at
+		FROM escalation_events
+		WHERE user_id=$1 AND reminder_id=$2
+		ORDER BY occurrence_at DESC, step_position DESC
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID, reminderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.EscalationEvent
+	for rows.Next() {
+		var event models.EscalationEvent
+		if err := rows.Scan(&event.Id, &event.PolicyId, &event.ReminderId, &event.UserId, &event.StepPosition,
+			&event.ContactId, &event.Channel, &event.OccurrenceAt, &event.Status, &event.Error, &event.CreatedAt); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
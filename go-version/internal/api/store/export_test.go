@@ -0,0 +1,23 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+// likeSearchReminderStore lists reminders with the search of backends
+// without a full-text index, so that the conformance suite can check it
+// against SQLite.
+type likeSearchReminderStore struct {
+	*ReminderStore
+}
+
+func NewLikeSearchReminderStore(db *sql.DB) ReminderStoreInterface {
+	return &likeSearchReminderStore{ReminderStore: &ReminderStore{db: db}}
+}
+
+func (s *likeSearchReminderStore) ListReminders(ctx context.Context, filters *ReminderListFilters) ([]models.Reminder, error) {
+	return s.listReminders(ctx, filters, likeSearch{contains: lowerLike}, lowerLike, sqliteOrder)
+}
@@ -1,12 +1,133 @@
 package store
 
-import "time"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go-version/internal/api/models"
+)
 
 type ReminderListFilters struct {
-	UserID    string
+	UserID string
+	// IncludeShared adds the reminders shared with UserID as a caregiver.
+	IncludeShared bool
+	Search        *string
+	// Medication matches reminders whose medication name contains it.
+	Medication *string
+	StartDate  *time.Time
+	EndDate    *time.Time
+	// Sort orders the reminders by models.ReminderSortStartAt,
+	// ReminderSortCreatedAt or ReminderSortDescription, ignoring case, and
+	// then by id, and Descending reverses the order. Reminders are listed in
+	// no particular order without one.
+	Sort       string
+	Descending bool
+	// After lists only the reminders that sort after it, and Limit caps the
+	// reminders listed. Both need a Sort.
+	After *ReminderListKey
+	Limit *int
+}
+
+// ReminderListKey is the position of a reminder in a sorted listing: its
+// start or creation time or its description, as the listing is sorted,
+// and its id.
+type ReminderListKey struct {
+	Time *time.Time
+	Text string
+	ID   string
+}
+
+type CheckInResponseListFilters struct {
+	UserID     string
+	ReminderID string
+	StartDate  *time.Time
+	EndDate    *time.Time
+}
+
+type JournalEntryListFilters struct {
+	UserID string
+	// Search matches entries whose text or a tag contains it.
 	Search    *string
+	Tag       *string
 	StartDate *time.Time
 	EndDate   *time.Time
-	Limit     *int
-	Offset    *int
+}
+
+// searchPattern makes a LIKE pattern matching values that contain search.
+// Wildcards in search are escaped so that they match themselves, and
+// searches are compared with LOWER on both sides, so they ignore case.
+func searchPattern(search string) string {
+	return "%" + likeEscaper.Replace(search) + "%"
+}
+
+// likeEscaper escapes LIKE wildcards with the escape character patterns are
+// matched with.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// containsCondition returns a condition matching rows whose column is LIKE
+// the pattern in argument arg, ignoring case.
+type containsCondition func(column string, arg int) string
+
+// lowerLike lowers both sides of LIKE, which works on any backend.
+func lowerLike(column string, arg int) string {
+	return fmt.Sprintf(`LOWER(%s) LIKE LOWER($%d) ESCAPE '\'`, column, arg)
+}
+
+// iLike uses PostgreSQL's ILIKE.
+func iLike(column string, arg int) string {
+	return fmt.Sprintf(`%s ILIKE $%d ESCAPE '\'`, column, arg)
+}
+
+// listOrder is how a backend compares the keys of a sorted listing, as
+// expressions on a column or an argument, so that the keys compare alike
+// whether read from a row or from a ReminderListKey.
+type listOrder struct {
+	time func(operand string) string
+	text func(operand string) string
+}
+
+// sqliteOrder compares times as Julian days. SQLite stores times as text,
+// as CURRENT_TIMESTAMP writes them or in the driver's format of time.Time,
+// "2006-01-02 15:04:05.999999999 -0700 MST", whose offset julianday only
+// reads once it is written as "-07:00".
+var sqliteOrder = listOrder{
+	time: func(operand string) string {
+		clock := fmt.Sprintf(`substr(%[1]s, 12, instr(substr(%[1]s, 12), ' ') - 1)`, operand)
+		offset := fmt.Sprintf(`substr(%[1]s, 12 + instr(substr(%[1]s, 12), ' '), 5)`, operand)
+		return fmt.Sprintf(`COALESCE(julianday(%[1]s), julianday(substr(%[1]s, 1, 11) || %[2]s || substr(%[3]s, 1, 3) || ':' || substr(%[3]s, 4, 2)))`, operand, clock, offset)
+	},
+	text: func(operand string) string { return operand },
+}
+
+// postgresOrder compares text byte by byte, as SQLite does, rather than in
+// the database's collation.
+var postgresOrder = listOrder{
+	time: func(operand string) string { return operand },
+	text: func(operand string) string { return operand + `::text COLLATE "C"` },
+}
+
+// sortKeys returns what a listing sorted by sort is ordered on, given the
+// operands holding the sorted value and the id: the reminder's columns, or
+// the arguments holding a ReminderListKey.
+func (o listOrder) sortKeys(sort, value, id string) []string {
+	if sort == models.ReminderSortDescription {
+		return []string{o.text(`LOWER(COALESCE(` + value + `, ''))`), o.text(id)}
+	}
+	return []string{o.time(value), o.text(id)}
+}
+
+// reminderSortColumns are the columns holding what reminders are sorted by.
+var reminderSortColumns = map[string]string{
+	models.ReminderSortStartAt:     "r.start_at",
+	models.ReminderSortCreatedAt:   "r.created_at",
+	models.ReminderSortDescription: "r.description",
+}
+
+// value returns the part of the key a listing sorted by sort compares.
+func (k *ReminderListKey) value(sort string) any {
+	if sort == models.ReminderSortDescription {
+		return k.Text
+	}
+	return k.Time
 }
@@ -0,0 +1,283 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"go-version/internal/api/models"
+)
+
+type JournalStoreInterface interface {
+	ListEntries(ctx context.Context, filters *JournalEntryListFilters) ([]models.JournalEntry, error)
+	GetEntry(ctx context.Context, userID, entryID string) (*models.JournalEntry, error)
+	CreateEntry(ctx context.Context, entry *models.JournalEntry) (*models.JournalEntry, error)
+	UpdateEntry(ctx context.Context, entry *models.JournalEntry) (*models.JournalEntry, error)
+	DeleteEntry(ctx context.Context, userID, entryID string) error
+}
+
+type JournalStore struct {
+	db *sql.DB
+}
+
+func NewJournalStore(db *sql.DB) (*JournalStore, error) {
+	return &JournalStore{db: db}, nil
+}
+
+const journalEntryColumns = `id, user_id, occurred_at, text, created_at, updated_at`
+
+func scanJournalEntry(row rowScanner) (*models.JournalEntry, error) {
+	var entry models.JournalEntry
+	err := row.Scan(&entry.Id, &entry.UserId, &entry.OccurredAt, &entry.Text, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	entry.Tags = []string{}
+	entry.Measurements = []models.JournalMeasurement{}
+	return &entry, nil
+}
+
+// ListEntries returns the user's journal entries in the order they
+// occurred.
+func (s *JournalStore) ListEntries(ctx context.Context, filters *JournalEntryListFilters) ([]models.JournalEntry, error) {
+	query := `SELECT ` + journalEntryColumns + ` FROM journal_entries e WHERE user_id=$1`
+
+	args := []interface{}{filters.UserID}
+	argIdx := 2
+
+	// A search matches the text or any tag.
+	if filters.Search != nil {
+		query += fmt.Sprintf(` AND (%s OR EXISTS (
+			SELECT 1 FROM journal_entry_tags t WHERE t.entry_id = e.id AND %s
+		))`, lowerLike("e.text", argIdx), lowerLike("t.tag", argIdx))
+		args = append(args, searchPattern(*filters.Search))
+		argIdx++
+	}
+
+	if filters.Tag != nil {
+		query += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM journal_entry_tags t WHERE t.entry_id = e.id AND t.tag = $%d)`, argIdx)
+		args = append(args, *filters.Tag)
+		argIdx++
+	}
+
+	if filters.StartDate != nil {
+		query += fmt.Sprintf(` AND e.occurred_at >= $%d`, argIdx)
+		args = append(args, filters.StartDate.UTC())
+		argIdx++
+	}
+
+	if filters.EndDate != nil {
+		query += fmt.Sprintf(` AND e.occurred_at <= $%d`, argIdx)
+		args = append(args, filters.EndDate.UTC())
+		argIdx++
+	}
+
+	query += ` ORDER BY e.occurred_at, e.id`
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []models.JournalEntry{}
+	for rows.Next() {
+		entry, err := scanJournalEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := s.loadDetails(ctx, entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (s *JournalStore) GetEntry(ctx context.Context, userID, entryID string) (*models.JournalEntry, error) {
+	query := `SELECT ` + journalEntryColumns + ` FROM journal_entries WHERE id=$1 AND user_id=$2`
+
+	entry, err := scanJournalEntry(s.db.QueryRowContext(ctx, query, entryID, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoJournalEntryFoundError{ID: entryID}
+		}
+		return nil, err
+	}
+
+	entries := []models.JournalEntry{*entry}
+	if err := s.loadDetails(ctx, entries); err != nil {
+		return nil, err
+	}
+	return &entries[0], nil
+}
+
+func (s *JournalStore) CreateEntry(ctx context.Context, entry *models.JournalEntry) (*models.JournalEntry, error) {
+	query := `
+		INSERT INTO journal_entries (id, user_id, occurred_at, text)
+		VALUES ($1, $2, $3, $4)
+		RETURNING ` + journalEntryColumns
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	created, err := scanJournalEntry(tx.QueryRowContext(ctx, query, entry.Id, entry.UserId, entry.OccurredAt.UTC(), entry.Text))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := replaceJournalDetails(ctx, tx, created, entry); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateEntry saves every field of the entry, replacing its tags and
+// measurements.
+func (s *JournalStore) UpdateEntry(ctx context.Context, entry *models.JournalEntry) (*models.JournalEntry, error) {
+	query := `
+		UPDATE journal_entries
+		SET occurred_at = $1, text = $2
+		WHERE id = $3 AND user_id = $4
+		RETURNING ` + journalEntryColumns
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	updated, err := scanJournalEntry(tx.QueryRowContext(ctx, query, entry.OccurredAt.UTC(), entry.Text, entry.Id, entry.UserId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoJournalEntryFoundError{ID: entry.Id}
+		}
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM journal_entry_tags WHERE entry_id=$1`, entry.Id); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM journal_measurements WHERE entry_id=$1`, entry.Id); err != nil {
+		return nil, err
+	}
+	if err := replaceJournalDetails(ctx, tx, updated, entry); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (s *JournalStore) DeleteEntry(ctx context.Context, userID, entryID string) error {
+	query := `DELETE FROM journal_entries WHERE id=$1 AND user_id=$2`
+	result, err := s.db.ExecContext(ctx, query, entryID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoJournalEntryFoundError{ID: entryID}
+	}
+
+	return nil
+}
+
+// replaceJournalDetails inserts the tags and measurements of entry for the
+// saved entry, which has none yet.
+func replaceJournalDetails(ctx context.Context, tx *sql.Tx, saved, entry *models.JournalEntry) error {
+	for _, tag := range entry.Tags {
+		_, err := tx.ExecContext(ctx, `INSERT INTO journal_entry_tags (entry_id, tag) VALUES ($1, $2)`, saved.Id, tag)
+		if err != nil {
+			return err
+		}
+	}
+	for i, measurement := range entry.Measurements {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO journal_measurements (entry_id, position, name, value, unit)
+			VALUES ($1, $2, $3, $4, $5)
+		`, saved.Id, i+1, measurement.Name, measurement.Value, measurement.Unit)
+		if err != nil {
+			return err
+		}
+	}
+
+	if entry.Tags != nil {
+		saved.Tags = entry.Tags
+	}
+	if entry.Measurements != nil {
+		saved.Measurements = entry.Measurements
+	}
+	return nil
+}
+
+// loadDetails fills in the tags and measurements of the entries.
+func (s *JournalStore) loadDetails(ctx context.Context, entries []models.JournalEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	byID := make(map[string]*models.JournalEntry, len(entries))
+	placeholders := make([]string, len(entries))
+	args := make([]interface{}, len(entries))
+	for i := range entries {
+		byID[entries[i].Id] = &entries[i]
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = entries[i].Id
+	}
+	in := strings.Join(placeholders, ", ")
+
+	rows, err := s.db.QueryContext(ctx, `SELECT entry_id, tag FROM journal_entry_tags WHERE entry_id IN (`+in+`) ORDER BY tag`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var entryID, tag string
+		if err := rows.Scan(&entryID, &tag); err != nil {
+			return err
+		}
+		byID[entryID].Tags = append(byID[entryID].Tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	rows, err = s.db.QueryContext(ctx, `
+		SELECT entry_id, name, value, unit FROM journal_measurements
+		WHERE entry_id IN (`+in+`)
+		ORDER BY position
+	`, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var entryID string
+		var measurement models.JournalMeasurement
+		if err := rows.Scan(&entryID, &measurement.Name, &measurement.Value, &measurement.Unit); err != nil {
+			return err
+		}
+		byID[entryID].Measurements = append(byID[entryID].Measurements, measurement)
+	}
+	return rows.Err()
+}
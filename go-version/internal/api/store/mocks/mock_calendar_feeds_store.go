@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/calendar_feeds_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/calendar_feeds_store.go -destination=internal/api/store/mocks/mock_calendar_feeds_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCalendarFeedStoreInterface is a mock of CalendarFeedStoreInterface interface.
+type MockCalendarFeedStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarFeedStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarFeedStoreInterfaceMockRecorder is the mock recorder for MockCalendarFeedStoreInterface.
+type MockCalendarFeedStoreInterfaceMockRecorder struct {
+	mock *MockCalendarFeedStoreInterface
+}
+
+// NewMockCalendarFeedStoreInterface creates a new mock instance.
+func NewMockCalendarFeedStoreInterface(ctrl *gomock.Controller) *MockCalendarFeedStoreInterface {
+	mock := &MockCalendarFeedStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockCalendarFeedStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarFeedStoreInterface) EXPECT() *MockCalendarFeedStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteFeed mocks base method.
+func (m *MockCalendarFeedStoreInterface) DeleteFeed(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeed", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeed indicates an expected call of DeleteFeed.
+func (mr *MockCalendarFeedStoreInterfaceMockRecorder) DeleteFeed(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeed", reflect.TypeOf((*MockCalendarFeedStoreInterface)(nil).DeleteFeed), ctx, userID)
+}
+
+// GetFeedByTokenHash mocks base method.
+func (m *MockCalendarFeedStoreInterface) GetFeedByTokenHash(ctx context.Context, tokenHash string) (*models.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(*models.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedByTokenHash indicates an expected call of GetFeedByTokenHash.
+func (mr *MockCalendarFeedStoreInterfaceMockRecorder) GetFeedByTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedByTokenHash", reflect.TypeOf((*MockCalendarFeedStoreInterface)(nil).GetFeedByTokenHash), ctx, tokenHash)
+}
+
+// ReplaceFeed mocks base method.
+func (m *MockCalendarFeedStoreInterface) ReplaceFeed(ctx context.Context, feed *models.CalendarFeed) (*models.CalendarFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceFeed", ctx, feed)
+	ret0, _ := ret[0].(*models.CalendarFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceFeed indicates an expected call of ReplaceFeed.
+func (mr *MockCalendarFeedStoreInterfaceMockRecorder) ReplaceFeed(ctx, feed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceFeed", reflect.TypeOf((*MockCalendarFeedStoreInterface)(nil).ReplaceFeed), ctx, feed)
+}
@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/caregivers_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/caregivers_store.go -destination=internal/api/store/mocks/mock_caregivers_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCaregiverStoreInterface is a mock of CaregiverStoreInterface interface.
+type MockCaregiverStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCaregiverStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCaregiverStoreInterfaceMockRecorder is the mock recorder for MockCaregiverStoreInterface.
+type MockCaregiverStoreInterfaceMockRecorder struct {
+	mock *MockCaregiverStoreInterface
+}
+
+// NewMockCaregiverStoreInterface creates a new mock instance.
+func NewMockCaregiverStoreInterface(ctrl *gomock.Controller) *MockCaregiverStoreInterface {
+	mock := &MockCaregiverStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockCaregiverStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCaregiverStoreInterface) EXPECT() *MockCaregiverStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// AcceptGrant mocks base method.
+func (m *MockCaregiverStoreInterface) AcceptGrant(ctx context.Context, grantID, caregiverID, email string) (*models.CaregiverGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptGrant", ctx, grantID, caregiverID, email)
+	ret0, _ := ret[0].(*models.CaregiverGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptGrant indicates an expected call of AcceptGrant.
+func (mr *MockCaregiverStoreInterfaceMockRecorder) AcceptGrant(ctx, grantID, caregiverID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptGrant", reflect.TypeOf((*MockCaregiverStoreInterface)(nil).AcceptGrant), ctx, grantID, caregiverID, email)
+}
+
+// DeleteGrant mocks base method.
+func (m *MockCaregiverStoreInterface) DeleteGrant(ctx context.Context, ownerID, grantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGrant", ctx, ownerID, grantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGrant indicates an expected call of DeleteGrant.
+func (mr *MockCaregiverStoreInterfaceMockRecorder) DeleteGrant(ctx, ownerID, grantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGrant", reflect.TypeOf((*MockCaregiverStoreInterface)(nil).DeleteGrant), ctx, ownerID, grantID)
+}
+
+// DeleteGrantForCaregiver mocks base method.
+func (m *MockCaregiverStoreInterface) DeleteGrantForCaregiver(ctx context.Context, caregiverID, email, grantID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGrantForCaregiver", ctx, caregiverID, email, grantID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGrantForCaregiver indicates an expected call of DeleteGrantForCaregiver.
+func (mr *MockCaregiverStoreInterfaceMockRecorder) DeleteGrantForCaregiver(ctx, caregiverID, email, grantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGrantForCaregiver", reflect.TypeOf((*MockCaregiverStoreInterface)(nil).DeleteGrantForCaregiver), ctx, caregiverID, email, grantID)
+}
+
+// ListActions mocks base method.
+func (m *MockCaregiverStoreInterface) ListActions(ctx context.Context, ownerID string) ([]models.CaregiverAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActions", ctx, ownerID)
+	ret0, _ := ret[0].([]models.CaregiverAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActions indicates an expected call of ListActions.
+func (mr *MockCaregiverStoreInterfaceMockRecorder) ListActions(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActions", reflect.TypeOf((*MockCaregiverStoreInterface)(nil).ListActions), ctx, ownerID)
+}
+
+// ListGrantsByOwner mocks base method.
+func (m *MockCaregiverStoreInterface) ListGrantsByOwner(ctx context.Context, ownerID string) ([]models.CaregiverGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGrantsByOwner", ctx, ownerID)
+	ret0, _ := ret[0].([]models.CaregiverGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGrantsByOwner indicates an expected call of ListGrantsByOwner.
+func (mr *MockCaregiverStoreInterfaceMockRecorder) ListGrantsByOwner(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGrantsByOwner", reflect.TypeOf((*MockCaregiverStoreInterface)(nil).ListGrantsByOwner), ctx, ownerID)
+}
+
+// ListGrantsForCaregiver mocks base method.
+func (m *MockCaregiverStoreInterface) ListGrantsForCaregiver(ctx context.Context, caregiverID, email string) ([]models.CaregiverGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGrantsForCaregiver", ctx, caregiverID, email)
+	ret0, _ := ret[0].([]models.CaregiverGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGrantsForCaregiver indicates an expected call of ListGrantsForCaregiver.
+func (mr *MockCaregiverStoreInterfaceMockRecorder) ListGrantsForCaregiver(ctx, caregiverID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGrantsForCaregiver", reflect.TypeOf((*MockCaregiverStoreInterface)(nil).ListGrantsForCaregiver), ctx, caregiverID, email)
+}
+
+// RecordAction mocks base method.
+func (m *MockCaregiverStoreInterface) RecordAction(ctx context.Context, action *models.CaregiverAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAction", ctx, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAction indicates an expected call of RecordAction.
+func (mr *MockCaregiverStoreInterfaceMockRecorder) RecordAction(ctx, action any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAction", reflect.TypeOf((*MockCaregiverStoreInterface)(nil).RecordAction), ctx, action)
+}
+
+// UpsertGrant mocks base method.
+func (m *MockCaregiverStoreInterface) UpsertGrant(ctx context.Context, grant *models.CaregiverGrant) (*models.CaregiverGrant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertGrant", ctx, grant)
+	ret0, _ := ret[0].(*models.CaregiverGrant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertGrant indicates an expected call of UpsertGrant.
+func (mr *MockCaregiverStoreInterfaceMockRecorder) UpsertGrant(ctx, grant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertGrant", reflect.TypeOf((*MockCaregiverStoreInterface)(nil).UpsertGrant), ctx, grant)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/check_ins_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/check_ins_store.go -destination=internal/api/store/mocks/mock_check_ins_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	store "go-version/internal/api/store"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCheckInStoreInterface is a mock of CheckInStoreInterface interface.
+type MockCheckInStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckInStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockCheckInStoreInterfaceMockRecorder is the mock recorder for MockCheckInStoreInterface.
+type MockCheckInStoreInterfaceMockRecorder struct {
+	mock *MockCheckInStoreInterface
+}
+
+// NewMockCheckInStoreInterface creates a new mock instance.
+func NewMockCheckInStoreInterface(ctrl *gomock.Controller) *MockCheckInStoreInterface {
+	mock := &MockCheckInStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockCheckInStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckInStoreInterface) EXPECT() *MockCheckInStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteQuestionnaire mocks base method.
+func (m *MockCheckInStoreInterface) DeleteQuestionnaire(ctx context.Context, userID, reminderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuestionnaire", ctx, userID, reminderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuestionnaire indicates an expected call of DeleteQuestionnaire.
+func (mr *MockCheckInStoreInterfaceMockRecorder) DeleteQuestionnaire(ctx, userID, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuestionnaire", reflect.TypeOf((*MockCheckInStoreInterface)(nil).DeleteQuestionnaire), ctx, userID, reminderID)
+}
+
+// GetQuestionnaire mocks base method.
+func (m *MockCheckInStoreInterface) GetQuestionnaire(ctx context.Context, userID, reminderID string) (*models.CheckInQuestionnaire, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionnaire", ctx, userID, reminderID)
+	ret0, _ := ret[0].(*models.CheckInQuestionnaire)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestionnaire indicates an expected call of GetQuestionnaire.
+func (mr *MockCheckInStoreInterfaceMockRecorder) GetQuestionnaire(ctx, userID, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionnaire", reflect.TypeOf((*MockCheckInStoreInterface)(nil).GetQuestionnaire), ctx, userID, reminderID)
+}
+
+// ListResponses mocks base method.
+func (m *MockCheckInStoreInterface) ListResponses(ctx context.Context, filters *store.CheckInResponseListFilters) ([]models.CheckInResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResponses", ctx, filters)
+	ret0, _ := ret[0].([]models.CheckInResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResponses indicates an expected call of ListResponses.
+func (mr *MockCheckInStoreInterfaceMockRecorder) ListResponses(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResponses", reflect.TypeOf((*MockCheckInStoreInterface)(nil).ListResponses), ctx, filters)
+}
+
+// ReplaceQuestionnaire mocks base method.
+func (m *MockCheckInStoreInterface) ReplaceQuestionnaire(ctx context.Context, questionnaire *models.CheckInQuestionnaire) (*models.CheckInQuestionnaire, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceQuestionnaire", ctx, questionnaire)
+	ret0, _ := ret[0].(*models.CheckInQuestionnaire)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceQuestionnaire indicates an expected call of ReplaceQuestionnaire.
+func (mr *MockCheckInStoreInterfaceMockRecorder) ReplaceQuestionnaire(ctx, questionnaire any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceQuestionnaire", reflect.TypeOf((*MockCheckInStoreInterface)(nil).ReplaceQuestionnaire), ctx, questionnaire)
+}
+
+// ReplaceResponse mocks base method.
+func (m *MockCheckInStoreInterface) ReplaceResponse(ctx context.Context, response *models.CheckInResponse) (*models.CheckInResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceResponse", ctx, response)
+	ret0, _ := ret[0].(*models.CheckInResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceResponse indicates an expected call of ReplaceResponse.
+func (mr *MockCheckInStoreInterfaceMockRecorder) ReplaceResponse(ctx, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceResponse", reflect.TypeOf((*MockCheckInStoreInterface)(nil).ReplaceResponse), ctx, response)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/contacts_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/contacts_store.go -destination=internal/api/store/mocks/mock_contacts_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContactStoreInterface is a mock of ContactStoreInterface interface.
+type MockContactStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockContactStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockContactStoreInterfaceMockRecorder is the mock recorder for MockContactStoreInterface.
+type MockContactStoreInterfaceMockRecorder struct {
+	mock *MockContactStoreInterface
+}
+
+// NewMockContactStoreInterface creates a new mock instance.
+func NewMockContactStoreInterface(ctrl *gomock.Controller) *MockContactStoreInterface {
+	mock := &MockContactStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockContactStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContactStoreInterface) EXPECT() *MockContactStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateContact mocks base method.
+func (m *MockContactStoreInterface) CreateContact(ctx context.Context, contact *models.Contact) (*models.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContact", ctx, contact)
+	ret0, _ := ret[0].(*models.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateContact indicates an expected call of CreateContact.
+func (mr *MockContactStoreInterfaceMockRecorder) CreateContact(ctx, contact any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContact", reflect.TypeOf((*MockContactStoreInterface)(nil).CreateContact), ctx, contact)
+}
+
+// DeleteContact mocks base method.
+func (m *MockContactStoreInterface) DeleteContact(ctx context.Context, userID, contactID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContact", ctx, userID, contactID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContact indicates an expected call of DeleteContact.
+func (mr *MockContactStoreInterfaceMockRecorder) DeleteContact(ctx, userID, contactID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContact", reflect.TypeOf((*MockContactStoreInterface)(nil).DeleteContact), ctx, userID, contactID)
+}
+
+// GetContact mocks base method.
+func (m *MockContactStoreInterface) GetContact(ctx context.Context, userID, contactID string) (*models.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContact", ctx, userID, contactID)
+	ret0, _ := ret[0].(*models.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContact indicates an expected call of GetContact.
+func (mr *MockContactStoreInterfaceMockRecorder) GetContact(ctx, userID, contactID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContact", reflect.TypeOf((*MockContactStoreInterface)(nil).GetContact), ctx, userID, contactID)
+}
+
+// ListContacts mocks base method.
+func (m *MockContactStoreInterface) ListContacts(ctx context.Context, userID string) ([]models.Contact, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListContacts", ctx, userID)
+	ret0, _ := ret[0].([]models.Contact)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListContacts indicates an expected call of ListContacts.
+func (mr *MockContactStoreInterfaceMockRecorder) ListContacts(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListContacts", reflect.TypeOf((*MockContactStoreInterface)(nil).ListContacts), ctx, userID)
+}
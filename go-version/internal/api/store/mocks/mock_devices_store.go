@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/devices_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/devices_store.go -destination=internal/api/store/mocks/mock_devices_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeviceStoreInterface is a mock of DeviceStoreInterface interface.
+type MockDeviceStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDeviceStoreInterfaceMockRecorder is the mock recorder for MockDeviceStoreInterface.
+type MockDeviceStoreInterfaceMockRecorder struct {
+	mock *MockDeviceStoreInterface
+}
+
+// NewMockDeviceStoreInterface creates a new mock instance.
+func NewMockDeviceStoreInterface(ctrl *gomock.Controller) *MockDeviceStoreInterface {
+	mock := &MockDeviceStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockDeviceStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceStoreInterface) EXPECT() *MockDeviceStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteDevice mocks base method.
+func (m *MockDeviceStoreInterface) DeleteDevice(ctx context.Context, userID, deviceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDevice", ctx, userID, deviceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevice indicates an expected call of DeleteDevice.
+func (mr *MockDeviceStoreInterfaceMockRecorder) DeleteDevice(ctx, userID, deviceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockDeviceStoreInterface)(nil).DeleteDevice), ctx, userID, deviceID)
+}
+
+// DeleteDeviceByToken mocks base method.
+func (m *MockDeviceStoreInterface) DeleteDeviceByToken(ctx context.Context, platform, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeviceByToken", ctx, platform, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeviceByToken indicates an expected call of DeleteDeviceByToken.
+func (mr *MockDeviceStoreInterfaceMockRecorder) DeleteDeviceByToken(ctx, platform, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeviceByToken", reflect.TypeOf((*MockDeviceStoreInterface)(nil).DeleteDeviceByToken), ctx, platform, token)
+}
+
+// ListDevices mocks base method.
+func (m *MockDeviceStoreInterface) ListDevices(ctx context.Context, userID string) ([]models.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDevices", ctx, userID)
+	ret0, _ := ret[0].([]models.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDevices indicates an expected call of ListDevices.
+func (mr *MockDeviceStoreInterfaceMockRecorder) ListDevices(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDevices", reflect.TypeOf((*MockDeviceStoreInterface)(nil).ListDevices), ctx, userID)
+}
+
+// UpsertDevice mocks base method.
+func (m *MockDeviceStoreInterface) UpsertDevice(ctx context.Context, device *models.Device) (*models.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDevice", ctx, device)
+	ret0, _ := ret[0].(*models.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertDevice indicates an expected call of UpsertDevice.
+func (mr *MockDeviceStoreInterfaceMockRecorder) UpsertDevice(ctx, device any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDevice", reflect.TypeOf((*MockDeviceStoreInterface)(nil).UpsertDevice), ctx, device)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/digest_subscriptions_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/digest_subscriptions_store.go -destination=internal/api/store/mocks/mock_digest_subscriptions_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDigestSubscriptionStoreInterface is a mock of DigestSubscriptionStoreInterface interface.
+type MockDigestSubscriptionStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestSubscriptionStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockDigestSubscriptionStoreInterfaceMockRecorder is the mock recorder for MockDigestSubscriptionStoreInterface.
+type MockDigestSubscriptionStoreInterfaceMockRecorder struct {
+	mock *MockDigestSubscriptionStoreInterface
+}
+
+// NewMockDigestSubscriptionStoreInterface creates a new mock instance.
+func NewMockDigestSubscriptionStoreInterface(ctrl *gomock.Controller) *MockDigestSubscriptionStoreInterface {
+	mock := &MockDigestSubscriptionStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockDigestSubscriptionStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestSubscriptionStoreInterface) EXPECT() *MockDigestSubscriptionStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteDigestSubscription mocks base method.
+func (m *MockDigestSubscriptionStoreInterface) DeleteDigestSubscription(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDigestSubscription", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDigestSubscription indicates an expected call of DeleteDigestSubscription.
+func (mr *MockDigestSubscriptionStoreInterfaceMockRecorder) DeleteDigestSubscription(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDigestSubscription", reflect.TypeOf((*MockDigestSubscriptionStoreInterface)(nil).DeleteDigestSubscription), ctx, userID)
+}
+
+// GetDigestSubscription mocks base method.
+func (m *MockDigestSubscriptionStoreInterface) GetDigestSubscription(ctx context.Context, userID string) (*models.DigestSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestSubscription", ctx, userID)
+	ret0, _ := ret[0].(*models.DigestSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestSubscription indicates an expected call of GetDigestSubscription.
+func (mr *MockDigestSubscriptionStoreInterfaceMockRecorder) GetDigestSubscription(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestSubscription", reflect.TypeOf((*MockDigestSubscriptionStoreInterface)(nil).GetDigestSubscription), ctx, userID)
+}
+
+// ListDigestSubscriptions mocks base method.
+func (m *MockDigestSubscriptionStoreInterface) ListDigestSubscriptions(ctx context.Context) ([]models.DigestSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDigestSubscriptions", ctx)
+	ret0, _ := ret[0].([]models.DigestSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDigestSubscriptions indicates an expected call of ListDigestSubscriptions.
+func (mr *MockDigestSubscriptionStoreInterfaceMockRecorder) ListDigestSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDigestSubscriptions", reflect.TypeOf((*MockDigestSubscriptionStoreInterface)(nil).ListDigestSubscriptions), ctx)
+}
+
+// MarkDigestSent mocks base method.
+func (m *MockDigestSubscriptionStoreInterface) MarkDigestSent(ctx context.Context, userID string, sentAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDigestSent", ctx, userID, sentAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkDigestSent indicates an expected call of MarkDigestSent.
+func (mr *MockDigestSubscriptionStoreInterfaceMockRecorder) MarkDigestSent(ctx, userID, sentAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDigestSent", reflect.TypeOf((*MockDigestSubscriptionStoreInterface)(nil).MarkDigestSent), ctx, userID, sentAt)
+}
+
+// UpsertDigestSubscription mocks base method.
+func (m *MockDigestSubscriptionStoreInterface) UpsertDigestSubscription(ctx context.Context, subscription *models.DigestSubscription) (*models.DigestSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDigestSubscription", ctx, subscription)
+	ret0, _ := ret[0].(*models.DigestSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertDigestSubscription indicates an expected call of UpsertDigestSubscription.
+func (mr *MockDigestSubscriptionStoreInterfaceMockRecorder) UpsertDigestSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDigestSubscription", reflect.TypeOf((*MockDigestSubscriptionStoreInterface)(nil).UpsertDigestSubscription), ctx, subscription)
+}
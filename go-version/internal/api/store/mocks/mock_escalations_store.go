@@ -0,0 +1,192 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/escalations_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/escalations_store.go -destination=internal/api/store/mocks/mock_escalations_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEscalationStoreInterface is a mock of EscalationStoreInterface interface.
+type MockEscalationStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockEscalationStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockEscalationStoreInterfaceMockRecorder is the mock recorder for MockEscalationStoreInterface.
+type MockEscalationStoreInterfaceMockRecorder struct {
+	mock *MockEscalationStoreInterface
+}
+
+// NewMockEscalationStoreInterface creates a new mock instance.
+func NewMockEscalationStoreInterface(ctrl *gomock.Controller) *MockEscalationStoreInterface {
+	mock := &MockEscalationStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockEscalationStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEscalationStoreInterface) EXPECT() *MockEscalationStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateAcknowledgement mocks base method.
+func (m *MockEscalationStoreInterface) CreateAcknowledgement(ctx context.Context, ack *models.ReminderAcknowledgement) (*models.ReminderAcknowledgement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAcknowledgement", ctx, ack)
+	ret0, _ := ret[0].(*models.ReminderAcknowledgement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAcknowledgement indicates an expected call of CreateAcknowledgement.
+func (mr *MockEscalationStoreInterfaceMockRecorder) CreateAcknowledgement(ctx, ack any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAcknowledgement", reflect.TypeOf((*MockEscalationStoreInterface)(nil).CreateAcknowledgement), ctx, ack)
+}
+
+// CreateEvent mocks base method.
+func (m *MockEscalationStoreInterface) CreateEvent(ctx context.Context, event *models.EscalationEvent) (*models.EscalationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event)
+	ret0, _ := ret[0].(*models.EscalationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockEscalationStoreInterfaceMockRecorder) CreateEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockEscalationStoreInterface)(nil).CreateEvent), ctx, event)
+}
+
+// DeletePolicy mocks base method.
+func (m *MockEscalationStoreInterface) DeletePolicy(ctx context.Context, userID, reminderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePolicy", ctx, userID, reminderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePolicy indicates an expected call of DeletePolicy.
+func (mr *MockEscalationStoreInterfaceMockRecorder) DeletePolicy(ctx, userID, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePolicy", reflect.TypeOf((*MockEscalationStoreInterface)(nil).DeletePolicy), ctx, userID, reminderID)
+}
+
+// GetPolicy mocks base method.
+func (m *MockEscalationStoreInterface) GetPolicy(ctx context.Context, userID, reminderID string) (*models.EscalationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", ctx, userID, reminderID)
+	ret0, _ := ret[0].(*models.EscalationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockEscalationStoreInterfaceMockRecorder) GetPolicy(ctx, userID, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockEscalationStoreInterface)(nil).GetPolicy), ctx, userID, reminderID)
+}
+
+// HasEvent mocks base method.
+func (m *MockEscalationStoreInterface) HasEvent(ctx context.Context, reminderID string, stepPosition int, occurrenceAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasEvent", ctx, reminderID, stepPosition, occurrenceAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasEvent indicates an expected call of HasEvent.
+func (mr *MockEscalationStoreInterfaceMockRecorder) HasEvent(ctx, reminderID, stepPosition, occurrenceAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasEvent", reflect.TypeOf((*MockEscalationStoreInterface)(nil).HasEvent), ctx, reminderID, stepPosition, occurrenceAt)
+}
+
+// IsAcknowledged mocks base method.
+func (m *MockEscalationStoreInterface) IsAcknowledged(ctx context.Context, reminderID string, occurrenceAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAcknowledged", ctx, reminderID, occurrenceAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAcknowledged indicates an expected call of IsAcknowledged.
+func (mr *MockEscalationStoreInterfaceMockRecorder) IsAcknowledged(ctx, reminderID, occurrenceAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAcknowledged", reflect.TypeOf((*MockEscalationStoreInterface)(nil).IsAcknowledged), ctx, reminderID, occurrenceAt)
+}
+
+// ListAcknowledgements mocks base method.
+func (m *MockEscalationStoreInterface) ListAcknowledgements(ctx context.Context, userID string, start, end time.Time) ([]models.ReminderAcknowledgement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAcknowledgements", ctx, userID, start, end)
+	ret0, _ := ret[0].([]models.ReminderAcknowledgement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAcknowledgements indicates an expected call of ListAcknowledgements.
+func (mr *MockEscalationStoreInterfaceMockRecorder) ListAcknowledgements(ctx, userID, start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAcknowledgements", reflect.TypeOf((*MockEscalationStoreInterface)(nil).ListAcknowledgements), ctx, userID, start, end)
+}
+
+// ListEvents mocks base method.
+func (m *MockEscalationStoreInterface) ListEvents(ctx context.Context, userID, reminderID string) ([]models.EscalationEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEvents", ctx, userID, reminderID)
+	ret0, _ := ret[0].([]models.EscalationEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEvents indicates an expected call of ListEvents.
+func (mr *MockEscalationStoreInterfaceMockRecorder) ListEvents(ctx, userID, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEscalationStoreInterface)(nil).ListEvents), ctx, userID, reminderID)
+}
+
+// ListPolicies mocks base method.
+func (m *MockEscalationStoreInterface) ListPolicies(ctx context.Context) ([]models.EscalationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", ctx)
+	ret0, _ := ret[0].([]models.EscalationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockEscalationStoreInterfaceMockRecorder) ListPolicies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockEscalationStoreInterface)(nil).ListPolicies), ctx)
+}
+
+// ReplacePolicy mocks base method.
+func (m *MockEscalationStoreInterface) ReplacePolicy(ctx context.Context, policy *models.EscalationPolicy) (*models.EscalationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePolicy", ctx, policy)
+	ret0, _ := ret[0].(*models.EscalationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplacePolicy indicates an expected call of ReplacePolicy.
+func (mr *MockEscalationStoreInterfaceMockRecorder) ReplacePolicy(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePolicy", reflect.TypeOf((*MockEscalationStoreInterface)(nil).ReplacePolicy), ctx, policy)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/journal_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/journal_store.go -destination=internal/api/store/mocks/mock_journal_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	store "go-version/internal/api/store"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJournalStoreInterface is a mock of JournalStoreInterface interface.
+type MockJournalStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockJournalStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockJournalStoreInterfaceMockRecorder is the mock recorder for MockJournalStoreInterface.
+type MockJournalStoreInterfaceMockRecorder struct {
+	mock *MockJournalStoreInterface
+}
+
+// NewMockJournalStoreInterface creates a new mock instance.
+func NewMockJournalStoreInterface(ctrl *gomock.Controller) *MockJournalStoreInterface {
+	mock := &MockJournalStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockJournalStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJournalStoreInterface) EXPECT() *MockJournalStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateEntry mocks base method.
+func (m *MockJournalStoreInterface) CreateEntry(ctx context.Context, entry *models.JournalEntry) (*models.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, entry)
+	ret0, _ := ret[0].(*models.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockJournalStoreInterfaceMockRecorder) CreateEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockJournalStoreInterface)(nil).CreateEntry), ctx, entry)
+}
+
+// DeleteEntry mocks base method.
+func (m *MockJournalStoreInterface) DeleteEntry(ctx context.Context, userID, entryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntry", ctx, userID, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEntry indicates an expected call of DeleteEntry.
+func (mr *MockJournalStoreInterfaceMockRecorder) DeleteEntry(ctx, userID, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockJournalStoreInterface)(nil).DeleteEntry), ctx, userID, entryID)
+}
+
+// GetEntry mocks base method.
+func (m *MockJournalStoreInterface) GetEntry(ctx context.Context, userID, entryID string) (*models.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, userID, entryID)
+	ret0, _ := ret[0].(*models.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockJournalStoreInterfaceMockRecorder) GetEntry(ctx, userID, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockJournalStoreInterface)(nil).GetEntry), ctx, userID, entryID)
+}
+
+// ListEntries mocks base method.
+func (m *MockJournalStoreInterface) ListEntries(ctx context.Context, filters *store.JournalEntryListFilters) ([]models.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEntries", ctx, filters)
+	ret0, _ := ret[0].([]models.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEntries indicates an expected call of ListEntries.
+func (mr *MockJournalStoreInterfaceMockRecorder) ListEntries(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEntries", reflect.TypeOf((*MockJournalStoreInterface)(nil).ListEntries), ctx, filters)
+}
+
+// UpdateEntry mocks base method.
+func (m *MockJournalStoreInterface) UpdateEntry(ctx context.Context, entry *models.JournalEntry) (*models.JournalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntry", ctx, entry)
+	ret0, _ := ret[0].(*models.JournalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEntry indicates an expected call of UpdateEntry.
+func (mr *MockJournalStoreInterfaceMockRecorder) UpdateEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntry", reflect.TypeOf((*MockJournalStoreInterface)(nil).UpdateEntry), ctx, entry)
+}
@@ -0,0 +1,234 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/organizations_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/organizations_store.go -destination=internal/api/store/mocks/mock_organizations_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganizationStoreInterface is a mock of OrganizationStoreInterface interface.
+type MockOrganizationStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganizationStoreInterfaceMockRecorder is the mock recorder for MockOrganizationStoreInterface.
+type MockOrganizationStoreInterfaceMockRecorder struct {
+	mock *MockOrganizationStoreInterface
+}
+
+// NewMockOrganizationStoreInterface creates a new mock instance.
+func NewMockOrganizationStoreInterface(ctrl *gomock.Controller) *MockOrganizationStoreInterface {
+	mock := &MockOrganizationStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockOrganizationStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationStoreInterface) EXPECT() *MockOrganizationStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// ConsentEnrollment mocks base method.
+func (m *MockOrganizationStoreInterface) ConsentEnrollment(ctx context.Context, enrollmentID, patientID string) (*models.PatientEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsentEnrollment", ctx, enrollmentID, patientID)
+	ret0, _ := ret[0].(*models.PatientEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsentEnrollment indicates an expected call of ConsentEnrollment.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) ConsentEnrollment(ctx, enrollmentID, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsentEnrollment", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).ConsentEnrollment), ctx, enrollmentID, patientID)
+}
+
+// CreateOrganization mocks base method.
+func (m *MockOrganizationStoreInterface) CreateOrganization(ctx context.Context, organization *models.Organization, adminID string) (*models.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, organization, adminID)
+	ret0, _ := ret[0].(*models.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) CreateOrganization(ctx, organization, adminID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).CreateOrganization), ctx, organization, adminID)
+}
+
+// DeleteEnrollment mocks base method.
+func (m *MockOrganizationStoreInterface) DeleteEnrollment(ctx context.Context, organizationID, patientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnrollment", ctx, organizationID, patientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnrollment indicates an expected call of DeleteEnrollment.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) DeleteEnrollment(ctx, organizationID, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollment", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).DeleteEnrollment), ctx, organizationID, patientID)
+}
+
+// DeleteEnrollmentForPatient mocks base method.
+func (m *MockOrganizationStoreInterface) DeleteEnrollmentForPatient(ctx context.Context, enrollmentID, patientID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnrollmentForPatient", ctx, enrollmentID, patientID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEnrollmentForPatient indicates an expected call of DeleteEnrollmentForPatient.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) DeleteEnrollmentForPatient(ctx, enrollmentID, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollmentForPatient", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).DeleteEnrollmentForPatient), ctx, enrollmentID, patientID)
+}
+
+// DeleteMember mocks base method.
+func (m *MockOrganizationStoreInterface) DeleteMember(ctx context.Context, organizationID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) DeleteMember(ctx, organizationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).DeleteMember), ctx, organizationID, userID)
+}
+
+// GetEnrollment mocks base method.
+func (m *MockOrganizationStoreInterface) GetEnrollment(ctx context.Context, organizationID, patientID string) (*models.PatientEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollment", ctx, organizationID, patientID)
+	ret0, _ := ret[0].(*models.PatientEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEnrollment indicates an expected call of GetEnrollment.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) GetEnrollment(ctx, organizationID, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollment", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).GetEnrollment), ctx, organizationID, patientID)
+}
+
+// GetMember mocks base method.
+func (m *MockOrganizationStoreInterface) GetMember(ctx context.Context, organizationID, userID string) (*models.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(*models.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) GetMember(ctx, organizationID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).GetMember), ctx, organizationID, userID)
+}
+
+// ListEnrollments mocks base method.
+func (m *MockOrganizationStoreInterface) ListEnrollments(ctx context.Context, organizationID string) ([]models.PatientEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollments", ctx, organizationID)
+	ret0, _ := ret[0].([]models.PatientEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnrollments indicates an expected call of ListEnrollments.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) ListEnrollments(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollments", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).ListEnrollments), ctx, organizationID)
+}
+
+// ListEnrollmentsForPatient mocks base method.
+func (m *MockOrganizationStoreInterface) ListEnrollmentsForPatient(ctx context.Context, patientID string) ([]models.PatientEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollmentsForPatient", ctx, patientID)
+	ret0, _ := ret[0].([]models.PatientEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnrollmentsForPatient indicates an expected call of ListEnrollmentsForPatient.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) ListEnrollmentsForPatient(ctx, patientID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollmentsForPatient", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).ListEnrollmentsForPatient), ctx, patientID)
+}
+
+// ListMembers mocks base method.
+func (m *MockOrganizationStoreInterface) ListMembers(ctx context.Context, organizationID string) ([]models.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, organizationID)
+	ret0, _ := ret[0].([]models.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) ListMembers(ctx, organizationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).ListMembers), ctx, organizationID)
+}
+
+// ListOrganizations mocks base method.
+func (m *MockOrganizationStoreInterface) ListOrganizations(ctx context.Context, userID string) ([]models.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizations", ctx, userID)
+	ret0, _ := ret[0].([]models.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizations indicates an expected call of ListOrganizations.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) ListOrganizations(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizations", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).ListOrganizations), ctx, userID)
+}
+
+// UpsertEnrollment mocks base method.
+func (m *MockOrganizationStoreInterface) UpsertEnrollment(ctx context.Context, enrollment *models.PatientEnrollment) (*models.PatientEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertEnrollment", ctx, enrollment)
+	ret0, _ := ret[0].(*models.PatientEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertEnrollment indicates an expected call of UpsertEnrollment.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) UpsertEnrollment(ctx, enrollment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertEnrollment", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).UpsertEnrollment), ctx, enrollment)
+}
+
+// UpsertMember mocks base method.
+func (m *MockOrganizationStoreInterface) UpsertMember(ctx context.Context, member *models.OrganizationMember) (*models.OrganizationMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMember", ctx, member)
+	ret0, _ := ret[0].(*models.OrganizationMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertMember indicates an expected call of UpsertMember.
+func (mr *MockOrganizationStoreInterfaceMockRecorder) UpsertMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMember", reflect.TypeOf((*MockOrganizationStoreInterface)(nil).UpsertMember), ctx, member)
+}
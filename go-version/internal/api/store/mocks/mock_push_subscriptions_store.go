@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/push_subscriptions_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/push_subscriptions_store.go -destination=internal/api/store/mocks/mock_push_subscriptions_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPushSubscriptionStoreInterface is a mock of PushSubscriptionStoreInterface interface.
+type MockPushSubscriptionStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockPushSubscriptionStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockPushSubscriptionStoreInterfaceMockRecorder is the mock recorder for MockPushSubscriptionStoreInterface.
+type MockPushSubscriptionStoreInterfaceMockRecorder struct {
+	mock *MockPushSubscriptionStoreInterface
+}
+
+// NewMockPushSubscriptionStoreInterface creates a new mock instance.
+func NewMockPushSubscriptionStoreInterface(ctrl *gomock.Controller) *MockPushSubscriptionStoreInterface {
+	mock := &MockPushSubscriptionStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockPushSubscriptionStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPushSubscriptionStoreInterface) EXPECT() *MockPushSubscriptionStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// CreateVapidKeys mocks base method.
+func (m *MockPushSubscriptionStoreInterface) CreateVapidKeys(ctx context.Context, keys *models.VapidKeys) (*models.VapidKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVapidKeys", ctx, keys)
+	ret0, _ := ret[0].(*models.VapidKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVapidKeys indicates an expected call of CreateVapidKeys.
+func (mr *MockPushSubscriptionStoreInterfaceMockRecorder) CreateVapidKeys(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVapidKeys", reflect.TypeOf((*MockPushSubscriptionStoreInterface)(nil).CreateVapidKeys), ctx, keys)
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockPushSubscriptionStoreInterface) DeleteSubscription(ctx context.Context, userID, subscriptionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, userID, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockPushSubscriptionStoreInterfaceMockRecorder) DeleteSubscription(ctx, userID, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockPushSubscriptionStoreInterface)(nil).DeleteSubscription), ctx, userID, subscriptionID)
+}
+
+// DeleteSubscriptionByEndpoint mocks base method.
+func (m *MockPushSubscriptionStoreInterface) DeleteSubscriptionByEndpoint(ctx context.Context, endpoint string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscriptionByEndpoint", ctx, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscriptionByEndpoint indicates an expected call of DeleteSubscriptionByEndpoint.
+func (mr *MockPushSubscriptionStoreInterfaceMockRecorder) DeleteSubscriptionByEndpoint(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscriptionByEndpoint", reflect.TypeOf((*MockPushSubscriptionStoreInterface)(nil).DeleteSubscriptionByEndpoint), ctx, endpoint)
+}
+
+// GetVapidKeys mocks base method.
+func (m *MockPushSubscriptionStoreInterface) GetVapidKeys(ctx context.Context) (*models.VapidKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVapidKeys", ctx)
+	ret0, _ := ret[0].(*models.VapidKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVapidKeys indicates an expected call of GetVapidKeys.
+func (mr *MockPushSubscriptionStoreInterfaceMockRecorder) GetVapidKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVapidKeys", reflect.TypeOf((*MockPushSubscriptionStoreInterface)(nil).GetVapidKeys), ctx)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockPushSubscriptionStoreInterface) ListSubscriptions(ctx context.Context, userID string) ([]models.PushSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, userID)
+	ret0, _ := ret[0].([]models.PushSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockPushSubscriptionStoreInterfaceMockRecorder) ListSubscriptions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockPushSubscriptionStoreInterface)(nil).ListSubscriptions), ctx, userID)
+}
+
+// UpsertSubscription mocks base method.
+func (m *MockPushSubscriptionStoreInterface) UpsertSubscription(ctx context.Context, subscription *models.PushSubscription) (*models.PushSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSubscription", ctx, subscription)
+	ret0, _ := ret[0].(*models.PushSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSubscription indicates an expected call of UpsertSubscription.
+func (mr *MockPushSubscriptionStoreInterfaceMockRecorder) UpsertSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSubscription", reflect.TypeOf((*MockPushSubscriptionStoreInterface)(nil).UpsertSubscription), ctx, subscription)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/quiet_hours_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/quiet_hours_store.go -destination=internal/api/store/mocks/mock_quiet_hours_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockQuietHoursStoreInterface is a mock of QuietHoursStoreInterface interface.
+type MockQuietHoursStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuietHoursStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockQuietHoursStoreInterfaceMockRecorder is the mock recorder for MockQuietHoursStoreInterface.
+type MockQuietHoursStoreInterfaceMockRecorder struct {
+	mock *MockQuietHoursStoreInterface
+}
+
+// NewMockQuietHoursStoreInterface creates a new mock instance.
+func NewMockQuietHoursStoreInterface(ctrl *gomock.Controller) *MockQuietHoursStoreInterface {
+	mock := &MockQuietHoursStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockQuietHoursStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuietHoursStoreInterface) EXPECT() *MockQuietHoursStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteQuietHours mocks base method.
+func (m *MockQuietHoursStoreInterface) DeleteQuietHours(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQuietHours", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQuietHours indicates an expected call of DeleteQuietHours.
+func (mr *MockQuietHoursStoreInterfaceMockRecorder) DeleteQuietHours(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQuietHours", reflect.TypeOf((*MockQuietHoursStoreInterface)(nil).DeleteQuietHours), ctx, userID)
+}
+
+// GetQuietHours mocks base method.
+func (m *MockQuietHoursStoreInterface) GetQuietHours(ctx context.Context, userID string) (*models.QuietHours, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuietHours", ctx, userID)
+	ret0, _ := ret[0].(*models.QuietHours)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuietHours indicates an expected call of GetQuietHours.
+func (mr *MockQuietHoursStoreInterfaceMockRecorder) GetQuietHours(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuietHours", reflect.TypeOf((*MockQuietHoursStoreInterface)(nil).GetQuietHours), ctx, userID)
+}
+
+// ListQuietHours mocks base method.
+func (m *MockQuietHoursStoreInterface) ListQuietHours(ctx context.Context) ([]models.QuietHours, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuietHours", ctx)
+	ret0, _ := ret[0].([]models.QuietHours)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuietHours indicates an expected call of ListQuietHours.
+func (mr *MockQuietHoursStoreInterfaceMockRecorder) ListQuietHours(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuietHours", reflect.TypeOf((*MockQuietHoursStoreInterface)(nil).ListQuietHours), ctx)
+}
+
+// UpsertQuietHours mocks base method.
+func (m *MockQuietHoursStoreInterface) UpsertQuietHours(ctx context.Context, quietHours *models.QuietHours) (*models.QuietHours, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertQuietHours", ctx, quietHours)
+	ret0, _ := ret[0].(*models.QuietHours)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertQuietHours indicates an expected call of UpsertQuietHours.
+func (mr *MockQuietHoursStoreInterfaceMockRecorder) UpsertQuietHours(ctx, quietHours any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertQuietHours", reflect.TypeOf((*MockQuietHoursStoreInterface)(nil).UpsertQuietHours), ctx, quietHours)
+}
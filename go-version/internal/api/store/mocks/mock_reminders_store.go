@@ -14,6 +14,7 @@ import (
 	models "go-version/internal/api/models"
 	store "go-version/internal/api/store"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -58,17 +59,32 @@ func (mr *MockReminderStoreInterfaceMockRecorder) CreateReminder(ctx, reminder a
 }
 
 // DeleteReminder mocks base method.
-func (m *MockReminderStoreInterface) DeleteReminder(ctx context.Context, userID, reminderID string) error {
+func (m *MockReminderStoreInterface) DeleteReminder(ctx context.Context, userID, reminderID string, version int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteReminder", ctx, userID, reminderID)
+	ret := m.ctrl.Call(m, "DeleteReminder", ctx, userID, reminderID, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteReminder indicates an expected call of DeleteReminder.
-func (mr *MockReminderStoreInterfaceMockRecorder) DeleteReminder(ctx, userID, reminderID any) *gomock.Call {
+func (mr *MockReminderStoreInterfaceMockRecorder) DeleteReminder(ctx, userID, reminderID, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockReminderStoreInterface)(nil).DeleteReminder), ctx, userID, reminderID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReminder", reflect.TypeOf((*MockReminderStoreInterface)(nil).DeleteReminder), ctx, userID, reminderID, version)
+}
+
+// GetAccessibleReminder mocks base method.
+func (m *MockReminderStoreInterface) GetAccessibleReminder(ctx context.Context, userID, reminderID, permission string) (*models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccessibleReminder", ctx, userID, reminderID, permission)
+	ret0, _ := ret[0].(*models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccessibleReminder indicates an expected call of GetAccessibleReminder.
+func (mr *MockReminderStoreInterfaceMockRecorder) GetAccessibleReminder(ctx, userID, reminderID, permission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessibleReminder", reflect.TypeOf((*MockReminderStoreInterface)(nil).GetAccessibleReminder), ctx, userID, reminderID, permission)
 }
 
 // GetReminderByID mocks base method.
@@ -86,6 +102,21 @@ func (mr *MockReminderStoreInterfaceMockRecorder) GetReminderByID(ctx, userID, r
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminderByID", reflect.TypeOf((*MockReminderStoreInterface)(nil).GetReminderByID), ctx, userID, reminderID)
 }
 
+// ListActiveReminders mocks base method.
+func (m *MockReminderStoreInterface) ListActiveReminders(ctx context.Context, asOf time.Time) ([]models.Reminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActiveReminders", ctx, asOf)
+	ret0, _ := ret[0].([]models.Reminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActiveReminders indicates an expected call of ListActiveReminders.
+func (mr *MockReminderStoreInterfaceMockRecorder) ListActiveReminders(ctx, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActiveReminders", reflect.TypeOf((*MockReminderStoreInterface)(nil).ListActiveReminders), ctx, asOf)
+}
+
 // ListReminders mocks base method.
 func (m *MockReminderStoreInterface) ListReminders(ctx context.Context, filters *store.ReminderListFilters) ([]models.Reminder, error) {
 	m.ctrl.T.Helper()
@@ -115,3 +146,45 @@ func (mr *MockReminderStoreInterfaceMockRecorder) UpdateReminder(ctx, reminder a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReminder", reflect.TypeOf((*MockReminderStoreInterface)(nil).UpdateReminder), ctx, reminder)
 }
+
+// MockrowScanner is a mock of rowScanner interface.
+type MockrowScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockrowScannerMockRecorder
+	isgomock struct{}
+}
+
+// MockrowScannerMockRecorder is the mock recorder for MockrowScanner.
+type MockrowScannerMockRecorder struct {
+	mock *MockrowScanner
+}
+
+// NewMockrowScanner creates a new mock instance.
+func NewMockrowScanner(ctrl *gomock.Controller) *MockrowScanner {
+	mock := &MockrowScanner{ctrl: ctrl}
+	mock.recorder = &MockrowScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrowScanner) EXPECT() *MockrowScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockrowScanner) Scan(dest ...any) error {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range dest {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Scan", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockrowScannerMockRecorder) Scan(dest ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockrowScanner)(nil).Scan), dest...)
+}
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/api/store/supplies_store.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/api/store/supplies_store.go -destination=internal/api/store/mocks/mock_supplies_store.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	models "go-version/internal/api/models"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSupplyStoreInterface is a mock of SupplyStoreInterface interface.
+type MockSupplyStoreInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockSupplyStoreInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockSupplyStoreInterfaceMockRecorder is the mock recorder for MockSupplyStoreInterface.
+type MockSupplyStoreInterfaceMockRecorder struct {
+	mock *MockSupplyStoreInterface
+}
+
+// NewMockSupplyStoreInterface creates a new mock instance.
+func NewMockSupplyStoreInterface(ctrl *gomock.Controller) *MockSupplyStoreInterface {
+	mock := &MockSupplyStoreInterface{ctrl: ctrl}
+	mock.recorder = &MockSupplyStoreInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSupplyStoreInterface) EXPECT() *MockSupplyStoreInterfaceMockRecorder {
+	return m.recorder
+}
+
+// DeleteSupply mocks base method.
+func (m *MockSupplyStoreInterface) DeleteSupply(ctx context.Context, userID, reminderID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSupply", ctx, userID, reminderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSupply indicates an expected call of DeleteSupply.
+func (mr *MockSupplyStoreInterfaceMockRecorder) DeleteSupply(ctx, userID, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSupply", reflect.TypeOf((*MockSupplyStoreInterface)(nil).DeleteSupply), ctx, userID, reminderID)
+}
+
+// GetSupply mocks base method.
+func (m *MockSupplyStoreInterface) GetSupply(ctx context.Context, userID, reminderID string) (*models.ReminderSupply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupply", ctx, userID, reminderID)
+	ret0, _ := ret[0].(*models.ReminderSupply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupply indicates an expected call of GetSupply.
+func (mr *MockSupplyStoreInterfaceMockRecorder) GetSupply(ctx, userID, reminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupply", reflect.TypeOf((*MockSupplyStoreInterface)(nil).GetSupply), ctx, userID, reminderID)
+}
+
+// LogDose mocks base method.
+func (m *MockSupplyStoreInterface) LogDose(ctx context.Context, dose *models.DoseLog) (*models.ReminderSupply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogDose", ctx, dose)
+	ret0, _ := ret[0].(*models.ReminderSupply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogDose indicates an expected call of LogDose.
+func (mr *MockSupplyStoreInterfaceMockRecorder) LogDose(ctx, dose any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogDose", reflect.TypeOf((*MockSupplyStoreInterface)(nil).LogDose), ctx, dose)
+}
+
+// SetRefillReminder mocks base method.
+func (m *MockSupplyStoreInterface) SetRefillReminder(ctx context.Context, userID, reminderID string, refillReminderID *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRefillReminder", ctx, userID, reminderID, refillReminderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRefillReminder indicates an expected call of SetRefillReminder.
+func (mr *MockSupplyStoreInterfaceMockRecorder) SetRefillReminder(ctx, userID, reminderID, refillReminderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRefillReminder", reflect.TypeOf((*MockSupplyStoreInterface)(nil).SetRefillReminder), ctx, userID, reminderID, refillReminderID)
+}
+
+// UpsertSupply mocks base method.
+func (m *MockSupplyStoreInterface) UpsertSupply(ctx context.Context, supply *models.ReminderSupply) (*models.ReminderSupply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertSupply", ctx, supply)
+	ret0, _ := ret[0].(*models.ReminderSupply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertSupply indicates an expected call of UpsertSupply.
+func (mr *MockSupplyStoreInterfaceMockRecorder) UpsertSupply(ctx, supply any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertSupply", reflect.TypeOf((*MockSupplyStoreInterface)(nil).UpsertSupply), ctx, supply)
+}
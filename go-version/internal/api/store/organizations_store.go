@@ -0,0 +1,334 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+type OrganizationStoreInterface interface {
+	CreateOrganization(ctx context.Context, organization *models.Organization, adminID string) (*models.Organization, error)
+	ListOrganizations(ctx context.Context, userID string) ([]models.Organization, error)
+	GetMember(ctx context.Context, organizationID, userID string) (*models.OrganizationMember, error)
+	ListMembers(ctx context.Context, organizationID string) ([]models.OrganizationMember, error)
+	UpsertMember(ctx context.Context, member *models.OrganizationMember) (*models.OrganizationMember, error)
+	DeleteMember(ctx context.Context, organizationID, userID string) error
+	UpsertEnrollment(ctx context.Context, enrollment *models.PatientEnrollment) (*models.PatientEnrollment, error)
+	GetEnrollment(ctx context.Context, organizationID, patientID string) (*models.PatientEnrollment, error)
+	ListEnrollments(ctx context.Context, organizationID string) ([]models.PatientEnrollment, error)
+	ListEnrollmentsForPatient(ctx context.Context, patientID string) ([]models.PatientEnrollment, error)
+	ConsentEnrollment(ctx context.Context, enrollmentID, patientID string) (*models.PatientEnrollment, error)
+	DeleteEnrollment(ctx context.Context, organizationID, patientID string) error
+	DeleteEnrollmentForPatient(ctx context.Context, enrollmentID, patientID string) error
+}
+
+type OrganizationStore struct {
+	db *sql.DB
+}
+
+func NewOrganizationStore(db *sql.DB) (*OrganizationStore, error) {
+	return &OrganizationStore{db: db}, nil
+}
+
+// selectMembers selects organization members along with their name and
+// email. Queries using it refer to members as m.
+const selectMembers = `
+	SELECT m.organization_id, m.user_id, u.name, u.email, m.role, m.created_at
+	FROM organization_members m
+	JOIN users u ON u.id = m.user_id
+`
+
+func scanMember(row rowScanner) (*models.OrganizationMember, error) {
+	var member models.OrganizationMember
+	err := row.Scan(&member.OrganizationId, &member.UserId, &member.Name, &member.Email, &member.Role, &member.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+// selectEnrollments selects patient enrollments along with the names of
+// their organization and patient. Queries using it refer to enrollments
+// as e.
+const selectEnrollments = `
+	SELECT e.id, e.organization_id, o.name, e.patient_id, u.name, u.email, e.status, e.enrolled_by, e.created_at, e.consented_at
+	FROM patient_enrollments e
+	JOIN organizations o ON o.id = e.organization_id
+	JOIN users u ON u.id = e.patient_id
+`
+
+func scanEnrollment(row rowScanner) (*models.PatientEnrollment, error) {
+	var enrollment models.PatientEnrollment
+	err := row.Scan(&enrollment.Id, &enrollment.OrganizationId, &enrollment.OrganizationName, &enrollment.PatientId,
+		&enrollment.PatientName, &enrollment.PatientEmail, &enrollment.Status, &enrollment.EnrolledBy,
+		&enrollment.CreatedAt, &enrollment.ConsentedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &enrollment, nil
+}
+
+// CreateOrganization creates the organization with adminID as its first
+// admin.
+func (s *OrganizationStore) CreateOrganization(ctx context.Context, organization *models.Organization, adminID string) (*models.Organization, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var created models.Organization
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO organizations (id, name)
+		VALUES ($1, $2)
+		RETURNING id, name, created_at
+	`, organization.Id, organization.Name).Scan(&created.Id, &created.Name, &created.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role)
+		VALUES ($1, $2, $3)
+	`, created.Id, adminID, models.OrganizationRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	created.Role = models.OrganizationRoleAdmin
+	return &created, nil
+}
+
+// ListOrganizations returns the organizations the user is a member of,
+// with their role in each.
+func (s *OrganizationStore) ListOrganizations(ctx context.Context, userID string) ([]models.Organization, error) {
+	query := `
+		SELECT o.id, o.name, o.created_at, m.role
+		FROM organizations o
+		JOIN organization_members m ON m.organization_id = o.id
+		WHERE m.user_id=$1
+		ORDER BY o.name, o.id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	organizations := []models.Organization{}
+	for rows.Next() {
+		var organization models.Organization
+		if err := rows.Scan(&organization.Id, &organization.Name, &organization.CreatedAt, &organization.Role); err != nil {
+			return nil, err
+		}
+		organizations = append(organizations, organization)
+	}
+
+	return organizations, rows.Err()
+}
+
+func (s *OrganizationStore) GetMember(ctx context.Context, organizationID, userID string) (*models.OrganizationMember, error) {
+	query := selectMembers + `
+		WHERE m.organization_id=$1 AND m.user_id=$2
+	`
+
+	member, err := scanMember(s.db.QueryRowContext(ctx, query, organizationID, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoOrganizationMemberFoundError{OrganizationID: organizationID, UserID: userID}
+		}
+		return nil, err
+	}
+	return member, nil
+}
+
+func (s *OrganizationStore) ListMembers(ctx context.Context, organizationID string) ([]models.OrganizationMember, error) {
+	query := selectMembers + `
+		WHERE m.organization_id=$1
+		ORDER BY u.name, m.user_id
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := []models.OrganizationMember{}
+	for rows.Next() {
+		member, err := scanMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, *member)
+	}
+
+	return members, rows.Err()
+}
+
+// UpsertMember adds the user to the organization or changes their role.
+func (s *OrganizationStore) UpsertMember(ctx context.Context, member *models.OrganizationMember) (*models.OrganizationMember, error) {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO organization_members (organization_id, user_id, role)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (organization_id, user_id) DO UPDATE SET role = excluded.role
+	`, member.OrganizationId, member.UserId, member.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetMember(ctx, member.OrganizationId, member.UserId)
+}
+
+func (s *OrganizationStore) DeleteMember(ctx context.Context, organizationID, userID string) error {
+	query := `DELETE FROM organization_members WHERE organization_id=$1 AND user_id=$2`
+	result, err := s.db.ExecContext(ctx, query, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoOrganizationMemberFoundError{OrganizationID: organizationID, UserID: userID}
+	}
+
+	return nil
+}
+
+// UpsertEnrollment asks the patient to join the organization's roster.
+// Enrolling a patient again keeps their existing enrollment and consent.
+func (s *OrganizationStore) UpsertEnrollment(ctx context.Context, enrollment *models.PatientEnrollment) (*models.PatientEnrollment, error) {
+	_, err := s.db.ExecContext(ctx, `
+		INSERT INTO patient_enrollments (id, organization_id, patient_id, status, enrolled_by)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (organization_id, patient_id) DO NOTHING
+	`, enrollment.Id, enrollment.OrganizationId, enrollment.PatientId, models.EnrollmentPending, enrollment.EnrolledBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetEnrollment(ctx, enrollment.OrganizationId, enrollment.PatientId)
+}
+
+func (s *OrganizationStore) GetEnrollment(ctx context.Context, organizationID, patientID string) (*models.PatientEnrollment, error) {
+	query := selectEnrollments + `
+		WHERE e.organization_id=$1 AND e.patient_id=$2
+	`
+
+	enrollment, err := scanEnrollment(s.db.QueryRowContext(ctx, query, organizationID, patientID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &PatientNotEnrolledError{OrganizationID: organizationID, PatientID: patientID}
+		}
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+// ListEnrollments returns the organization's roster, pending enrollments
+// included.
+func (s *OrganizationStore) ListEnrollments(ctx context.Context, organizationID string) ([]models.PatientEnrollment, error) {
+	query := selectEnrollments + `
+		WHERE e.organization_id=$1
+		ORDER BY u.name, e.patient_id
+	`
+	return s.listEnrollments(ctx, query, organizationID)
+}
+
+func (s *OrganizationStore) ListEnrollmentsForPatient(ctx context.Context, patientID string) ([]models.PatientEnrollment, error) {
+	query := selectEnrollments + `
+		WHERE e.patient_id=$1
+		ORDER BY o.name, e.organization_id
+	`
+	return s.listEnrollments(ctx, query, patientID)
+}
+
+func (s *OrganizationStore) listEnrollments(ctx context.Context, query string, args ...interface{}) ([]models.PatientEnrollment, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	enrollments := []models.PatientEnrollment{}
+	for rows.Next() {
+		enrollment, err := scanEnrollment(rows)
+		if err != nil {
+			return nil, err
+		}
+		enrollments = append(enrollments, *enrollment)
+	}
+
+	return enrollments, rows.Err()
+}
+
+// ConsentEnrollment records the patient's consent to a pending enrollment,
+// making it active.
+func (s *OrganizationStore) ConsentEnrollment(ctx context.Context, enrollmentID, patientID string) (*models.PatientEnrollment, error) {
+	query := `
+		UPDATE patient_enrollments
+		SET status = $1, consented_at = CURRENT_TIMESTAMP
+		WHERE id = $2 AND patient_id = $3 AND status = $4
+		RETURNING organization_id
+	`
+
+	var organizationID string
+	err := s.db.QueryRowContext(ctx, query, models.EnrollmentActive, enrollmentID, patientID, models.EnrollmentPending).Scan(&organizationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoPatientEnrollmentFoundError{ID: enrollmentID}
+		}
+		return nil, err
+	}
+
+	return s.GetEnrollment(ctx, organizationID, patientID)
+}
+
+// DeleteEnrollment removes the patient from the organization's roster.
+func (s *OrganizationStore) DeleteEnrollment(ctx context.Context, organizationID, patientID string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM patient_enrollments WHERE organization_id=$1 AND patient_id=$2`, organizationID, patientID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &PatientNotEnrolledError{OrganizationID: organizationID, PatientID: patientID}
+	}
+
+	return nil
+}
+
+// DeleteEnrollmentForPatient declines a pending enrollment or withdraws
+// the patient's consent to an active one.
+func (s *OrganizationStore) DeleteEnrollmentForPatient(ctx context.Context, enrollmentID, patientID string) error {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM patient_enrollments WHERE id=$1 AND patient_id=$2`, enrollmentID, patientID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoPatientEnrollmentFoundError{ID: enrollmentID}
+	}
+
+	return nil
+}
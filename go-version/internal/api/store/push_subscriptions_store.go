@@ -0,0 +1,137 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+type PushSubscriptionStoreInterface interface {
+	ListSubscriptions(ctx context.Context, userID string) ([]models.PushSubscription, error)
+	UpsertSubscription(ctx context.Context, subscription *models.PushSubscription) (*models.PushSubscription, error)
+	DeleteSubscription(ctx context.Context, userID, subscriptionID string) error
+	DeleteSubscriptionByEndpoint(ctx context.Context, endpoint string) error
+	GetVapidKeys(ctx context.Context) (*models.VapidKeys, error)
+	CreateVapidKeys(ctx context.Context, keys *models.VapidKeys) (*models.VapidKeys, error)
+}
+
+type PushSubscriptionStore struct {
+	db *sql.DB
+}
+
+func NewPushSubscriptionStore(db *sql.DB) (*PushSubscriptionStore, error) {
+	return &PushSubscriptionStore{db: db}, nil
+}
+
+func (s *PushSubscriptionStore) ListSubscriptions(ctx context.Context, userID string) ([]models.PushSubscription, error) {
+	query := `
+		SELECT id, user_id, endpoint, p256dh, auth, user_agent, created_at, updated_at
+		FROM push_subscriptions
+		WHERE user_id=$1
+		ORDER BY created_at
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subscriptions []models.PushSubscription
+	for rows.Next() {
+		var sub models.PushSubscription
+		if err := rows.Scan(&sub.Id, &sub.UserId, &sub.Endpoint, &sub.P256dh, &sub.Auth, &sub.UserAgent, &sub.CreatedAt, &sub.UpdatedAt); err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+// UpsertSubscription stores the subscription, replacing the keys and owner of
+// an existing row with the same endpoint. Browsers re-send the same endpoint
+// when a page re-subscribes, and an endpoint can only belong to one user.
+func (s *PushSubscriptionStore) UpsertSubscription(ctx context.Context, subscription *models.PushSubscription) (*models.PushSubscription, error) {
+	query := `
+		INSERT INTO push_subscriptions (id, user_id, endpoint, p256dh, auth, user_agent)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (endpoint) DO UPDATE SET
+			user_id = excluded.user_id,
+			p256dh = excluded.p256dh,
+			auth = excluded.auth,
+			user_agent = excluded.user_agent
+		RETURNING id, user_id, endpoint, p256dh, auth, user_agent, created_at, updated_at
+	`
+
+	var sub models.PushSubscription
+	err := s.db.QueryRowContext(ctx, query,
+		subscription.Id,
+		subscription.UserId,
+		subscription.Endpoint,
+		subscription.P256dh,
+		subscription.Auth,
+		subscription.UserAgent,
+	).Scan(&sub.Id, &sub.UserId, &sub.Endpoint, &sub.P256dh, &sub.Auth, &sub.UserAgent, &sub.CreatedAt, &sub.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+func (s *PushSubscriptionStore) DeleteSubscription(ctx context.Context, userID, subscriptionID string) error {
+	query := `DELETE FROM push_subscriptions WHERE id=$1 AND user_id=$2`
+	result, err := s.db.ExecContext(ctx, query, subscriptionID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoPushSubscriptionFoundError{ID: subscriptionID}
+	}
+
+	return nil
+}
+
+func (s *PushSubscriptionStore) DeleteSubscriptionByEndpoint(ctx context.Context, endpoint string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM push_subscriptions WHERE endpoint=$1`, endpoint)
+	return err
+}
+
+func (s *PushSubscriptionStore) GetVapidKeys(ctx context.Context) (*models.VapidKeys, error) {
+	query := `SELECT public_key, private_key, created_at FROM vapid_keys WHERE id=1`
+
+	var keys models.VapidKeys
+	err := s.db.QueryRowContext(ctx, query).Scan(&keys.PublicKey, &keys.PrivateKey, &keys.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoVapidKeysFoundError{}
+		}
+		return nil, err
+	}
+
+	return &keys, nil
+}
+
+// CreateVapidKeys stores the key pair unless one already exists, and returns
+// whichever pair is stored so concurrent starts agree on the same keys.
+func (s *PushSubscriptionStore) CreateVapidKeys(ctx context.Context, keys *models.VapidKeys) (*models.VapidKeys, error) {
+	query := `
+		INSERT INTO vapid_keys (id, public_key, private_key)
+		VALUES (1, $1, $2)
+		ON CONFLICT (id) DO NOTHING
+	`
+
+	if _, err := s.db.ExecContext(ctx, query, keys.PublicKey, keys.PrivateKey); err != nil {
+		return nil, err
+	}
+
+	return s.GetVapidKeys(ctx)
+}
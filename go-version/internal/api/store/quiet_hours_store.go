@@ -0,0 +1,112 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+type QuietHoursStoreInterface interface {
+	GetQuietHours(ctx context.Context, userID string) (*models.QuietHours, error)
+	UpsertQuietHours(ctx context.Context, quietHours *models.QuietHours) (*models.QuietHours, error)
+	DeleteQuietHours(ctx context.Context, userID string) error
+	ListQuietHours(ctx context.Context) ([]models.QuietHours, error)
+}
+
+type QuietHoursStore struct {
+	db *sql.DB
+}
+
+func NewQuietHoursStore(db *sql.DB) (*QuietHoursStore, error) {
+	return &QuietHoursStore{db: db}, nil
+}
+
+func (s *QuietHoursStore) GetQuietHours(ctx context.Context, userID string) (*models.QuietHours, error) {
+	query := `
+		SELECT user_id, start_time, end_time, time_zone, created_at, updated_at
+		FROM quiet_hours
+		WHERE user_id=$1
+	`
+
+	var quietHours models.QuietHours
+	err := s.db.QueryRowContext(ctx, query, userID).Scan(&quietHours.UserId, &quietHours.StartTime, &quietHours.EndTime, &quietHours.TimeZone, &quietHours.CreatedAt, &quietHours.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoQuietHoursFoundError{UserID: userID}
+		}
+		return nil, err
+	}
+
+	return &quietHours, nil
+}
+
+func (s *QuietHoursStore) UpsertQuietHours(ctx context.Context, quietHours *models.QuietHours) (*models.QuietHours, error) {
+	query := `
+		INSERT INTO quiet_hours (user_id, start_time, end_time, time_zone)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (user_id) DO UPDATE SET
+			start_time = excluded.start_time,
+			end_time = excluded.end_time,
+			time_zone = excluded.time_zone
+		RETURNING user_id, start_time, end_time, time_zone, created_at, updated_at
+	`
+
+	var saved models.QuietHours
+	err := s.db.QueryRowContext(ctx, query,
+		quietHours.UserId,
+		quietHours.StartTime,
+		quietHours.EndTime,
+		quietHours.TimeZone,
+	).Scan(&saved.UserId, &saved.StartTime, &saved.EndTime, &saved.TimeZone, &saved.CreatedAt, &saved.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &saved, nil
+}
+
+func (s *QuietHoursStore) DeleteQuietHours(ctx context.Context, userID string) error {
+	query := `DELETE FROM quiet_hours WHERE user_id=$1`
+	result, err := s.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return &NoQuietHoursFoundError{UserID: userID}
+	}
+
+	return nil
+}
+
+// ListQuietHours returns every user's settings. It is used by background
+// delivery rather than by requests.
+func (s *QuietHoursStore) ListQuietHours(ctx context.Context) ([]models.QuietHours, error) {
+	query := `
+		SELECT user_id, start_time, end_time, time_zone, created_at, updated_at
+		FROM quiet_hours
+	`
+
+	rows, err := s.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var quietHours []models.QuietHours
+	for rows.Next() {
+		var q models.QuietHours
+		if err := rows.Scan(&q.UserId, &q.StartTime, &q.EndTime, &q.TimeZone, &q.CreatedAt, &q.UpdatedAt); err != nil {
+			return nil, err
+		}
+		quietHours = append(quietHours, q)
+	}
+
+	return quietHours, rows.Err()
+}
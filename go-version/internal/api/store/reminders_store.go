@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"go-version/internal/api/models"
 )
@@ -11,9 +14,103 @@ import (
 type ReminderStoreInterface interface {
 	ListReminders(ctx context.Context, filters *ReminderListFilters) ([]models.Reminder, error)
 	GetReminderByID(ctx context.Context, userID, reminderID string) (*models.Reminder, error)
+	GetAccessibleReminder(ctx context.Context, userID, reminderID, permission string) (*models.Reminder, error)
 	CreateReminder(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error)
 	UpdateReminder(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error)
-	DeleteReminder(ctx context.Context, userID, reminderID string) error
+	DeleteReminder(ctx context.Context, userID, reminderID string, version int) error
+	ListActiveReminders(ctx context.Context, asOf time.Time) ([]models.Reminder, error)
+}
+
+// reminderColumns are the columns scanReminder reads, and remindersFrom
+// joins the tables they come from. Queries using them refer to reminders
+// as r and medications as m.
+const (
+	reminderColumns = `
+		r.id, r.user_id, r.rrule, r.description, r.start_at, r.critical, r.created_at, r.updated_at,
+		r.first_occurrence_at, r.last_occurrence_at, r.version,
+		m.name, m.strength, m.dose_quantity, m.dose_unit, m.route, m.instructions
+	`
+	remindersFrom = `
+	FROM reminders r
+	LEFT JOIN reminder_medications m ON m.reminder_id = r.id
+	`
+)
+
+// selectReminders selects reminders along with their medication, if any.
+const selectReminders = `SELECT ` + reminderColumns + remindersFrom
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanReminder scans the reminderColumns, followed by any extra columns
+// into extra.
+func scanReminder(row rowScanner, extra ...any) (*models.Reminder, error) {
+	var reminder models.Reminder
+	var medication models.Medication
+	var medicationName sql.NullString
+	dest := []any{&reminder.Id, &reminder.UserId, &reminder.RRule, &reminder.Description, &reminder.StartAt, &reminder.Critical, &reminder.CreatedAt, &reminder.UpdatedAt,
+		&reminder.FirstOccurrenceAt, &reminder.LastOccurrenceAt, &reminder.Version,
+		&medicationName, &medication.Strength, &medication.DoseQuantity, &medication.DoseUnit, &medication.Route, &medication.Instructions}
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if medicationName.Valid {
+		medication.ReminderId = reminder.Id
+		medication.Name = medicationName.String
+		reminder.Medication = &medication
+	}
+	return &reminder, nil
+}
+
+// occurrenceBounds returns the first and last occurrences to store for the
+// reminder, in UTC so that SQLite, which stores times as text, compares
+// them in order.
+func occurrenceBounds(reminder *models.Reminder) (first, last *time.Time, err error) {
+	first, last, err = reminder.OccurrenceBounds()
+	if err != nil {
+		return nil, nil, err
+	}
+	if first != nil {
+		utc := first.UTC()
+		first = &utc
+	}
+	if last != nil {
+		utc := last.UTC()
+		last = &utc
+	}
+	return first, last, nil
+}
+
+// replaceMedication stores medication as the reminder's, removing any
+// medication it had when medication is nil.
+func replaceMedication(ctx context.Context, tx *sql.Tx, reminder *models.Reminder, medication *models.Medication) error {
+	if medication == nil {
+		_, err := tx.ExecContext(ctx, `DELETE FROM reminder_medications WHERE reminder_id=$1`, reminder.Id)
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO reminder_medications (reminder_id, user_id, name, strength, dose_quantity, dose_unit, route, instructions)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (reminder_id) DO UPDATE SET
+			name = excluded.name,
+			strength = excluded.strength,
+			dose_quantity = excluded.dose_quantity,
+			dose_unit = excluded.dose_unit,
+			route = excluded.route,
+			instructions = excluded.instructions
+	`, reminder.Id, reminder.UserId, medication.Name, medication.Strength, medication.DoseQuantity, medication.DoseUnit, medication.Route, medication.Instructions)
+	if err != nil {
+		return err
+	}
+
+	saved := *medication
+	saved.ReminderId = reminder.Id
+	reminder.Medication = &saved
+	return nil
 }
 
 type ReminderStore struct {
@@ -24,29 +121,152 @@ func NewReminderStore(db *sql.DB) (*ReminderStore, error) {
 	return &ReminderStore{db: db}, nil
 }
 
+// ListReminders searches with the SQLite FTS5 index.
 func (s *ReminderStore) ListReminders(ctx context.Context, filters *ReminderListFilters) ([]models.Reminder, error) {
-	query := `
-		SELECT id, user_id, rrule, description, start_at, created_at, updated_at
-		FROM reminders
-		WHERE user_id=$1
-	`
+	return s.listReminders(ctx, filters, ftsSearch{}, lowerLike, sqliteOrder)
+}
+
+// listReminders lists the filtered reminders, matching searches with
+// search and medication names with contains and sorting in order, so that
+// each backend can use its own full-text search, case-insensitive LIKE and
+// comparisons.
+func (s *ReminderStore) listReminders(ctx context.Context, filters *ReminderListFilters, search reminderSearch, contains containsCondition, order listOrder) ([]models.Reminder, error) {
+	columns, joins, where := "NULL, NULL", "", `r.user_id=$1`
+	if filters.IncludeShared {
+		where = `(r.user_id=$1 OR ` + sharedReminderCondition(1, models.CaregiverPermissionView) + `)`
+	}
 
 	args := []interface{}{filters.UserID}
 	argIdx := 2
 
+	// A search matches the description or the medication name or
+	// instructions, and one without any words matches nothing.
+	var terms []searchTerm
 	if filters.Search != nil {
-		query += fmt.Sprintf(` AND LOWER(description) LIKE LOWER($%d)`, argIdx)
-		args = append(args, "%"+*filters.Search+"%")
+		terms = parseSearch(*filters.Search)
+		if len(terms) == 0 {
+			return nil, nil
+		}
+		var condition string
+		var searchArgs []any
+		joins, condition, columns, searchArgs = search.clause(terms, argIdx)
+		where += ` AND ` + condition
+		args = append(args, searchArgs...)
+		argIdx += len(searchArgs)
+	}
+
+	if filters.Medication != nil {
+		where += ` AND ` + contains("m.name", argIdx)
+		args = append(args, searchPattern(*filters.Medication))
 		argIdx++
 	}
 
+	// Series that end before the range or only begin after it are skipped
+	// without expanding their rules. Reminders whose bounds are unknown are
+	// only pruned by their start.
 	if filters.StartDate != nil && filters.EndDate != nil {
-		query += fmt.Sprintf(` AND start_at <= $%d`, argIdx)
-		args = append(args, *filters.EndDate)
-		argIdx++
+		where += fmt.Sprintf(` AND r.start_at <= $%d`, argIdx)
+		where += fmt.Sprintf(` AND (r.first_occurrence_at IS NULL OR r.first_occurrence_at <= $%d)`, argIdx+1)
+		where += fmt.Sprintf(` AND (r.last_occurrence_at IS NULL OR r.last_occurrence_at >= $%d)`, argIdx+2)
+		args = append(args, *filters.EndDate, filters.EndDate.UTC(), filters.StartDate.UTC())
+		argIdx += 3
 	}
 
+	query := `SELECT ` + reminderColumns + `, ` + columns + remindersFrom + joins + ` WHERE ` + where
+	if filters.Sort == "" {
+		reminders, _, err := s.scanListedReminders(ctx, query, args, search, terms)
+		return reminders, err
+	}
+
+	direction, comparison := "ASC", ">"
+	if filters.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	sortKeys := order.sortKeys(filters.Sort, reminderSortColumns[filters.Sort], "r.id")
+	orderBy := ` ORDER BY ` + strings.Join(sortKeys, ` `+direction+`, `) + ` ` + direction
+
+	// A search may reject rows its query matched, so pages are filled from
+	// the rows following them.
+	var reminders []models.Reminder
+	after := filters.After
+	for {
+		pageQuery, pageArgs := query, args
+		if after != nil {
+			afterKeys := order.sortKeys(filters.Sort, fmt.Sprintf(`$%d`, argIdx), fmt.Sprintf(`$%d`, argIdx+1))
+			pageQuery += fmt.Sprintf(` AND (%s) %s (%s)`, strings.Join(sortKeys, `, `), comparison, strings.Join(afterKeys, `, `))
+			pageArgs = append(slices.Clip(args), after.value(filters.Sort), after.ID)
+		}
+		pageQuery += orderBy
+		wanted := 0
+		if filters.Limit != nil {
+			wanted = *filters.Limit - len(reminders)
+			pageQuery += fmt.Sprintf(` LIMIT %d`, wanted)
+		}
+
+		page, last, err := s.scanListedReminders(ctx, pageQuery, pageArgs, search, terms)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, page...)
+		if filters.Limit == nil || terms == nil || len(page) == wanted || last == nil {
+			return reminders, nil
+		}
+		after = newReminderListKey(last, filters.Sort)
+	}
+}
+
+// scanListedReminders runs a listing query and returns the reminders the
+// search matches, along with the last row read whether it matched or not.
+func (s *ReminderStore) scanListedReminders(ctx context.Context, query string, args []any, search reminderSearch, terms []searchTerm) ([]models.Reminder, *models.Reminder, error) {
 	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var reminders []models.Reminder
+	var last *models.Reminder
+	for rows.Next() {
+		var rank sql.NullFloat64
+		var snippet sql.NullString
+		reminder, err := scanReminder(rows, &rank, &snippet)
+		if err != nil {
+			return nil, nil, err
+		}
+		last = reminder
+		if terms != nil && !search.match(reminder, terms, rank, snippet) {
+			continue
+		}
+		reminders = append(reminders, *reminder)
+	}
+
+	return reminders, last, rows.Err()
+}
+
+// newReminderListKey returns the position of the reminder in a listing
+// sorted by sort.
+func newReminderListKey(reminder *models.Reminder, sort string) *ReminderListKey {
+	key := &ReminderListKey{ID: reminder.Id}
+	switch sort {
+	case models.ReminderSortCreatedAt:
+		key.Time = reminder.CreatedAt
+	case models.ReminderSortDescription:
+		key.Text = derefString(reminder.Description)
+	default:
+		startAt := reminder.StartAt
+		key.Time = &startAt
+	}
+	return key
+}
+
+// ListActiveReminders returns the reminders of every user whose series has
+// started by asOf. It is used by background delivery rather than by requests.
+func (s *ReminderStore) ListActiveReminders(ctx context.Context, asOf time.Time) ([]models.Reminder, error) {
+	query := selectReminders + `
+		WHERE r.start_at <= $1
+	`
+
+	rows, err := s.db.QueryContext(ctx, query, asOf)
 	if err != nil {
 		return nil, err
 	}
@@ -54,25 +274,22 @@ func (s *ReminderStore) ListReminders(ctx context.Context, filters *ReminderList
 
 	var reminders []models.Reminder
 	for rows.Next() {
-		var reminder models.Reminder
-		if err := rows.Scan(&reminder.Id, &reminder.UserId, &reminder.RRule, &reminder.Description, &reminder.StartAt, &reminder.CreatedAt, &reminder.UpdatedAt); err != nil {
+		reminder, err := scanReminder(rows)
+		if err != nil {
 			return nil, err
 		}
-		reminders = append(reminders, reminder)
+		reminders = append(reminders, *reminder)
 	}
 
-	return reminders, nil
+	return reminders, rows.Err()
 }
 
 func (s *ReminderStore) GetReminderByID(ctx context.Context, userID, reminderID string) (*models.Reminder, error) {
-	query := `
-		SELECT id, user_id, rrule, description, start_at, created_at, updated_at
-		FROM reminders
-		WHERE id=$1 AND user_id=$2
+	query := selectReminders + `
+		WHERE r.id=$1 AND r.user_id=$2
 	`
 
-	var reminder models.Reminder
-	err := s.db.QueryRowContext(ctx, query, reminderID, userID).Scan(&reminder.Id, &reminder.UserId, &reminder.RRule, &reminder.Description, &reminder.StartAt, &reminder.CreatedAt, &reminder.UpdatedAt)
+	reminder, err := scanReminder(s.db.QueryRowContext(ctx, query, reminderID, userID))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &NoReminderFoundError{
@@ -81,60 +298,159 @@ func (s *ReminderStore) GetReminderByID(ctx context.Context, userID, reminderID
 		}
 		return nil, err
 	}
-	return &reminder, nil
+	return reminder, nil
 }
 
+// GetAccessibleReminder returns the reminder if the user owns it or it is
+// shared with them as a caregiver with at least the permission.
+func (s *ReminderStore) GetAccessibleReminder(ctx context.Context, userID, reminderID, permission string) (*models.Reminder, error) {
+	query := selectReminders + `
+		WHERE r.id=$1 AND (r.user_id=$2 OR ` + sharedReminderCondition(2, permission) + `)
+	`
+
+	reminder, err := scanReminder(s.db.QueryRowContext(ctx, query, reminderID, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoReminderFoundError{
+				ID: reminderID,
+			}
+		}
+		return nil, err
+	}
+	return reminder, nil
+}
+
+// CreateReminder inserts the reminder, failing with ReminderExistsError if
+// its id is taken, whoever the reminder with that id belongs to.
 func (s *ReminderStore) CreateReminder(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error) {
 	query := `
-		INSERT INTO reminders (id, user_id, rrule, description, start_at)
-		VALUES ($1, $2, $3, $4, $5)
-		RETURNING id, user_id, rrule, description, start_at, created_at, updated_at
+		INSERT INTO reminders (id, user_id, rrule, description, start_at, critical, first_occurrence_at, last_occurrence_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (id) DO NOTHING
+		RETURNING id, user_id, rrule, description, start_at, critical, created_at, updated_at, first_occurrence_at, last_occurrence_at, version
 	`
 
+	firstOccurrence, lastOccurrence, err := occurrenceBounds(reminder)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	var newReminder models.Reminder
-	err := s.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		reminder.Id,
 		reminder.UserId,
 		reminder.RRule,
 		reminder.Description,
 		reminder.StartAt,
-	).Scan(&newReminder.Id, &newReminder.UserId, &newReminder.RRule, &newReminder.Description, &newReminder.StartAt, &newReminder.CreatedAt, &newReminder.UpdatedAt)
+		reminder.Critical,
+		firstOccurrence,
+		lastOccurrence,
+	).Scan(&newReminder.Id, &newReminder.UserId, &newReminder.RRule, &newReminder.Description, &newReminder.StartAt, &newReminder.Critical, &newReminder.CreatedAt, &newReminder.UpdatedAt,
+		&newReminder.FirstOccurrenceAt, &newReminder.LastOccurrenceAt, &newReminder.Version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &ReminderExistsError{ID: reminder.Id}
+		}
+		return nil, err
+	}
 
-	return &newReminder, err
+	if err := replaceMedication(ctx, tx, &newReminder, reminder.Medication); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return &newReminder, nil
 }
 
+// UpdateReminder replaces the reminder and bumps its version. When the
+// reminder's Version is set, the update only applies if the stored reminder
+// is still at that version, and fails with ReminderChangedError otherwise.
 func (s *ReminderStore) UpdateReminder(ctx context.Context, reminder *models.Reminder) (*models.Reminder, error) {
 	query := `
         UPDATE reminders 
-        SET rrule = $1, description = $2, start_at = $3, updated_at = NOW()
-        WHERE id = $4 AND user_id = $5
-        RETURNING id, user_id, rrule, description, start_at, created_at, updated_at
+        SET rrule = $1, description = $2, start_at = $3, critical = $4, first_occurrence_at = $5, last_occurrence_at = $6,
+            updated_at = CURRENT_TIMESTAMP, version = version + 1
+        WHERE id = $7 AND user_id = $8 AND ($9 = 0 OR version = $9)
+        RETURNING id, user_id, rrule, description, start_at, critical, created_at, updated_at, first_occurrence_at, last_occurrence_at, version
     `
 
+	firstOccurrence, lastOccurrence, err := occurrenceBounds(reminder)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
 	var updatedReminder models.Reminder
-	err := s.db.QueryRowContext(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		reminder.RRule,
 		reminder.Description,
 		reminder.StartAt,
+		reminder.Critical,
+		firstOccurrence,
+		lastOccurrence,
 		reminder.Id,
 		reminder.UserId,
+		reminder.Version,
 	).Scan(&updatedReminder.Id, &updatedReminder.UserId, &updatedReminder.RRule,
-		&updatedReminder.Description, &updatedReminder.StartAt,
-		&updatedReminder.CreatedAt, &updatedReminder.UpdatedAt)
+		&updatedReminder.Description, &updatedReminder.StartAt, &updatedReminder.Critical,
+		&updatedReminder.CreatedAt, &updatedReminder.UpdatedAt,
+		&updatedReminder.FirstOccurrenceAt, &updatedReminder.LastOccurrenceAt, &updatedReminder.Version)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
+			if reminder.Version != 0 {
+				return nil, &ReminderChangedError{ID: reminder.Id}
+			}
 			return nil, &NoReminderFoundError{ID: reminder.Id}
 		}
 		return nil, err
 	}
 
+	if err := replaceMedication(ctx, tx, &updatedReminder, reminder.Medication); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
 	return &updatedReminder, nil
 }
 
-func (s *ReminderStore) DeleteReminder(ctx context.Context, userID, reminderID string) error {
-	query := `DELETE FROM reminders WHERE id=$1 AND user_id=$2`
-	result, err := s.db.ExecContext(ctx, query, reminderID, userID)
+// DeleteReminder deletes the reminder. A nonzero version makes the delete
+// conditional, as in UpdateReminder. The refill reminder of the reminder's
+// supply goes with it, as nothing is left to refill.
+func (s *ReminderStore) DeleteReminder(ctx context.Context, userID, reminderID string, version int) error {
+	query := `DELETE FROM reminders WHERE id=$1 AND user_id=$2 AND ($3 = 0 OR version = $3)`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var refillReminderID *string
+	err = tx.QueryRowContext(ctx,
+		`SELECT refill_reminder_id FROM reminder_supplies WHERE reminder_id=$1 AND user_id=$2`,
+		reminderID, userID,
+	).Scan(&refillReminderID)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, query, reminderID, userID, version)
 	if err != nil {
 		return err
 	}
@@ -145,10 +461,20 @@ func (s *ReminderStore) DeleteReminder(ctx context.Context, userID, reminderID s
 	}
 
 	if rowsAffected == 0 {
+		if version != 0 {
+			return &ReminderChangedError{ID: reminderID}
+		}
 		return &NoReminderFoundError{
 			ID: reminderID,
 		}
 	}
 
-	return nil
+	if refillReminderID != nil {
+		_, err = tx.ExecContext(ctx, `DELETE FROM reminders WHERE id=$1 AND user_id=$2`, *refillReminderID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
@@ -0,0 +1,28 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+// PostgresReminderStore is the ReminderStoreInterface for PostgreSQL. It
+// shares the ReminderStore queries, which are portable apart from the
+// full-text search of ListReminders, and only overrides those that need or
+// have a better PostgreSQL form.
+type PostgresReminderStore struct {
+	*ReminderStore
+}
+
+func NewPostgresReminderStore(db *sql.DB) (*PostgresReminderStore, error) {
+	return &PostgresReminderStore{ReminderStore: &ReminderStore{db: db}}, nil
+}
+
+// ListReminders searches without the SQLite full-text index, matching terms
+// with ILIKE rather than lowering both sides, so that trigram indexes on
+// the searched columns can be used, and compares the text it sorts on byte
+// by byte.
+func (s *PostgresReminderStore) ListReminders(ctx context.Context, filters *ReminderListFilters) ([]models.Reminder, error) {
+	return s.listReminders(ctx, filters, likeSearch{contains: iLike}, iLike, postgresOrder)
+}
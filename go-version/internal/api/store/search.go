@@ -0,0 +1,266 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"html"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"go-version/internal/api/models"
+)
+
+// searchTerm is a word or quoted phrase of a search. Every term must match.
+type searchTerm struct {
+	Text string
+	// Prefix matches words beginning with the term's last word.
+	Prefix bool
+}
+
+// parseSearch splits a search into terms. Words match the words they
+// begin, so that results come as the user types, and text in double quotes
+// is a phrase matching whole words unless followed by *.
+func parseSearch(search string) []searchTerm {
+	var terms []searchTerm
+	for {
+		search = strings.TrimLeftFunc(search, unicode.IsSpace)
+		if search == "" {
+			return terms
+		}
+
+		var term searchTerm
+		if search[0] == '"' {
+			end := strings.IndexByte(search[1:], '"')
+			if end < 0 {
+				end = len(search) - 1
+			}
+			term.Text, search = search[1:end+1], search[min(end+2, len(search)):]
+			if strings.HasPrefix(search, "*") {
+				term.Prefix, search = true, search[1:]
+			}
+		} else {
+			end := strings.IndexFunc(search, unicode.IsSpace)
+			if end < 0 {
+				end = len(search)
+			}
+			term.Text, search = search[:end], search[end:]
+			term.Prefix = true
+		}
+
+		term.Text = strings.TrimSpace(strings.TrimRight(term.Text, "*"))
+		if term.Text != "" {
+			terms = append(terms, term)
+		}
+	}
+}
+
+// Snippets mark matches with these control characters until highlight
+// escapes the text around them.
+const (
+	snippetMatchStart = "\x02"
+	snippetMatchEnd   = "\x03"
+)
+
+// highlight escapes a snippet for HTML and wraps its matches in <mark>.
+func highlight(snippet string) string {
+	snippet = strings.ToValidUTF8(snippet, "")
+	snippet = html.EscapeString(snippet)
+	snippet = strings.ReplaceAll(snippet, snippetMatchStart, "<mark>")
+	return strings.ReplaceAll(snippet, snippetMatchEnd, "</mark>")
+}
+
+// reminderSearch narrows a reminder listing to the reminders matching a
+// search, and ranks and highlights them.
+type reminderSearch interface {
+	// clause returns the tables to join and the condition matching the
+	// terms, with arguments numbered from arg, and the rank and snippet
+	// columns to select.
+	clause(terms []searchTerm, arg int) (joins, condition, columns string, args []any)
+	// match sets the reminder's rank and snippet from the selected columns,
+	// and reports whether the reminder matches after all.
+	match(reminder *models.Reminder, terms []searchTerm, rank sql.NullFloat64, snippet sql.NullString) bool
+}
+
+// ftsSearch searches the SQLite FTS5 index, ranking with bm25 and weighting
+// descriptions above medication names above instructions.
+type ftsSearch struct{}
+
+func (ftsSearch) clause(terms []searchTerm, arg int) (string, string, string, []any) {
+	joins := `
+		JOIN reminder_search_rows s ON s.reminder_id = r.id
+		JOIN reminders_fts ON reminders_fts.rowid = s.id
+	`
+	columns := `bm25(reminders_fts, 10.0, 5.0, 1.0), snippet(reminders_fts, -1, char(2), char(3), '…', 12)`
+	return joins, fmt.Sprintf(`reminders_fts MATCH $%d`, arg), columns, []any{ftsQuery(terms)}
+}
+
+func (ftsSearch) match(reminder *models.Reminder, terms []searchTerm, rank sql.NullFloat64, snippet sql.NullString) bool {
+	reminder.SearchRank = &rank.Float64
+	highlighted := highlight(snippet.String)
+	reminder.Snippet = &highlighted
+	return true
+}
+
+// ftsQuery quotes every term so that FTS5 reads the user's text as words
+// and never as query syntax.
+func ftsQuery(terms []searchTerm) string {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = `"` + strings.ReplaceAll(term.Text, `"`, `""`) + `"`
+		if term.Prefix {
+			quoted[i] += ` *`
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+// likeSearch is the search for backends without a full-text index. Each
+// term must be contained in the description or the medication's name or
+// instructions, and since LIKE knows nothing of words, matches are checked
+// against word boundaries, ranked and highlighted in Go.
+type likeSearch struct {
+	contains containsCondition
+}
+
+// likeSearchColumns are the columns a likeSearch matches, with the weight
+// of a match in each.
+var likeSearchColumns = []struct {
+	column string
+	weight float64
+	value  func(reminder *models.Reminder) string
+}{
+	{"r.description", 10, func(r *models.Reminder) string { return derefString(r.Description) }},
+	{"m.name", 5, func(r *models.Reminder) string {
+		if r.Medication == nil {
+			return ""
+		}
+		return r.Medication.Name
+	}},
+	{"m.instructions", 1, func(r *models.Reminder) string {
+		if r.Medication == nil {
+			return ""
+		}
+		return derefString(r.Medication.Instructions)
+	}},
+}
+
+func (s likeSearch) clause(terms []searchTerm, arg int) (string, string, string, []any) {
+	conditions := make([]string, len(terms))
+	args := make([]any, len(terms))
+	for i, term := range terms {
+		matches := make([]string, len(likeSearchColumns))
+		for j, column := range likeSearchColumns {
+			matches[j] = s.contains(column.column, arg+i)
+		}
+		conditions[i] = "(" + strings.Join(matches, " OR ") + ")"
+		args[i] = searchPattern(term.Text)
+	}
+	return "", strings.Join(conditions, " AND "), "NULL, NULL", args
+}
+
+// match ranks the reminder by how often the terms occur in each column, and
+// snippets the first column they occur in. Like FTS5, it only counts terms
+// starting a word, and ending one unless they are prefixes.
+func (likeSearch) match(reminder *models.Reminder, terms []searchTerm, _ sql.NullFloat64, _ sql.NullString) bool {
+	rank, snippet := 0.0, ""
+	matched := make([]bool, len(terms))
+	for _, column := range likeSearchColumns {
+		value := column.value(reminder)
+		var found [][]int
+		for i, term := range terms {
+			words := findWords(value, term)
+			matched[i] = matched[i] || len(words) > 0
+			found = append(found, words...)
+		}
+		rank -= column.weight * float64(len(found))
+		if snippet == "" && len(found) > 0 {
+			slices.SortFunc(found, func(a, b []int) int { return a[0] - b[0] })
+			snippet = markMatches(value, found)
+		}
+	}
+	if slices.Contains(matched, false) {
+		return false
+	}
+
+	reminder.SearchRank = &rank
+	highlighted := highlight(snippet)
+	reminder.Snippet = &highlighted
+	return true
+}
+
+// findWords returns the start and end of each case-insensitive occurrence
+// of the term in value that starts a word, and also ends one unless the
+// term is a prefix. Overlapping occurrences are skipped.
+func findWords(value string, term searchTerm) [][]int {
+	pattern := regexp.MustCompile(`(?i)` + regexp.QuoteMeta(term.Text))
+	var words [][]int
+	last := 0
+	for _, match := range pattern.FindAllStringIndex(value, -1) {
+		if match[0] < last || !isWordBoundary(value, match[0]) {
+			continue
+		}
+		if !term.Prefix && !isWordBoundary(value, match[1]) {
+			continue
+		}
+		words = append(words, match)
+		last = match[1]
+	}
+	return words
+}
+
+// isWordBoundary reports whether a word starts or ends at offset i of value,
+// taking words to be runs of letters and digits as FTS5's unicode61
+// tokenizer does.
+func isWordBoundary(value string, i int) bool {
+	before, _ := utf8.DecodeLastRuneInString(value[:i])
+	after, _ := utf8.DecodeRuneInString(value[i:])
+	return isWordRune(before) != isWordRune(after) || !isWordRune(before)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// markMatches marks the matches found in value, trimmed to the words around
+// the first of them as FTS5 snippets are.
+func markMatches(value string, found [][]int) string {
+	var b strings.Builder
+	last := 0
+	for _, match := range found {
+		b.WriteString(value[last:match[0]])
+		b.WriteString(snippetMatchStart + value[match[0]:match[1]] + snippetMatchEnd)
+		last = match[1]
+	}
+	b.WriteString(value[last:])
+
+	words := strings.Fields(b.String())
+	first := 0
+	for i, word := range words {
+		if strings.Contains(word, snippetMatchStart) {
+			first = max(i-4, 0)
+			break
+		}
+	}
+	end := min(first+12, len(words))
+	snippet := strings.Join(words[first:end], " ")
+	if strings.Count(snippet, snippetMatchStart) > strings.Count(snippet, snippetMatchEnd) {
+		snippet += snippetMatchEnd
+	}
+	if first > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(words) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
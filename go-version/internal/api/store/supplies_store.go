@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"go-version/internal/api/models"
+)
+
+type SupplyStoreInterface interface {
+	GetSupply(ctx context.Context, userID, reminderID string) (*models.ReminderSupply, error)
+	UpsertSupply(ctx context.Context, supply *models.ReminderSupply) (*models.ReminderSupply, error)
+	SetRefillReminder(ctx context.Context, userID, reminderID string, refillReminderID *string) error
+	DeleteSupply(ctx context.Context, userID, reminderID string) error
+	LogDose(ctx context.Context, dose *models.DoseLog) (*models.ReminderSupply, error)
+}
+
+type SupplyStore struct {
+	db *sql.DB
+}
+
+func NewSupplyStore(db *sql.DB) (*SupplyStore, error) {
+	return &SupplyStore{db: db}, nil
+}
+
+const supplyColumns = `reminder_id, user_id, quantity, dose_per_occurrence, refill_threshold_days, refill_reminder_id, updated_at`
+
+func scanSupply(row rowScanner) (*models.ReminderSupply, error) {
+	var supply models.ReminderSupply
+	err := row.Scan(&supply.ReminderId, &supply.UserId, &supply.Quantity, &supply.DosePerOccurrence,
+		&supply.RefillThresholdDays, &supply.RefillReminderId, &supply.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &supply, nil
+}
+
+func (s *SupplyStore) GetSupply(ctx context.Context, userID, reminderID string) (*models.ReminderSupply, error) {
+	query := `SELECT ` + supplyColumns + ` FROM reminder_supplies WHERE reminder_id=$1 AND user_id=$2`
+
+	supply, err := scanSupply(s.db.QueryRowContext(ctx, query, reminderID, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoReminderSupplyFoundError{ReminderID: reminderID}
+		}
+		return nil, err
+	}
+	return supply, nil
+}
+
+// UpsertSupply sets the reminder's supply, as when it is restocked. The
+// refill reminder is left as it is.
+func (s *SupplyStore) UpsertSupply(ctx context.Context, supply *models.ReminderSupply) (*models.ReminderSupply, error) {
+	query := `
+		INSERT INTO reminder_supplies (reminder_id, user_id, quantity, dose_per_occurrence, refill_threshold_days)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (reminder_id) DO UPDATE SET
+			quantity = excluded.quantity,
+			dose_per_occurrence = excluded.dose_per_occurrence,
+			refill_threshold_days = excluded.refill_threshold_days
+		RETURNING ` + supplyColumns
+
+	return scanSupply(s.db.QueryRowContext(ctx, query,
+		supply.ReminderId,
+		supply.UserId,
+		supply.Quantity,
+		supply.DosePerOccurrence,
+		supply.RefillThresholdDays,
+	))
+}
+
+func (s *SupplyStore) SetRefillReminder(ctx context.Context, userID, reminderID string, refillReminderID *string) error {
+	query := `UPDATE reminder_supplies SET refill_reminder_id=$1 WHERE reminder_id=$2 AND user_id=$3`
+	result, err := s.db.ExecContext(ctx, query, refillReminderID, reminderID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return &NoReminderSupplyFoundError{ReminderID: reminderID}
+	}
+	return nil
+}
+
+func (s *SupplyStore) DeleteSupply(ctx context.Context, userID, reminderID string) error {
+	query := `DELETE FROM reminder_supplies WHERE reminder_id=$1 AND user_id=$2`
+	result, err := s.db.ExecContext(ctx, query, reminderID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return &NoReminderSupplyFoundError{ReminderID: reminderID}
+	}
+	return nil
+}
+
+// LogDose records a dose and takes it from the supply, which never goes
+// below zero.
+func (s *SupplyStore) LogDose(ctx context.Context, dose *models.DoseLog) (*models.ReminderSupply, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE reminder_supplies
+		SET quantity = CASE WHEN quantity > $1 THEN quantity - $1 ELSE 0 END
+		WHERE reminder_id=$2 AND user_id=$3
+		RETURNING ` + supplyColumns
+
+	supply, err := scanSupply(tx.QueryRowContext(ctx, query, dose.Quantity, dose.ReminderId, dose.UserId))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoReminderSupplyFoundError{ReminderID: dose.ReminderId}
+		}
+		return nil, err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO dose_logs (id, reminder_id, user_id, quantity, taken_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, dose.Id, dose.ReminderId, dose.UserId, dose.Quantity, dose.TakenAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return supply, nil
+}
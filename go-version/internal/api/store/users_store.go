@@ -10,6 +10,7 @@ import (
 
 type UserStoreInterface interface {
 	GetUser(ctx context.Context, userId string) (*models.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
 	CreateUser(ctx context.Context, user *models.User) (*models.User, error)
 }
 
@@ -36,13 +37,34 @@ func (s *UserStore) GetUser(ctx context.Context, userId string) (*models.User, e
 
 }
 
+// GetUserByEmail finds the user by email, ignoring case as emails are
+// stored normalized.
+func (s *UserStore) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	query := `SELECT id, email, name, password, api_key, created_at, updated_at
+		FROM users
+		WHERE email=$1`
+
+	var user models.User
+	err := s.db.QueryRowContext(ctx, query, models.NormalizeEmail(email)).Scan(&user.Id, &user.Email, &user.Name, &user.Password, &user.ApiKey, &user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &NoUserFoundError{Email: email}
+		}
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// CreateUser stores the user with their email normalized, so that it
+// cannot be registered again in a different case.
 func (s *UserStore) CreateUser(ctx context.Context, user *models.User) (*models.User, error) {
 	var createdUser models.User
 	err := s.db.QueryRowContext(ctx, `
 		INSERT INTO users (id, email, name, password, api_key)
 		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id, email, name, password, api_key`,
-		user.Id, user.Email, user.Name, user.Password, user.ApiKey,
+		user.Id, models.NormalizeEmail(user.Email), user.Name, user.Password, user.ApiKey,
 	).Scan(&createdUser.Id, &createdUser.Email, &createdUser.Name, &createdUser.Password, &createdUser.ApiKey)
 	if err != nil {
 		fmt.Println("Error inserting user:", err)
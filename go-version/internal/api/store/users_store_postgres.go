@@ -0,0 +1,14 @@
+package store
+
+import "database/sql"
+
+// PostgresUserStore is the UserStoreInterface for PostgreSQL. The UserStore
+// queries are portable, so it adds nothing to them yet; it exists so that
+// queries needing a PostgreSQL form have somewhere to go.
+type PostgresUserStore struct {
+	*UserStore
+}
+
+func NewPostgresUserStore(db *sql.DB) (*PostgresUserStore, error) {
+	return &PostgresUserStore{UserStore: &UserStore{db: db}}, nil
+}
@@ -0,0 +1,127 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// maxAgendaDays bounds the range of an agenda.
+	maxAgendaDays = 366
+
+	// DefaultAgendaLimit and MaxAgendaLimit are the default and largest
+	// number of occurrences in a page of the agenda.
+	DefaultAgendaLimit = 100
+	MaxAgendaLimit     = 1000
+)
+
+type AgendaListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoURLParams
+
+	// Query Params
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+	// TimeZone is the IANA time zone the agenda's days are in.
+	TimeZone *string `json:"time_zone"`
+	// IncludeShared adds the reminders shared with the user as a caregiver.
+	IncludeShared *string `json:"include_shared"`
+	// Limit caps the page size, and Cursor is the next_cursor of the
+	// previous page.
+	Limit  *string `json:"limit"`
+	Cursor *string `json:"cursor"`
+}
+
+func (r *AgendaListRequest) ParseFromQuery(values url.Values) error {
+	startDate := values.Get("start_date")
+	endDate := values.Get("end_date")
+
+	if startDate != "" {
+		r.StartDate = &startDate
+	}
+	if endDate != "" {
+		r.EndDate = &endDate
+	}
+	if timeZone := values.Get("time_zone"); timeZone != "" {
+		r.TimeZone = &timeZone
+	}
+	if values.Has("include_shared") {
+		includeShared := values.Get("include_shared")
+		r.IncludeShared = &includeShared
+	}
+	if values.Has("limit") {
+		limit := values.Get("limit")
+		r.Limit = &limit
+	}
+	if cursor := values.Get("cursor"); cursor != "" {
+		r.Cursor = &cursor
+	}
+	return nil
+}
+
+func (r *AgendaListRequest) Validate() error {
+	var errors []error
+	if r.StartDate == nil {
+		errors = append(errors, &ErrStartDateRequired{})
+	}
+	if r.EndDate == nil {
+		errors = append(errors, &ErrEndDateRequired{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+
+	startDate, startErr := utils.ParseDateTime(*r.StartDate)
+	endDate, endErr := utils.ParseDateTime(*r.EndDate)
+	if startErr != nil || endErr != nil {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	} else if !endDate.After(startDate) || endDate.Sub(startDate).Hours() > maxAgendaDays*24 {
+		errors = append(errors, &ErrInvalidDateRange{MaxDays: maxAgendaDays})
+	}
+	if r.TimeZone != nil && !utils.IsValidTimeZone(*r.TimeZone) {
+		errors = append(errors, &ErrInvalidTimeZone{})
+	}
+	if r.IncludeShared != nil {
+		if _, err := strconv.ParseBool(*r.IncludeShared); err != nil {
+			errors = append(errors, &ErrInvalidIncludeShared{})
+		}
+	}
+	if r.Limit != nil {
+		if limit, err := strconv.Atoi(*r.Limit); err != nil || limit < 1 || limit > MaxAgendaLimit {
+			errors = append(errors, &ErrInvalidLimit{Max: MaxAgendaLimit})
+		}
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *AgendaListRequest) ToDomain() *domain.AgendaListDomain {
+	startDate, _ := utils.ParseDateTime(*r.StartDate)
+	endDate, _ := utils.ParseDateTime(*r.EndDate)
+	timeZone := ""
+	if r.TimeZone != nil {
+		timeZone = *r.TimeZone
+	}
+	includeShared := false
+	if r.IncludeShared != nil {
+		includeShared, _ = strconv.ParseBool(*r.IncludeShared)
+	}
+	limit := DefaultAgendaLimit
+	if r.Limit != nil {
+		limit, _ = strconv.Atoi(*r.Limit)
+	}
+	return &domain.AgendaListDomain{
+		UserID:        r.UserID,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		TimeZone:      timeZone,
+		IncludeShared: includeShared,
+		Limit:         limit,
+		Cursor:        r.Cursor,
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type CalendarFeedCreateRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *CalendarFeedCreateRequest) Validate() error {
+	return nil
+}
+
+func (r *CalendarFeedCreateRequest) ToDomain() *domain.CalendarFeedCreateDomain {
+	return &domain.CalendarFeedCreateDomain{
+		UserID: r.UserID,
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type CalendarFeedDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *CalendarFeedDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *CalendarFeedDeleteRequest) ToDomain() *domain.CalendarFeedDeleteDomain {
+	return &domain.CalendarFeedDeleteDomain{
+		UserID: r.UserID,
+	}
+}
@@ -0,0 +1,38 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// CalendarFeedGetRequest is authenticated by the feed token in the URL, as
+// calendar clients cannot send an Authorization header.
+type CalendarFeedGetRequest struct {
+	NoContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	FeedToken string `json:"-" db:"-"`
+}
+
+func (r *CalendarFeedGetRequest) ParseFromURLParams(req *http.Request) error {
+	r.FeedToken = chi.URLParam(req, "feedToken")
+	return nil
+}
+
+func (r *CalendarFeedGetRequest) Validate() error {
+	if r.FeedToken == "" {
+		return &ErrBadRequest{Errs: []error{&ErrFeedTokenRequired{}}}
+	}
+	return nil
+}
+
+func (r *CalendarFeedGetRequest) ToDomain() *domain.CalendarFeedGetDomain {
+	return &domain.CalendarFeedGetDomain{
+		FeedToken: r.FeedToken,
+	}
+}
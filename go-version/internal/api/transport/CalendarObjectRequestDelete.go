@@ -0,0 +1,44 @@
+package transport
+
+import (
+	"net/http"
+	"strings"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CalendarObjectDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Headers
+	IfMatch string `json:"-" db:"-"`
+}
+
+func (r *CalendarObjectDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *CalendarObjectDeleteRequest) ParseFromHeaders(header http.Header) error {
+	r.IfMatch = strings.TrimSpace(header.Get("If-Match"))
+	return nil
+}
+
+func (r *CalendarObjectDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *CalendarObjectDeleteRequest) ToDomain() *domain.CalendarObjectDeleteDomain {
+	return &domain.CalendarObjectDeleteDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+		IfMatch:    r.IfMatch,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CalendarObjectGetRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+}
+
+func (r *CalendarObjectGetRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *CalendarObjectGetRequest) Validate() error {
+	return nil
+}
+
+func (r *CalendarObjectGetRequest) ToDomain() *domain.CalendarObjectGetDomain {
+	return &domain.CalendarObjectGetDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+	}
+}
@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"net/http"
+	"strings"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/ical"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CalendarObjectPutRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Headers
+	IfMatch     string `json:"-" db:"-"`
+	IfNoneMatch string `json:"-" db:"-"`
+
+	// Request Body
+	Entries []ical.Entry `json:"-"`
+	hasBody bool
+	bodyErr error
+}
+
+func (r *CalendarObjectPutRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *CalendarObjectPutRequest) ParseFromHeaders(header http.Header) error {
+	r.IfMatch = strings.TrimSpace(header.Get("If-Match"))
+	r.IfNoneMatch = strings.TrimSpace(header.Get("If-None-Match"))
+	return nil
+}
+
+func (r *CalendarObjectPutRequest) BodyMediaTypes() []string {
+	return []string{"text/calendar"}
+}
+
+func (r *CalendarObjectPutRequest) ParseFromBody(req *http.Request) error {
+	r.hasBody = true
+	r.Entries, r.bodyErr = parseCalendarBody(req)
+	return r.bodyErr
+}
+
+func (r *CalendarObjectPutRequest) Validate() error {
+	var errors []error
+	if !r.hasBody {
+		errors = append(errors, &ErrCalendarRequired{})
+	} else if r.bodyErr != nil {
+		errors = append(errors, r.bodyErr)
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *CalendarObjectPutRequest) ToDomain() *domain.CalendarObjectPutDomain {
+	return &domain.CalendarObjectPutDomain{
+		UserID:      r.UserID,
+		ReminderID:  r.ReminderID,
+		Entries:     calendarEntriesToDomain(r.Entries),
+		IfMatch:     r.IfMatch,
+		IfNoneMatch: r.IfNoneMatch,
+	}
+}
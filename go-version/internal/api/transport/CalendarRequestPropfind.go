@@ -0,0 +1,74 @@
+package transport
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/caldav"
+)
+
+// maxDAVBodySize bounds PROPFIND and REPORT bodies, which only name
+// properties and resources.
+const maxDAVBodySize = 1 << 20
+
+type CalendarPropfindRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Headers
+	Depth string `json:"-" db:"-"`
+
+	// Request Body
+	Props   []xml.Name `json:"-"`
+	bodyErr error
+}
+
+func (r *CalendarPropfindRequest) ParseFromHeaders(header http.Header) error {
+	r.Depth = header.Get("Depth")
+	return nil
+}
+
+func (r *CalendarPropfindRequest) BodyMediaTypes() []string {
+	return []string{"application/xml", "text/xml"}
+}
+
+func (r *CalendarPropfindRequest) ParseFromBody(req *http.Request) error {
+	propfind, err := caldav.ParsePropfind(io.LimitReader(req.Body, maxDAVBodySize))
+	if err != nil {
+		r.bodyErr = err
+		return err
+	}
+	r.Props = propfind.Props
+	return nil
+}
+
+func (r *CalendarPropfindRequest) Validate() error {
+	var errors []error
+	if r.bodyErr != nil {
+		errors = append(errors, r.bodyErr)
+	}
+	if r.Depth != "" && r.Depth != "0" && r.Depth != "1" && r.Depth != "infinity" {
+		errors = append(errors, &ErrInvalidDepth{})
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+// IncludeChildren reports whether the members of a collection are listed
+// too. Infinite depth is treated as depth 1, which covers every resource
+// below a calendar collection.
+func (r *CalendarPropfindRequest) IncludeChildren() bool {
+	return r.Depth != "0"
+}
+
+func (r *CalendarPropfindRequest) ToDomain() *domain.CalendarObjectListDomain {
+	return &domain.CalendarObjectListDomain{
+		UserID: r.UserID,
+	}
+}
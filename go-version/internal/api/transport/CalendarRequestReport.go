@@ -0,0 +1,71 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/caldav"
+)
+
+type CalendarReportRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	Report  *caldav.Report `json:"-"`
+	bodyErr error
+}
+
+func (r *CalendarReportRequest) BodyMediaTypes() []string {
+	return []string{"application/xml", "text/xml"}
+}
+
+func (r *CalendarReportRequest) ParseFromBody(req *http.Request) error {
+	r.Report, r.bodyErr = caldav.ParseReport(io.LimitReader(req.Body, maxDAVBodySize))
+	return r.bodyErr
+}
+
+func (r *CalendarReportRequest) Validate() error {
+	var errors []error
+	if r.bodyErr != nil {
+		errors = append(errors, r.bodyErr)
+	} else if r.Report == nil {
+		errors = append(errors, &ErrReportRequired{})
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *CalendarReportRequest) ToDomain() *domain.CalendarObjectListDomain {
+	list := &domain.CalendarObjectListDomain{
+		UserID: r.UserID,
+	}
+
+	if r.Report.Kind == caldav.ReportCalendarMultiget {
+		list.ReminderIDs = make([]string, 0, len(r.Report.Hrefs))
+		for _, href := range r.Report.Hrefs {
+			list.ReminderIDs = append(list.ReminderIDs, CalendarObjectName(href))
+		}
+	}
+	if !r.Report.Start.IsZero() {
+		list.Start = &r.Report.Start
+	}
+	if !r.Report.End.IsZero() {
+		list.End = &r.Report.End
+	}
+
+	return list
+}
+
+// CalendarObjectName returns the reminder id named by a calendar object
+// href.
+func CalendarObjectName(href string) string {
+	return strings.TrimSuffix(path.Base(href), ".ics")
+}
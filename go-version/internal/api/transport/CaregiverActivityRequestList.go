@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type CaregiverActivityListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *CaregiverActivityListRequest) Validate() error {
+	return nil
+}
+
+func (r *CaregiverActivityListRequest) ToDomain() *domain.CaregiverActivityListDomain {
+	return &domain.CaregiverActivityListDomain{
+		UserID: r.UserID,
+	}
+}
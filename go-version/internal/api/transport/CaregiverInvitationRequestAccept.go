@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CaregiverInvitationAcceptRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	GrantID string `json:"-" db:"-"`
+}
+
+func (r *CaregiverInvitationAcceptRequest) ParseFromURLParams(req *http.Request) error {
+	r.GrantID = chi.URLParam(req, "grantId")
+	return nil
+}
+
+func (r *CaregiverInvitationAcceptRequest) Validate() error {
+	return nil
+}
+
+func (r *CaregiverInvitationAcceptRequest) ToDomain() *domain.CaregiverInvitationAcceptDomain {
+	return &domain.CaregiverInvitationAcceptDomain{
+		UserID:  r.UserID,
+		GrantID: r.GrantID,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CaregiverInvitationDeclineRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	GrantID string `json:"-" db:"-"`
+}
+
+func (r *CaregiverInvitationDeclineRequest) ParseFromURLParams(req *http.Request) error {
+	r.GrantID = chi.URLParam(req, "grantId")
+	return nil
+}
+
+func (r *CaregiverInvitationDeclineRequest) Validate() error {
+	return nil
+}
+
+func (r *CaregiverInvitationDeclineRequest) ToDomain() *domain.CaregiverInvitationDeclineDomain {
+	return &domain.CaregiverInvitationDeclineDomain{
+		UserID:  r.UserID,
+		GrantID: r.GrantID,
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type CaregiverInvitationListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *CaregiverInvitationListRequest) Validate() error {
+	return nil
+}
+
+func (r *CaregiverInvitationListRequest) ToDomain() *domain.CaregiverInvitationListDomain {
+	return &domain.CaregiverInvitationListDomain{
+		UserID: r.UserID,
+	}
+}
@@ -0,0 +1,60 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"net/http"
+	"net/mail"
+)
+
+type CaregiverInviteRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	Email      *string `json:"email"`
+	Permission *string `json:"permission"`
+	// ReminderIDs limits the grant to these reminders. Every reminder is
+	// shared when it is left out.
+	ReminderIDs []string `json:"reminder_ids"`
+}
+
+func (r *CaregiverInviteRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *CaregiverInviteRequest) Validate() error {
+	var errors []error
+	if r.Email == nil || *r.Email == "" {
+		errors = append(errors, &ErrEmailRequired{})
+	} else if _, err := mail.ParseAddress(*r.Email); err != nil {
+		errors = append(errors, &ErrInvalidCaregiverInvitation{Reason: "email is not a valid address"})
+	}
+	if r.Permission == nil || !models.IsValidCaregiverPermission(*r.Permission) {
+		errors = append(errors, &ErrInvalidCaregiverInvitation{Reason: "permission must be view or manage"})
+	}
+	if r.ReminderIDs != nil && len(r.ReminderIDs) == 0 {
+		errors = append(errors, &ErrInvalidCaregiverInvitation{Reason: "reminder_ids must not be empty"})
+	}
+	for _, reminderID := range r.ReminderIDs {
+		if reminderID == "" {
+			errors = append(errors, &ErrInvalidCaregiverInvitation{Reason: "reminder_ids must not contain empty ids"})
+			break
+		}
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *CaregiverInviteRequest) ToDomain() *domain.CaregiverInviteDomain {
+	return &domain.CaregiverInviteDomain{
+		UserID:      r.UserID,
+		Email:       models.NormalizeEmail(*r.Email),
+		Permission:  *r.Permission,
+		ReminderIDs: r.ReminderIDs,
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type CaregiverListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *CaregiverListRequest) Validate() error {
+	return nil
+}
+
+func (r *CaregiverListRequest) ToDomain() *domain.CaregiverListDomain {
+	return &domain.CaregiverListDomain{
+		UserID: r.UserID,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CaregiverRevokeRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	GrantID string `json:"-" db:"-"`
+}
+
+func (r *CaregiverRevokeRequest) ParseFromURLParams(req *http.Request) error {
+	r.GrantID = chi.URLParam(req, "grantId")
+	return nil
+}
+
+func (r *CaregiverRevokeRequest) Validate() error {
+	return nil
+}
+
+func (r *CaregiverRevokeRequest) ToDomain() *domain.CaregiverRevokeDomain {
+	return &domain.CaregiverRevokeDomain{
+		UserID:  r.UserID,
+		GrantID: r.GrantID,
+	}
+}
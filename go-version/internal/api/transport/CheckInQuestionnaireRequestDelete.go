@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CheckInQuestionnaireDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+}
+
+func (r *CheckInQuestionnaireDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *CheckInQuestionnaireDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *CheckInQuestionnaireDeleteRequest) ToDomain() *domain.CheckInQuestionnaireDeleteDomain {
+	return &domain.CheckInQuestionnaireDeleteDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+	}
+}
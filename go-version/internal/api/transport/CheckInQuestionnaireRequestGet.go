@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CheckInQuestionnaireGetRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+}
+
+func (r *CheckInQuestionnaireGetRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *CheckInQuestionnaireGetRequest) Validate() error {
+	return nil
+}
+
+func (r *CheckInQuestionnaireGetRequest) ToDomain() *domain.CheckInQuestionnaireGetDomain {
+	return &domain.CheckInQuestionnaireGetDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+	}
+}
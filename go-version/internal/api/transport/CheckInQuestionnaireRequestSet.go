@@ -0,0 +1,145 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"math"
+	"net/http"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	maxCheckInQuestions    = 20
+	maxCheckInPromptLength = 500
+	maxCheckInUnitLength   = 20
+)
+
+var checkInQuestionKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+type CheckInQuestionRequest struct {
+	Key      *string  `json:"key"`
+	Type     *string  `json:"type"`
+	Prompt   *string  `json:"prompt"`
+	Required bool     `json:"required"`
+	Min      *float64 `json:"min"`
+	Max      *float64 `json:"max"`
+	Unit     *string  `json:"unit"`
+}
+
+type CheckInQuestionnaireSetRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Request Body
+	Questions []CheckInQuestionRequest `json:"questions"`
+}
+
+func (r *CheckInQuestionnaireSetRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *CheckInQuestionnaireSetRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *CheckInQuestionnaireSetRequest) Validate() error {
+	var errors []error
+	if len(r.Questions) == 0 {
+		errors = append(errors, &ErrCheckInQuestionsRequired{})
+	} else if len(r.Questions) > maxCheckInQuestions {
+		errors = append(errors, &ErrTooManyCheckInQuestions{Max: maxCheckInQuestions})
+	}
+
+	keys := make(map[string]bool, len(r.Questions))
+	for i, question := range r.Questions {
+		if question.Key == nil || !checkInQuestionKeyPattern.MatchString(*question.Key) {
+			errors = append(errors, &ErrInvalidCheckInQuestion{Index: i, Reason: "key must be lowercase letters, digits and underscores, starting with a letter"})
+		} else if keys[*question.Key] {
+			errors = append(errors, &ErrInvalidCheckInQuestion{Index: i, Reason: "key " + *question.Key + " is used more than once"})
+		} else {
+			keys[*question.Key] = true
+		}
+		if question.Prompt == nil || *question.Prompt == "" || utf8.RuneCountInString(*question.Prompt) > maxCheckInPromptLength {
+			errors = append(errors, &ErrInvalidCheckInQuestion{Index: i, Reason: "prompt must be between 1 and 500 characters"})
+		}
+		if question.Type == nil || !models.IsValidCheckInQuestionType(*question.Type) {
+			errors = append(errors, &ErrInvalidCheckInQuestion{Index: i, Reason: "type must be one of: scale, yes_no, text, number"})
+			continue
+		}
+		for _, reason := range question.boundsErrors() {
+			errors = append(errors, &ErrInvalidCheckInQuestion{Index: i, Reason: reason})
+		}
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+// boundsErrors checks min, max and unit, which only some types of question
+// take.
+func (q *CheckInQuestionRequest) boundsErrors() []string {
+	var reasons []string
+	switch *q.Type {
+	case models.CheckInQuestionScale:
+		if (q.Min != nil && *q.Min != math.Trunc(*q.Min)) || (q.Max != nil && *q.Max != math.Trunc(*q.Max)) {
+			reasons = append(reasons, "min and max of a scale must be whole numbers")
+		}
+		lowest, highest := float64(models.DefaultCheckInScaleMin), float64(models.DefaultCheckInScaleMax)
+		if q.Min != nil {
+			lowest = *q.Min
+		}
+		if q.Max != nil {
+			highest = *q.Max
+		}
+		if lowest >= highest {
+			reasons = append(reasons, "min of a scale must be below its max")
+		}
+	case models.CheckInQuestionNumber:
+		if q.Min != nil && q.Max != nil && *q.Min > *q.Max {
+			reasons = append(reasons, "min must not be above max")
+		}
+	default:
+		if q.Min != nil || q.Max != nil {
+			reasons = append(reasons, "min and max are only allowed for scale and number questions")
+		}
+	}
+
+	if q.Unit != nil {
+		if *q.Type != models.CheckInQuestionNumber {
+			reasons = append(reasons, "unit is only allowed for number questions")
+		} else if *q.Unit == "" || utf8.RuneCountInString(*q.Unit) > maxCheckInUnitLength {
+			reasons = append(reasons, "unit must be between 1 and 20 characters")
+		}
+	}
+	return reasons
+}
+
+func (r *CheckInQuestionnaireSetRequest) ToDomain() *domain.CheckInQuestionnaireSetDomain {
+	questions := make([]domain.CheckInQuestionDomain, len(r.Questions))
+	for i, question := range r.Questions {
+		questions[i] = domain.CheckInQuestionDomain{
+			Key:      *question.Key,
+			Type:     *question.Type,
+			Prompt:   *question.Prompt,
+			Required: question.Required,
+			Min:      question.Min,
+			Max:      question.Max,
+			Unit:     question.Unit,
+		}
+	}
+	return &domain.CheckInQuestionnaireSetDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+		Questions:  questions,
+	}
+}
@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CheckInResponseListRequest struct {
+	UserIDContext
+	NoRequestBody
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Query Params
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+}
+
+func (r *CheckInResponseListRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *CheckInResponseListRequest) ParseFromQuery(values url.Values) error {
+	startDate := values.Get("start_date")
+	endDate := values.Get("end_date")
+
+	if startDate != "" {
+		r.StartDate = &startDate
+	}
+	if endDate != "" {
+		r.EndDate = &endDate
+	}
+	return nil
+}
+
+func (r *CheckInResponseListRequest) Validate() error {
+	var errors []error
+	if (r.StartDate != nil && !utils.IsValidDateTime(*r.StartDate)) || (r.EndDate != nil && !utils.IsValidDateTime(*r.EndDate)) {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *CheckInResponseListRequest) ToDomain() *domain.CheckInResponseListDomain {
+	var startDate, endDate *time.Time
+	if r.StartDate != nil {
+		sd, _ := utils.ParseDateTime(*r.StartDate)
+		startDate = &sd
+	}
+	if r.EndDate != nil {
+		ed, _ := utils.ParseDateTime(*r.EndDate)
+		endDate = &ed
+	}
+	return &domain.CheckInResponseListDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+		StartDate:  startDate,
+		EndDate:    endDate,
+	}
+}
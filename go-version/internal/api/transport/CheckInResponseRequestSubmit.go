@@ -0,0 +1,59 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type CheckInResponseSubmitRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Request Body
+	OccurrenceAt *string `json:"occurrence_at"`
+	// Answers maps question keys to answers. They are checked against the
+	// questionnaire by the repository.
+	Answers map[string]any `json:"answers"`
+}
+
+func (r *CheckInResponseSubmitRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *CheckInResponseSubmitRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *CheckInResponseSubmitRequest) Validate() error {
+	var errors []error
+	if r.OccurrenceAt == nil || *r.OccurrenceAt == "" {
+		errors = append(errors, &ErrOccurrenceAtRequired{})
+	} else if !utils.IsValidDateTime(*r.OccurrenceAt) {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	}
+	if r.Answers == nil {
+		errors = append(errors, &ErrCheckInAnswersRequired{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *CheckInResponseSubmitRequest) ToDomain() *domain.CheckInResponseSubmitDomain {
+	occurrenceAt, _ := utils.ParseDateTime(*r.OccurrenceAt)
+	return &domain.CheckInResponseSubmitDomain{
+		UserID:       r.UserID,
+		ReminderID:   r.ReminderID,
+		OccurrenceAt: occurrenceAt,
+		Answers:      r.Answers,
+	}
+}
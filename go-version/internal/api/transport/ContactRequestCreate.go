@@ -0,0 +1,46 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+)
+
+type ContactCreateRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	Name  *string `json:"name"`
+	Email *string `json:"email"`
+}
+
+func (r *ContactCreateRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *ContactCreateRequest) Validate() error {
+	var errors []error
+	if r.Name == nil || *r.Name == "" {
+		errors = append(errors, &ErrNameRequired{})
+	}
+	if r.Email == nil || *r.Email == "" {
+		errors = append(errors, &ErrEmailRequired{})
+	} else if !utils.IsValidEmail(*r.Email) {
+		errors = append(errors, &ErrInvalidEmail{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *ContactCreateRequest) ToDomain() *domain.ContactCreateDomain {
+	return &domain.ContactCreateDomain{
+		UserID: r.UserID,
+		Name:   *r.Name,
+		Email:  *r.Email,
+	}
+}
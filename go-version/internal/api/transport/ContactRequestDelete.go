@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ContactDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ContactID string `json:"-" db:"-"`
+}
+
+func (r *ContactDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.ContactID = chi.URLParam(req, "contactId")
+	return nil
+}
+
+func (r *ContactDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *ContactDeleteRequest) ToDomain() *domain.ContactDeleteDomain {
+	return &domain.ContactDeleteDomain{
+		UserID:    r.UserID,
+		ContactID: r.ContactID,
+	}
+}
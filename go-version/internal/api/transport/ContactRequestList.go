@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type ContactListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *ContactListRequest) Validate() error {
+	return nil
+}
+
+func (r *ContactListRequest) ToDomain() *domain.ContactListDomain {
+	return &domain.ContactListDomain{
+		UserID: r.UserID,
+	}
+}
@@ -0,0 +1,48 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/push"
+	"net/http"
+)
+
+type DeviceCreateRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	Token      *string `json:"token"`
+	Platform   *string `json:"platform"`
+	AppVersion *string `json:"app_version"`
+}
+
+func (r *DeviceCreateRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *DeviceCreateRequest) Validate() error {
+	var errors []error
+	if r.Token == nil || *r.Token == "" {
+		errors = append(errors, &ErrDeviceTokenRequired{})
+	}
+	if r.Platform == nil || *r.Platform == "" {
+		errors = append(errors, &ErrPlatformRequired{})
+	} else if !push.IsValidPlatform(*r.Platform) {
+		errors = append(errors, &ErrInvalidPlatform{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *DeviceCreateRequest) ToDomain() *domain.DeviceCreateDomain {
+	return &domain.DeviceCreateDomain{
+		UserID:     r.UserID,
+		Platform:   *r.Platform,
+		Token:      *r.Token,
+		AppVersion: r.AppVersion,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type DeviceDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	DeviceID string `json:"-" db:"-"`
+}
+
+func (r *DeviceDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.DeviceID = chi.URLParam(req, "deviceId")
+	return nil
+}
+
+func (r *DeviceDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *DeviceDeleteRequest) ToDomain() *domain.DeviceDeleteDomain {
+	return &domain.DeviceDeleteDomain{
+		UserID:   r.UserID,
+		DeviceID: r.DeviceID,
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type DeviceListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *DeviceListRequest) Validate() error {
+	return nil
+}
+
+func (r *DeviceListRequest) ToDomain() *domain.DeviceListDomain {
+	return &domain.DeviceListDomain{
+		UserID: r.UserID,
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type DigestSubscriptionDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *DigestSubscriptionDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *DigestSubscriptionDeleteRequest) ToDomain() *domain.DigestSubscriptionDeleteDomain {
+	return &domain.DigestSubscriptionDeleteDomain{
+		UserID: r.UserID,
+	}
+}
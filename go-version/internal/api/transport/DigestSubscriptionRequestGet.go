@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type DigestSubscriptionGetRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *DigestSubscriptionGetRequest) Validate() error {
+	return nil
+}
+
+func (r *DigestSubscriptionGetRequest) ToDomain() *domain.DigestSubscriptionGetDomain {
+	return &domain.DigestSubscriptionGetDomain{
+		UserID: r.UserID,
+	}
+}
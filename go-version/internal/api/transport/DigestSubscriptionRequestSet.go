@@ -0,0 +1,86 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"go-version/internal/api/utils"
+	"net/http"
+)
+
+// defaultDigestWeekday is used for weekly digests when no weekday is given.
+const defaultDigestWeekday = "monday"
+
+type DigestSubscriptionSetRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	Frequency *string `json:"frequency"`
+	SendTime  *string `json:"send_time"`
+	Weekday   *string `json:"weekday"`
+	TimeZone  *string `json:"time_zone"`
+	Channel   *string `json:"channel"`
+}
+
+func (r *DigestSubscriptionSetRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *DigestSubscriptionSetRequest) Validate() error {
+	var errors []error
+	if r.Frequency == nil || *r.Frequency == "" {
+		errors = append(errors, &ErrFrequencyRequired{})
+	} else if !models.IsValidDigestFrequency(*r.Frequency) {
+		errors = append(errors, &ErrInvalidFrequency{})
+	}
+
+	if r.SendTime == nil || *r.SendTime == "" {
+		errors = append(errors, &ErrSendTimeRequired{})
+	} else if !utils.IsValidClockTime(*r.SendTime) {
+		errors = append(errors, &ErrInvalidClockTime{Field: "send_time"})
+	}
+
+	if r.Weekday != nil {
+		if _, ok := models.ParseWeekday(*r.Weekday); !ok {
+			errors = append(errors, &ErrInvalidWeekday{})
+		}
+	}
+
+	if r.TimeZone == nil || *r.TimeZone == "" {
+		errors = append(errors, &ErrTimeZoneRequired{})
+	} else if !utils.IsValidTimeZone(*r.TimeZone) {
+		errors = append(errors, &ErrInvalidTimeZone{})
+	}
+
+	if r.Channel == nil || *r.Channel == "" {
+		errors = append(errors, &ErrChannelRequired{})
+	} else if !models.IsValidChannel(*r.Channel) {
+		errors = append(errors, &ErrInvalidChannel{})
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *DigestSubscriptionSetRequest) ToDomain() *domain.DigestSubscriptionSetDomain {
+	weekday := ""
+	if *r.Frequency == models.DigestFrequencyWeekly {
+		weekday = defaultDigestWeekday
+		if r.Weekday != nil {
+			weekday = *r.Weekday
+		}
+	}
+
+	return &domain.DigestSubscriptionSetDomain{
+		UserID:    r.UserID,
+		Frequency: *r.Frequency,
+		SendTime:  *r.SendTime,
+		Weekday:   weekday,
+		TimeZone:  *r.TimeZone,
+		Channel:   *r.Channel,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type EnrollmentConsentRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	EnrollmentID string `json:"-" db:"-"`
+}
+
+func (r *EnrollmentConsentRequest) ParseFromURLParams(req *http.Request) error {
+	r.EnrollmentID = chi.URLParam(req, "enrollmentId")
+	return nil
+}
+
+func (r *EnrollmentConsentRequest) Validate() error {
+	return nil
+}
+
+func (r *EnrollmentConsentRequest) ToDomain() *domain.EnrollmentConsentDomain {
+	return &domain.EnrollmentConsentDomain{
+		UserID:       r.UserID,
+		EnrollmentID: r.EnrollmentID,
+	}
+}
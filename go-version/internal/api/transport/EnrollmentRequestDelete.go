@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type EnrollmentDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	EnrollmentID string `json:"-" db:"-"`
+}
+
+func (r *EnrollmentDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.EnrollmentID = chi.URLParam(req, "enrollmentId")
+	return nil
+}
+
+func (r *EnrollmentDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *EnrollmentDeleteRequest) ToDomain() *domain.EnrollmentDeleteDomain {
+	return &domain.EnrollmentDeleteDomain{
+		UserID:       r.UserID,
+		EnrollmentID: r.EnrollmentID,
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type EnrollmentListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *EnrollmentListRequest) Validate() error {
+	return nil
+}
+
+func (r *EnrollmentListRequest) ToDomain() *domain.EnrollmentListDomain {
+	return &domain.EnrollmentListDomain{
+		UserID: r.UserID,
+	}
+}
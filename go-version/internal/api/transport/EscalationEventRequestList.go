@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type EscalationEventListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+}
+
+func (r *EscalationEventListRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *EscalationEventListRequest) Validate() error {
+	return nil
+}
+
+func (r *EscalationEventListRequest) ToDomain() *domain.EscalationEventListDomain {
+	return &domain.EscalationEventListDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type EscalationPolicyDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+}
+
+func (r *EscalationPolicyDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *EscalationPolicyDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *EscalationPolicyDeleteRequest) ToDomain() *domain.EscalationPolicyDeleteDomain {
+	return &domain.EscalationPolicyDeleteDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type EscalationPolicyGetRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+}
+
+func (r *EscalationPolicyGetRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *EscalationPolicyGetRequest) Validate() error {
+	return nil
+}
+
+func (r *EscalationPolicyGetRequest) ToDomain() *domain.EscalationPolicyGetDomain {
+	return &domain.EscalationPolicyGetDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+	}
+}
@@ -0,0 +1,83 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	maxEscalationSteps        = 10
+	maxEscalationDelayMinutes = 7 * 24 * 60
+)
+
+type EscalationStepRequest struct {
+	DelayMinutes *int    `json:"delay_minutes"`
+	ContactID    *string `json:"contact_id"`
+	Channel      *string `json:"channel"`
+}
+
+type EscalationPolicySetRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Request Body
+	Steps []EscalationStepRequest `json:"steps"`
+}
+
+func (r *EscalationPolicySetRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *EscalationPolicySetRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *EscalationPolicySetRequest) Validate() error {
+	var errors []error
+	if len(r.Steps) == 0 {
+		errors = append(errors, &ErrEscalationStepsRequired{})
+	} else if len(r.Steps) > maxEscalationSteps {
+		errors = append(errors, &ErrTooManyEscalationSteps{Max: maxEscalationSteps})
+	}
+
+	for i, step := range r.Steps {
+		if step.DelayMinutes == nil || *step.DelayMinutes < 1 || *step.DelayMinutes > maxEscalationDelayMinutes {
+			errors = append(errors, &ErrInvalidEscalationStep{Index: i, Reason: "delay_minutes must be between 1 and 10080"})
+		}
+		if step.ContactID == nil || *step.ContactID == "" {
+			errors = append(errors, &ErrInvalidEscalationStep{Index: i, Reason: "contact_id is required"})
+		}
+		if step.Channel == nil || !models.IsValidChannel(*step.Channel) {
+			errors = append(errors, &ErrInvalidEscalationStep{Index: i, Reason: "channel must be one of: web_push, mobile_push"})
+		}
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *EscalationPolicySetRequest) ToDomain() *domain.EscalationPolicySetDomain {
+	steps := make([]domain.EscalationStepDomain, len(r.Steps))
+	for i, step := range r.Steps {
+		steps[i] = domain.EscalationStepDomain{
+			DelayMinutes: *step.DelayMinutes,
+			ContactID:    *step.ContactID,
+			Channel:      *step.Channel,
+		}
+	}
+	return &domain.EscalationPolicySetDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+		Steps:      steps,
+	}
+}
@@ -0,0 +1,118 @@
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/fhir"
+)
+
+// maxFHIRBodySize is the largest FHIR resource accepted in a request body.
+const maxFHIRBodySize = 2 << 20
+
+// FHIRMedicationCreateRequest accepts a single MedicationRequest, or
+// a batch Bundle of them.
+type FHIRMedicationCreateRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	Entries []domain.FHIRMedicationEntryDomain `json:"-"`
+	hasBody bool
+	bodyErr error
+}
+
+func (r *FHIRMedicationCreateRequest) BodyMediaTypes() []string {
+	return []string{fhir.MediaType, "application/json"}
+}
+
+func (r *FHIRMedicationCreateRequest) ParseFromBody(req *http.Request) error {
+	r.hasBody = true
+	r.Entries, r.bodyErr = parseFHIRBody(req)
+	return r.bodyErr
+}
+
+func (r *FHIRMedicationCreateRequest) Validate() error {
+	if !r.hasBody {
+		return &ErrBadRequest{Errs: []error{&ErrFHIRResourceRequired{}}}
+	}
+	if r.bodyErr != nil {
+		return &ErrBadRequest{Errs: []error{r.bodyErr}}
+	}
+	return nil
+}
+
+func (r *FHIRMedicationCreateRequest) ToDomain() *domain.FHIRMedicationCreateDomain {
+	return &domain.FHIRMedicationCreateDomain{
+		UserID:  r.UserID,
+		Entries: r.Entries,
+	}
+}
+
+type fhirResourceHeader struct {
+	ResourceType string `json:"resourceType"`
+}
+
+// parseFHIRBody reads the MedicationRequests of a request body. Bundle
+// entries that cannot be read are returned with a ParseError so they can
+// be reported alongside the others.
+func parseFHIRBody(req *http.Request) ([]domain.FHIRMedicationEntryDomain, error) {
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxFHIRBodySize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxFHIRBodySize {
+		return nil, &ErrFHIRResourceTooLarge{Max: maxFHIRBodySize}
+	}
+
+	var header fhirResourceHeader
+	if err := json.Unmarshal(body, &header); err != nil {
+		return nil, &ErrInvalidFHIRResource{Reason: err.Error()}
+	}
+
+	switch header.ResourceType {
+	case fhir.ResourceTypeMedicationRequest:
+		entry := parseMedicationRequest(body)
+		if entry.ParseError != nil {
+			return nil, entry.ParseError
+		}
+		return []domain.FHIRMedicationEntryDomain{entry}, nil
+	case fhir.ResourceTypeBundle:
+		var bundle fhir.Bundle
+		if err := json.Unmarshal(body, &bundle); err != nil {
+			return nil, &ErrInvalidFHIRResource{Reason: err.Error()}
+		}
+		if bundle.Type != fhir.BundleTypeBatch {
+			return nil, &ErrInvalidFHIRResource{Reason: "only batch bundles are supported"}
+		}
+		entries := make([]domain.FHIRMedicationEntryDomain, len(bundle.Entry))
+		for i, entry := range bundle.Entry {
+			entries[i] = parseMedicationRequest(entry.Resource)
+		}
+		return entries, nil
+	default:
+		return nil, &ErrInvalidFHIRResource{Reason: "resourceType must be MedicationRequest or Bundle"}
+	}
+}
+
+func parseMedicationRequest(raw json.RawMessage) domain.FHIRMedicationEntryDomain {
+	var header fhirResourceHeader
+	if err := json.Unmarshal(raw, &header); err != nil {
+		return domain.FHIRMedicationEntryDomain{ParseError: &ErrInvalidFHIRResource{Reason: err.Error()}}
+	}
+	if header.ResourceType != fhir.ResourceTypeMedicationRequest {
+		return domain.FHIRMedicationEntryDomain{
+			ParseError: &ErrInvalidFHIRResource{Reason: fmt.Sprintf("resourceType %q is not MedicationRequest", header.ResourceType)},
+		}
+	}
+
+	var resource fhir.MedicationRequest
+	if err := json.Unmarshal(raw, &resource); err != nil {
+		return domain.FHIRMedicationEntryDomain{ParseError: &ErrInvalidFHIRResource{Reason: err.Error()}}
+	}
+	return domain.FHIRMedicationEntryDomain{Resource: &resource}
+}
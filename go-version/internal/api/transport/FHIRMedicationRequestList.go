@@ -0,0 +1,51 @@
+package transport
+
+import (
+	"net/url"
+	"strings"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/fhir"
+)
+
+type FHIRMedicationListRequest struct {
+	UserIDContext
+	NoURLParams
+	NoRequestBody
+
+	// Query Params
+	Patient *string `json:"patient"`
+}
+
+func (r *FHIRMedicationListRequest) ParseFromQuery(values url.Values) error {
+	if values.Has("patient") {
+		patient := values.Get("patient")
+		r.Patient = &patient
+	}
+	return nil
+}
+
+func (r *FHIRMedicationListRequest) Validate() error {
+	if r.Patient != nil && patientID(*r.Patient) == "" {
+		return &ErrBadRequest{Errs: []error{&ErrInvalidPatient{}}}
+	}
+	return nil
+}
+
+func (r *FHIRMedicationListRequest) ToDomain() *domain.FHIRMedicationListDomain {
+	params := &domain.FHIRMedicationListDomain{UserID: r.UserID}
+	if r.Patient != nil {
+		params.PatientID = patientID(*r.Patient)
+	}
+	return params
+}
+
+// patientID returns the id of a patient search value, which may be a bare
+// id or a Patient reference.
+func patientID(value string) string {
+	id, found := strings.CutPrefix(value, fhir.ResourceTypePatient+"/")
+	if !found && strings.Contains(value, "/") {
+		return ""
+	}
+	return id
+}
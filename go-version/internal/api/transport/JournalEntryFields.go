@@ -0,0 +1,96 @@
+package transport
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"go-version/internal/api/domain"
+)
+
+const (
+	maxJournalTextLength      = 5000
+	maxJournalTags            = 20
+	maxJournalTagLength       = 50
+	maxJournalMeasurements    = 20
+	maxJournalMeasurementName = 100
+	maxJournalMeasurementUnit = 20
+)
+
+// JournalMeasurementRequest is a measurement as sent with a journal entry.
+type JournalMeasurementRequest struct {
+	Name  *string  `json:"name"`
+	Value *float64 `json:"value"`
+	Unit  *string  `json:"unit"`
+}
+
+func validateJournalText(text string) []error {
+	if strings.TrimSpace(text) == "" {
+		return []error{&ErrInvalidJournalEntry{Reason: "text is required"}}
+	}
+	if utf8.RuneCountInString(text) > maxJournalTextLength {
+		return []error{&ErrInvalidJournalEntry{Reason: "text must be at most 5000 characters"}}
+	}
+	return nil
+}
+
+func validateJournalTags(tags []string) []error {
+	var errors []error
+	if len(tags) > maxJournalTags {
+		errors = append(errors, &ErrInvalidJournalEntry{Reason: "at most 20 tags are allowed"})
+	}
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || utf8.RuneCountInString(tag) > maxJournalTagLength {
+			errors = append(errors, &ErrInvalidJournalEntry{Reason: "tags must be between 1 and 50 characters"})
+			break
+		}
+	}
+	return errors
+}
+
+func validateJournalMeasurements(measurements []JournalMeasurementRequest) []error {
+	var errors []error
+	if len(measurements) > maxJournalMeasurements {
+		errors = append(errors, &ErrInvalidJournalEntry{Reason: "at most 20 measurements are allowed"})
+	}
+	for _, measurement := range measurements {
+		if measurement.Name == nil || strings.TrimSpace(*measurement.Name) == "" || utf8.RuneCountInString(*measurement.Name) > maxJournalMeasurementName {
+			errors = append(errors, &ErrInvalidJournalEntry{Reason: "measurement name must be between 1 and 100 characters"})
+		}
+		if measurement.Value == nil {
+			errors = append(errors, &ErrInvalidJournalEntry{Reason: "measurement value is required"})
+		}
+		if measurement.Unit != nil && (*measurement.Unit == "" || utf8.RuneCountInString(*measurement.Unit) > maxJournalMeasurementUnit) {
+			errors = append(errors, &ErrInvalidJournalEntry{Reason: "measurement unit must be between 1 and 20 characters"})
+		}
+	}
+	return errors
+}
+
+// normalizeJournalTags trims and lowercases tags so that they match
+// however they were typed.
+func normalizeJournalTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+	normalized := make([]string, len(tags))
+	for i, tag := range tags {
+		normalized[i] = strings.ToLower(strings.TrimSpace(tag))
+	}
+	return normalized
+}
+
+func journalMeasurementsToDomain(measurements []JournalMeasurementRequest) []domain.JournalMeasurementDomain {
+	if measurements == nil {
+		return nil
+	}
+	result := make([]domain.JournalMeasurementDomain, len(measurements))
+	for i, measurement := range measurements {
+		result[i] = domain.JournalMeasurementDomain{
+			Name:  strings.TrimSpace(*measurement.Name),
+			Value: *measurement.Value,
+			Unit:  measurement.Unit,
+		}
+	}
+	return result
+}
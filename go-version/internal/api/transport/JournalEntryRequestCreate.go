@@ -0,0 +1,57 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+	"time"
+)
+
+type JournalEntryCreateRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	OccurredAt   *string                     `json:"occurred_at"`
+	Text         *string                     `json:"text"`
+	Tags         []string                    `json:"tags"`
+	Measurements []JournalMeasurementRequest `json:"measurements"`
+}
+
+func (r *JournalEntryCreateRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *JournalEntryCreateRequest) Validate() error {
+	var errors []error
+	if r.OccurredAt != nil && !utils.IsValidDateTime(*r.OccurredAt) {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	}
+	if r.Text == nil {
+		errors = append(errors, &ErrInvalidJournalEntry{Reason: "text is required"})
+	} else {
+		errors = append(errors, validateJournalText(*r.Text)...)
+	}
+	errors = append(errors, validateJournalTags(r.Tags)...)
+	errors = append(errors, validateJournalMeasurements(r.Measurements)...)
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *JournalEntryCreateRequest) ToDomain() *domain.JournalEntryCreateDomain {
+	occurredAt := time.Now().UTC()
+	if r.OccurredAt != nil {
+		occurredAt, _ = utils.ParseDateTime(*r.OccurredAt)
+	}
+	return &domain.JournalEntryCreateDomain{
+		UserID:       r.UserID,
+		OccurredAt:   occurredAt,
+		Text:         *r.Text,
+		Tags:         normalizeJournalTags(r.Tags),
+		Measurements: journalMeasurementsToDomain(r.Measurements),
+	}
+}
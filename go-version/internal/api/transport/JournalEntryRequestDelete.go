@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type JournalEntryDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	EntryID string `json:"-" db:"-"`
+}
+
+func (r *JournalEntryDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.EntryID = chi.URLParam(req, "entryId")
+	return nil
+}
+
+func (r *JournalEntryDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *JournalEntryDeleteRequest) ToDomain() *domain.JournalEntryDeleteDomain {
+	return &domain.JournalEntryDeleteDomain{
+		UserID:  r.UserID,
+		EntryID: r.EntryID,
+	}
+}
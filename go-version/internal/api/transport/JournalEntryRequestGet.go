@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type JournalEntryGetRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	EntryID string `json:"-" db:"-"`
+}
+
+func (r *JournalEntryGetRequest) ParseFromURLParams(req *http.Request) error {
+	r.EntryID = chi.URLParam(req, "entryId")
+	return nil
+}
+
+func (r *JournalEntryGetRequest) Validate() error {
+	return nil
+}
+
+func (r *JournalEntryGetRequest) ToDomain() *domain.JournalEntryGetDomain {
+	return &domain.JournalEntryGetDomain{
+		UserID:  r.UserID,
+		EntryID: r.EntryID,
+	}
+}
@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type JournalEntryListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoURLParams
+
+	// Query Params
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+	Search    *string `json:"search"`
+	Tag       *string `json:"tag"`
+}
+
+func (r *JournalEntryListRequest) ParseFromQuery(values url.Values) error {
+	startDate := values.Get("start_date")
+	endDate := values.Get("end_date")
+	search := values.Get("search")
+	tag := values.Get("tag")
+
+	if startDate != "" {
+		r.StartDate = &startDate
+	}
+	if endDate != "" {
+		r.EndDate = &endDate
+	}
+	if search != "" {
+		r.Search = &search
+	}
+	if tag != "" {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		r.Tag = &tag
+	}
+	return nil
+}
+
+func (r *JournalEntryListRequest) Validate() error {
+	var errors []error
+	if (r.StartDate != nil && !utils.IsValidDateTime(*r.StartDate)) || (r.EndDate != nil && !utils.IsValidDateTime(*r.EndDate)) {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *JournalEntryListRequest) ToDomain() *domain.JournalEntryListDomain {
+	var startDate, endDate *time.Time
+	if r.StartDate != nil {
+		sd, _ := utils.ParseDateTime(*r.StartDate)
+		startDate = &sd
+	}
+	if r.EndDate != nil {
+		ed, _ := utils.ParseDateTime(*r.EndDate)
+		endDate = &ed
+	}
+	return &domain.JournalEntryListDomain{
+		UserID:    r.UserID,
+		Search:    r.Search,
+		Tag:       r.Tag,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+}
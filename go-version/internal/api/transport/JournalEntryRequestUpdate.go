@@ -0,0 +1,72 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type JournalEntryUpdateRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	EntryID string `json:"-" db:"-"`
+
+	// Request Body
+	OccurredAt *string `json:"occurred_at"`
+	Text       *string `json:"text"`
+	// Tags and Measurements replace the entry's when sent.
+	Tags         []string                    `json:"tags"`
+	Measurements []JournalMeasurementRequest `json:"measurements"`
+}
+
+func (r *JournalEntryUpdateRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *JournalEntryUpdateRequest) ParseFromURLParams(req *http.Request) error {
+	r.EntryID = chi.URLParam(req, "entryId")
+	return nil
+}
+
+func (r *JournalEntryUpdateRequest) Validate() error {
+	var errors []error
+	if r.OccurredAt != nil && !utils.IsValidDateTime(*r.OccurredAt) {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	}
+	if r.Text != nil {
+		errors = append(errors, validateJournalText(*r.Text)...)
+	}
+	errors = append(errors, validateJournalTags(r.Tags)...)
+	errors = append(errors, validateJournalMeasurements(r.Measurements)...)
+
+	if r.OccurredAt == nil && r.Text == nil && r.Tags == nil && r.Measurements == nil {
+		errors = append(errors, &ErrNoFieldsToUpdate{})
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *JournalEntryUpdateRequest) ToDomain() *domain.JournalEntryUpdateDomain {
+	var occurredAt *time.Time
+	if r.OccurredAt != nil {
+		oa, _ := utils.ParseDateTime(*r.OccurredAt)
+		occurredAt = &oa
+	}
+	return &domain.JournalEntryUpdateDomain{
+		UserID:       r.UserID,
+		EntryID:      r.EntryID,
+		OccurredAt:   occurredAt,
+		Text:         r.Text,
+		Tags:         normalizeJournalTags(r.Tags),
+		Measurements: journalMeasurementsToDomain(r.Measurements),
+	}
+}
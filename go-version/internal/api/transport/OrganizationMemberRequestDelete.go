@@ -0,0 +1,37 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type OrganizationMemberDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	OrganizationID string `json:"-" db:"-"`
+	MemberID       string `json:"-" db:"-"`
+}
+
+func (r *OrganizationMemberDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.OrganizationID = chi.URLParam(req, "organizationId")
+	r.MemberID = chi.URLParam(req, "memberId")
+	return nil
+}
+
+func (r *OrganizationMemberDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *OrganizationMemberDeleteRequest) ToDomain() *domain.OrganizationMemberDeleteDomain {
+	return &domain.OrganizationMemberDeleteDomain{
+		UserID:         r.UserID,
+		OrganizationID: r.OrganizationID,
+		MemberID:       r.MemberID,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type OrganizationMemberListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	OrganizationID string `json:"-" db:"-"`
+}
+
+func (r *OrganizationMemberListRequest) ParseFromURLParams(req *http.Request) error {
+	r.OrganizationID = chi.URLParam(req, "organizationId")
+	return nil
+}
+
+func (r *OrganizationMemberListRequest) Validate() error {
+	return nil
+}
+
+func (r *OrganizationMemberListRequest) ToDomain() *domain.OrganizationMemberListDomain {
+	return &domain.OrganizationMemberListDomain{
+		UserID:         r.UserID,
+		OrganizationID: r.OrganizationID,
+	}
+}
@@ -0,0 +1,56 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type OrganizationMemberSetRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	OrganizationID string `json:"-" db:"-"`
+
+	// Request Body
+	Email *string `json:"email"`
+	Role  *string `json:"role"`
+}
+
+func (r *OrganizationMemberSetRequest) ParseFromURLParams(req *http.Request) error {
+	r.OrganizationID = chi.URLParam(req, "organizationId")
+	return nil
+}
+
+func (r *OrganizationMemberSetRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *OrganizationMemberSetRequest) Validate() error {
+	var errors []error
+	if r.Email == nil || strings.TrimSpace(*r.Email) == "" {
+		errors = append(errors, &ErrEmailRequired{})
+	}
+	if r.Role == nil || !models.IsValidOrganizationRole(*r.Role) {
+		errors = append(errors, &ErrInvalidOrganizationMember{Reason: "role must be admin or clinician"})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *OrganizationMemberSetRequest) ToDomain() *domain.OrganizationMemberSetDomain {
+	return &domain.OrganizationMemberSetDomain{
+		UserID:         r.UserID,
+		OrganizationID: r.OrganizationID,
+		Email:          strings.TrimSpace(*r.Email),
+		Role:           *r.Role,
+	}
+}
@@ -0,0 +1,45 @@
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-version/internal/api/domain"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxOrganizationNameLength = 200
+
+type OrganizationCreateRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	Name *string `json:"name"`
+}
+
+func (r *OrganizationCreateRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *OrganizationCreateRequest) Validate() error {
+	var errors []error
+	if r.Name == nil || strings.TrimSpace(*r.Name) == "" {
+		errors = append(errors, &ErrNameRequired{})
+	} else if utf8.RuneCountInString(*r.Name) > maxOrganizationNameLength {
+		errors = append(errors, &ErrInvalidOrganization{Reason: fmt.Sprintf("name must be at most %d characters", maxOrganizationNameLength)})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *OrganizationCreateRequest) ToDomain() *domain.OrganizationCreateDomain {
+	return &domain.OrganizationCreateDomain{
+		UserID: r.UserID,
+		Name:   strings.TrimSpace(*r.Name),
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type OrganizationListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *OrganizationListRequest) Validate() error {
+	return nil
+}
+
+func (r *OrganizationListRequest) ToDomain() *domain.OrganizationListDomain {
+	return &domain.OrganizationListDomain{
+		UserID: r.UserID,
+	}
+}
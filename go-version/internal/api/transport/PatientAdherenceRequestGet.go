@@ -0,0 +1,84 @@
+package transport
+
+import (
+	"net/http"
+	"net/url"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// maxAdherenceDays bounds the range adherence is reported over, since
+// every occurrence within it is counted.
+const maxAdherenceDays = 366
+
+type PatientAdherenceGetRequest struct {
+	UserIDContext
+	NoRequestBody
+
+	// URL Params
+	OrganizationID string `json:"-" db:"-"`
+	PatientID      string `json:"-" db:"-"`
+
+	// Query Params
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+}
+
+func (r *PatientAdherenceGetRequest) ParseFromURLParams(req *http.Request) error {
+	r.OrganizationID = chi.URLParam(req, "organizationId")
+	r.PatientID = chi.URLParam(req, "patientId")
+	return nil
+}
+
+func (r *PatientAdherenceGetRequest) ParseFromQuery(values url.Values) error {
+	startDate := values.Get("start_date")
+	endDate := values.Get("end_date")
+
+	if startDate != "" {
+		r.StartDate = &startDate
+	}
+	if endDate != "" {
+		r.EndDate = &endDate
+	}
+	return nil
+}
+
+func (r *PatientAdherenceGetRequest) Validate() error {
+	var errors []error
+	if r.StartDate == nil {
+		errors = append(errors, &ErrStartDateRequired{})
+	}
+	if r.EndDate == nil {
+		errors = append(errors, &ErrEndDateRequired{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+
+	startDate, startErr := utils.ParseDateTime(*r.StartDate)
+	endDate, endErr := utils.ParseDateTime(*r.EndDate)
+	if startErr != nil || endErr != nil {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	} else if !endDate.After(startDate) || endDate.Sub(startDate).Hours() > maxAdherenceDays*24 {
+		errors = append(errors, &ErrInvalidDateRange{MaxDays: maxAdherenceDays})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *PatientAdherenceGetRequest) ToDomain() *domain.PatientAdherenceDomain {
+	startDate, _ := utils.ParseDateTime(*r.StartDate)
+	endDate, _ := utils.ParseDateTime(*r.EndDate)
+	return &domain.PatientAdherenceDomain{
+		UserID:         r.UserID,
+		OrganizationID: r.OrganizationID,
+		PatientID:      r.PatientID,
+		StartDate:      startDate,
+		EndDate:        endDate,
+	}
+}
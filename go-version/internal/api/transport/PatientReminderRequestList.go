@@ -0,0 +1,37 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type PatientReminderListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	OrganizationID string `json:"-" db:"-"`
+	PatientID      string `json:"-" db:"-"`
+}
+
+func (r *PatientReminderListRequest) ParseFromURLParams(req *http.Request) error {
+	r.OrganizationID = chi.URLParam(req, "organizationId")
+	r.PatientID = chi.URLParam(req, "patientId")
+	return nil
+}
+
+func (r *PatientReminderListRequest) Validate() error {
+	return nil
+}
+
+func (r *PatientReminderListRequest) ToDomain() *domain.PatientReminderListDomain {
+	return &domain.PatientReminderListDomain{
+		UserID:         r.UserID,
+		OrganizationID: r.OrganizationID,
+		PatientID:      r.PatientID,
+	}
+}
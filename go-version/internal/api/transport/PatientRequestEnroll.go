@@ -0,0 +1,50 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type PatientEnrollRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	OrganizationID string `json:"-" db:"-"`
+
+	// Request Body
+	Email *string `json:"email"`
+}
+
+func (r *PatientEnrollRequest) ParseFromURLParams(req *http.Request) error {
+	r.OrganizationID = chi.URLParam(req, "organizationId")
+	return nil
+}
+
+func (r *PatientEnrollRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *PatientEnrollRequest) Validate() error {
+	var errors []error
+	if r.Email == nil || strings.TrimSpace(*r.Email) == "" {
+		errors = append(errors, &ErrEmailRequired{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *PatientEnrollRequest) ToDomain() *domain.PatientEnrollDomain {
+	return &domain.PatientEnrollDomain{
+		UserID:         r.UserID,
+		OrganizationID: r.OrganizationID,
+		Email:          strings.TrimSpace(*r.Email),
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type PatientListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	OrganizationID string `json:"-" db:"-"`
+}
+
+func (r *PatientListRequest) ParseFromURLParams(req *http.Request) error {
+	r.OrganizationID = chi.URLParam(req, "organizationId")
+	return nil
+}
+
+func (r *PatientListRequest) Validate() error {
+	return nil
+}
+
+func (r *PatientListRequest) ToDomain() *domain.PatientListDomain {
+	return &domain.PatientListDomain{
+		UserID:         r.UserID,
+		OrganizationID: r.OrganizationID,
+	}
+}
@@ -0,0 +1,37 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type PatientUnenrollRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	OrganizationID string `json:"-" db:"-"`
+	PatientID      string `json:"-" db:"-"`
+}
+
+func (r *PatientUnenrollRequest) ParseFromURLParams(req *http.Request) error {
+	r.OrganizationID = chi.URLParam(req, "organizationId")
+	r.PatientID = chi.URLParam(req, "patientId")
+	return nil
+}
+
+func (r *PatientUnenrollRequest) Validate() error {
+	return nil
+}
+
+func (r *PatientUnenrollRequest) ToDomain() *domain.PatientUnenrollDomain {
+	return &domain.PatientUnenrollDomain{
+		UserID:         r.UserID,
+		OrganizationID: r.OrganizationID,
+		PatientID:      r.PatientID,
+	}
+}
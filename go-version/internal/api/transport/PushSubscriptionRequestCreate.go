@@ -0,0 +1,71 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/webpush"
+	"net/http"
+)
+
+type PushSubscriptionKeys struct {
+	P256dh *string `json:"p256dh"`
+	Auth   *string `json:"auth"`
+}
+
+// PushSubscriptionCreateRequest mirrors the shape of PushSubscription.toJSON()
+// in the browser so clients can post it unchanged.
+type PushSubscriptionCreateRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	Endpoint *string               `json:"endpoint"`
+	Keys     *PushSubscriptionKeys `json:"keys"`
+
+	// Headers
+	UserAgent *string `json:"-"`
+}
+
+func (r *PushSubscriptionCreateRequest) ParseFromBody(req *http.Request) error {
+	if userAgent := req.Header.Get("User-Agent"); userAgent != "" {
+		r.UserAgent = &userAgent
+	}
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *PushSubscriptionCreateRequest) Validate() error {
+	var errors []error
+	if r.Endpoint == nil || *r.Endpoint == "" {
+		errors = append(errors, &ErrEndpointRequired{})
+	}
+	if r.Keys == nil || r.Keys.P256dh == nil || r.Keys.Auth == nil {
+		errors = append(errors, &ErrSubscriptionKeysRequired{})
+	}
+
+	if len(errors) == 0 {
+		err := webpush.ValidateSubscription(webpush.Subscription{
+			Endpoint: *r.Endpoint,
+			P256dh:   *r.Keys.P256dh,
+			Auth:     *r.Keys.Auth,
+		})
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *PushSubscriptionCreateRequest) ToDomain() *domain.PushSubscriptionCreateDomain {
+	return &domain.PushSubscriptionCreateDomain{
+		UserID:    r.UserID,
+		Endpoint:  *r.Endpoint,
+		P256dh:    *r.Keys.P256dh,
+		Auth:      *r.Keys.Auth,
+		UserAgent: r.UserAgent,
+	}
+}
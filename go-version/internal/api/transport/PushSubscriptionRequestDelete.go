@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type PushSubscriptionDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	SubscriptionID string `json:"-" db:"-"`
+}
+
+func (r *PushSubscriptionDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.SubscriptionID = chi.URLParam(req, "subscriptionId")
+	return nil
+}
+
+func (r *PushSubscriptionDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *PushSubscriptionDeleteRequest) ToDomain() *domain.PushSubscriptionDeleteDomain {
+	return &domain.PushSubscriptionDeleteDomain{
+		UserID:         r.UserID,
+		SubscriptionID: r.SubscriptionID,
+	}
+}
@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type PushSubscriptionListRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *PushSubscriptionListRequest) Validate() error {
+	return nil
+}
+
+func (r *PushSubscriptionListRequest) ToDomain() *domain.PushSubscriptionListDomain {
+	return &domain.PushSubscriptionListDomain{
+		UserID: r.UserID,
+	}
+}
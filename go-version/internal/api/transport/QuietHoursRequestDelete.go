@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type QuietHoursDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *QuietHoursDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *QuietHoursDeleteRequest) ToDomain() *domain.QuietHoursDeleteDomain {
+	return &domain.QuietHoursDeleteDomain{
+		UserID: r.UserID,
+	}
+}
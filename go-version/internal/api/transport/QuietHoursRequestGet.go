@@ -0,0 +1,22 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+)
+
+type QuietHoursGetRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+	NoURLParams
+}
+
+func (r *QuietHoursGetRequest) Validate() error {
+	return nil
+}
+
+func (r *QuietHoursGetRequest) ToDomain() *domain.QuietHoursGetDomain {
+	return &domain.QuietHoursGetDomain{
+		UserID: r.UserID,
+	}
+}
@@ -0,0 +1,62 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+)
+
+type QuietHoursSetRequest struct {
+	UserIDContext
+	NoURLParams
+	NoQueryParams
+
+	// Request Body
+	StartTime *string `json:"start_time"`
+	EndTime   *string `json:"end_time"`
+	TimeZone  *string `json:"time_zone"`
+}
+
+func (r *QuietHoursSetRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *QuietHoursSetRequest) Validate() error {
+	var errors []error
+	if r.StartTime == nil || *r.StartTime == "" {
+		errors = append(errors, &ErrStartTimeRequired{})
+	} else if !utils.IsValidClockTime(*r.StartTime) {
+		errors = append(errors, &ErrInvalidClockTime{Field: "start_time"})
+	}
+
+	if r.EndTime == nil || *r.EndTime == "" {
+		errors = append(errors, &ErrEndTimeRequired{})
+	} else if !utils.IsValidClockTime(*r.EndTime) {
+		errors = append(errors, &ErrInvalidClockTime{Field: "end_time"})
+	}
+
+	if r.StartTime != nil && r.EndTime != nil && *r.StartTime != "" && *r.StartTime == *r.EndTime {
+		errors = append(errors, &ErrEmptyQuietHours{})
+	}
+
+	if r.TimeZone == nil || *r.TimeZone == "" {
+		errors = append(errors, &ErrTimeZoneRequired{})
+	} else if !utils.IsValidTimeZone(*r.TimeZone) {
+		errors = append(errors, &ErrInvalidTimeZone{})
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *QuietHoursSetRequest) ToDomain() *domain.QuietHoursSetDomain {
+	return &domain.QuietHoursSetDomain{
+		UserID:    r.UserID,
+		StartTime: *r.StartTime,
+		EndTime:   *r.EndTime,
+		TimeZone:  *r.TimeZone,
+	}
+}
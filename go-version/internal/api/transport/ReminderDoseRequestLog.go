@@ -0,0 +1,59 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ReminderDoseLogRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Request Body
+	Quantity *float64 `json:"quantity"`
+	TakenAt  *string  `json:"taken_at"`
+}
+
+func (r *ReminderDoseLogRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *ReminderDoseLogRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *ReminderDoseLogRequest) Validate() error {
+	var errors []error
+	if r.Quantity != nil && *r.Quantity <= 0 {
+		errors = append(errors, &ErrInvalidDose{Reason: "quantity must be greater than 0"})
+	}
+	if r.TakenAt != nil && !utils.IsValidDateTime(*r.TakenAt) {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *ReminderDoseLogRequest) ToDomain() *domain.ReminderDoseLogDomain {
+	takenAt := time.Now().UTC()
+	if r.TakenAt != nil {
+		takenAt, _ = utils.ParseDateTime(*r.TakenAt)
+	}
+	return &domain.ReminderDoseLogDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+		Quantity:   r.Quantity,
+		TakenAt:    takenAt,
+	}
+}
@@ -0,0 +1,83 @@
+package transport
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+)
+
+const (
+	maxMedicationNameLength         = 200
+	maxMedicationStrengthLength     = 100
+	maxMedicationInstructionsLength = 1000
+)
+
+// ReminderMedicationRequest is the medication a reminder is for, as sent
+// when creating or updating a reminder.
+type ReminderMedicationRequest struct {
+	Name         *string  `json:"name"`
+	Strength     *string  `json:"strength"`
+	DoseQuantity *float64 `json:"dose_quantity"`
+	DoseUnit     *string  `json:"dose_unit"`
+	Route        *string  `json:"route"`
+	Instructions *string  `json:"instructions"`
+}
+
+// OptionalMedication tells an omitted medication, which is left as it is,
+// from a null one, which is removed.
+type OptionalMedication struct {
+	Set   bool
+	Value *ReminderMedicationRequest
+}
+
+func (m *OptionalMedication) UnmarshalJSON(data []byte) error {
+	m.Set = true
+	if bytes.Equal(data, []byte("null")) {
+		m.Value = nil
+		return nil
+	}
+	m.Value = &ReminderMedicationRequest{}
+	return json.Unmarshal(data, m.Value)
+}
+
+func (m *ReminderMedicationRequest) Validate() []error {
+	var errors []error
+	if m.Name == nil || strings.TrimSpace(*m.Name) == "" {
+		errors = append(errors, &ErrInvalidMedication{Reason: "name is required"})
+	} else if len(*m.Name) > maxMedicationNameLength {
+		errors = append(errors, &ErrInvalidMedication{Reason: "name must be at most 200 characters"})
+	}
+	if m.Strength != nil && len(*m.Strength) > maxMedicationStrengthLength {
+		errors = append(errors, &ErrInvalidMedication{Reason: "strength must be at most 100 characters"})
+	}
+	if m.DoseQuantity != nil && *m.DoseQuantity <= 0 {
+		errors = append(errors, &ErrInvalidMedication{Reason: "dose_quantity must be positive"})
+	}
+	if m.DoseQuantity != nil && m.DoseUnit == nil {
+		errors = append(errors, &ErrInvalidMedication{Reason: "dose_unit is required with dose_quantity"})
+	}
+	if m.DoseUnit != nil && !models.IsValidDoseUnit(*m.DoseUnit) {
+		errors = append(errors, &ErrInvalidMedication{Reason: "dose_unit must be one of: " + strings.Join(models.DoseUnits, ", ")})
+	}
+	if m.Route != nil && !models.IsValidMedicationRoute(*m.Route) {
+		errors = append(errors, &ErrInvalidMedication{Reason: "route must be one of: " + strings.Join(models.MedicationRoutes, ", ")})
+	}
+	if m.Instructions != nil && len(*m.Instructions) > maxMedicationInstructionsLength {
+		errors = append(errors, &ErrInvalidMedication{Reason: "instructions must be at most 1000 characters"})
+	}
+	return errors
+}
+
+func (m *ReminderMedicationRequest) ToDomain() *domain.MedicationDomain {
+	return &domain.MedicationDomain{
+		Name:         strings.TrimSpace(*m.Name),
+		Strength:     m.Strength,
+		DoseQuantity: m.DoseQuantity,
+		DoseUnit:     m.DoseUnit,
+		Route:        m.Route,
+		Instructions: m.Instructions,
+	}
+}
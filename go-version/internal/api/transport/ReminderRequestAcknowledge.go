@@ -0,0 +1,52 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ReminderAcknowledgeRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Request Body
+	OccurrenceAt *string `json:"occurrence_at"`
+}
+
+func (r *ReminderAcknowledgeRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *ReminderAcknowledgeRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *ReminderAcknowledgeRequest) Validate() error {
+	var errors []error
+	if r.OccurrenceAt == nil || *r.OccurrenceAt == "" {
+		errors = append(errors, &ErrOccurrenceAtRequired{})
+	} else if !utils.IsValidDateTime(*r.OccurrenceAt) {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *ReminderAcknowledgeRequest) ToDomain() *domain.ReminderAcknowledgeDomain {
+	occurrenceAt, _ := utils.ParseDateTime(*r.OccurrenceAt)
+	return &domain.ReminderAcknowledgeDomain{
+		UserID:       r.UserID,
+		ReminderID:   r.ReminderID,
+		OccurrenceAt: occurrenceAt,
+	}
+}
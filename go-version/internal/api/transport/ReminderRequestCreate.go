@@ -16,6 +16,9 @@ type ReminderCreateRequest struct {
 	RRule       *string `json:"rrule"`
 	Description *string `json:"description"`
 	StartAt     *string `json:"start_at"`
+	Critical    *bool   `json:"critical"`
+
+	Medication *ReminderMedicationRequest `json:"medication"`
 }
 
 func (r *ReminderCreateRequest) ParseFromBody(req *http.Request) error {
@@ -36,6 +39,10 @@ func (r *ReminderCreateRequest) Validate() error {
 		errors = append(errors, &ErrInvalidStartAt{})
 	}
 
+	if r.Medication != nil {
+		errors = append(errors, r.Medication.Validate()...)
+	}
+
 	if len(errors) > 0 {
 		return &ErrBadRequest{Errs: errors}
 	}
@@ -44,9 +51,16 @@ func (r *ReminderCreateRequest) Validate() error {
 
 func (r *ReminderCreateRequest) ToDomain() *domain.ReminderCreateDomain {
 	startAt, _ := utils.ParseDateTime(*r.StartAt)
+	var medication *domain.MedicationDomain
+	if r.Medication != nil {
+		medication = r.Medication.ToDomain()
+	}
 	return &domain.ReminderCreateDomain{
+		UserID:      r.UserID,
 		RRule:       *r.RRule,
 		Description: r.Description,
 		StartAt:     startAt,
+		Critical:    r.Critical != nil && *r.Critical,
+		Medication:  medication,
 	}
 }
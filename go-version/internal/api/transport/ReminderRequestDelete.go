@@ -28,6 +28,7 @@ func (r *ReminderDeleteRequest) Validate() error {
 
 func (r *ReminderDeleteRequest) ToDomain() *domain.ReminderDeleteDomain {
 	return &domain.ReminderDeleteDomain{
+		UserID:     r.UserID,
 		ReminderID: r.ReminderID,
 	}
 }
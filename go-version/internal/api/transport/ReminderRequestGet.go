@@ -0,0 +1,59 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ReminderGetRequest struct {
+	UserIDContext
+	NoRequestBody
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Query Params
+	// AsOf is when the next and previous occurrences are found from.
+	AsOf *string `json:"as_of"`
+}
+
+func (r *ReminderGetRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *ReminderGetRequest) ParseFromQuery(values url.Values) error {
+	if asOf := values.Get("as_of"); asOf != "" {
+		r.AsOf = &asOf
+	}
+	return nil
+}
+
+func (r *ReminderGetRequest) Validate() error {
+	var errors []error
+	if r.AsOf != nil && !utils.IsValidDateTime(*r.AsOf) {
+		errors = append(errors, &ErrInvalidAsOf{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *ReminderGetRequest) ToDomain() *domain.ReminderGetDomain {
+	var asOf *time.Time
+	if r.AsOf != nil {
+		a, _ := utils.ParseDateTime(*r.AsOf)
+		asOf = &a
+	}
+	return &domain.ReminderGetDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+		AsOf:       asOf,
+	}
+}
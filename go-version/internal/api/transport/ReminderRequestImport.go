@@ -0,0 +1,113 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go-version/internal/api/domain"
+	"go-version/internal/ical"
+)
+
+// MaxCalendarSize is the largest calendar file accepted in a request body.
+const MaxCalendarSize = 2 << 20
+
+type ReminderImportRequest struct {
+	UserIDContext
+	NoURLParams
+
+	// Query Params
+	DryRun *string `json:"dry_run"`
+
+	// Request Body
+	Entries []ical.Entry `json:"-"`
+	hasBody bool
+	bodyErr error
+}
+
+func (r *ReminderImportRequest) BodyMediaTypes() []string {
+	return []string{"text/calendar"}
+}
+
+func (r *ReminderImportRequest) ParseFromQuery(values url.Values) error {
+	if values.Has("dry_run") {
+		dryRun := values.Get("dry_run")
+		r.DryRun = &dryRun
+	}
+	return nil
+}
+
+func (r *ReminderImportRequest) ParseFromBody(req *http.Request) error {
+	r.hasBody = true
+	r.Entries, r.bodyErr = parseCalendarBody(req)
+	return r.bodyErr
+}
+
+func (r *ReminderImportRequest) Validate() error {
+	var errors []error
+	if !r.hasBody {
+		errors = append(errors, &ErrCalendarRequired{})
+	} else if r.bodyErr != nil {
+		errors = append(errors, r.bodyErr)
+	}
+
+	if r.DryRun != nil {
+		if _, err := strconv.ParseBool(*r.DryRun); err != nil {
+			errors = append(errors, &ErrInvalidDryRun{})
+		}
+	}
+
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *ReminderImportRequest) ToDomain() *domain.ReminderImportDomain {
+	dryRun := false
+	if r.DryRun != nil {
+		dryRun, _ = strconv.ParseBool(*r.DryRun)
+	}
+
+	return &domain.ReminderImportDomain{
+		UserID:  r.UserID,
+		DryRun:  dryRun,
+		Entries: calendarEntriesToDomain(r.Entries),
+	}
+}
+
+// parseCalendarBody reads the entries of a text/calendar request body.
+func parseCalendarBody(req *http.Request) ([]ical.Entry, error) {
+	body, err := io.ReadAll(io.LimitReader(req.Body, MaxCalendarSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > MaxCalendarSize {
+		return nil, &ErrCalendarTooLarge{Max: MaxCalendarSize}
+	}
+
+	return ical.Parse(bytes.NewReader(body))
+}
+
+func calendarEntriesToDomain(entries []ical.Entry) []domain.CalendarEntryDomain {
+	converted := make([]domain.CalendarEntryDomain, len(entries))
+	for i, entry := range entries {
+		converted[i] = domain.CalendarEntryDomain{
+			Component:  entry.Component,
+			UID:        entry.UID,
+			Summary:    entry.Summary,
+			Status:     entry.Status,
+			RRule:      entry.RRule,
+			ExDates:    entry.ExDates,
+			Overrides:  entry.RecurrenceID,
+			ParseError: entry.Err,
+		}
+		if entry.HasStart {
+			start := entry.Start
+			converted[i].StartAt = &start
+		}
+	}
+	return converted
+}
@@ -2,11 +2,20 @@ package transport
 
 import (
 	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
 	"go-version/internal/api/utils"
 	"net/url"
+	"strconv"
 	"time"
 )
 
+const (
+	// DefaultReminderListLimit and MaxReminderListLimit are the default and
+	// largest number of reminders a page may hold.
+	DefaultReminderListLimit = 50
+	MaxReminderListLimit     = 100
+)
+
 type ReminderListRequest struct {
 	UserIDContext
 	NoRequestBody
@@ -16,12 +25,26 @@ type ReminderListRequest struct {
 	StartDate *string `json:"start_date"`
 	EndDate   *string `json:"end_date"`
 	Search    *string `json:"search"`
+	// Medication filters by medication name.
+	Medication *string `json:"medication"`
+	// IncludeShared adds the reminders shared with the user as a caregiver.
+	IncludeShared *string `json:"include_shared"`
+	// Sort is one of models.ReminderSorts, and Order asc or desc.
+	Sort  *string `json:"sort"`
+	Order *string `json:"order"`
+	// Limit caps the page size, and Cursor is the next_cursor of the
+	// previous page.
+	Limit  *string `json:"limit"`
+	Cursor *string `json:"cursor"`
+	// AsOf is when next and previous occurrences are found from.
+	AsOf *string `json:"as_of"`
 }
 
 func (r *ReminderListRequest) ParseFromQuery(values url.Values) error {
 	startDate := values.Get("start_date")
 	endDate := values.Get("end_date")
 	search := values.Get("search")
+	medication := values.Get("medication")
 
 	if startDate != "" {
 		r.StartDate = &startDate
@@ -32,6 +55,29 @@ func (r *ReminderListRequest) ParseFromQuery(values url.Values) error {
 	if search != "" {
 		r.Search = &search
 	}
+	if medication != "" {
+		r.Medication = &medication
+	}
+	if values.Has("include_shared") {
+		includeShared := values.Get("include_shared")
+		r.IncludeShared = &includeShared
+	}
+	if asOf := values.Get("as_of"); asOf != "" {
+		r.AsOf = &asOf
+	}
+	if sort := values.Get("sort"); sort != "" {
+		r.Sort = &sort
+	}
+	if order := values.Get("order"); order != "" {
+		r.Order = &order
+	}
+	if values.Has("limit") {
+		limit := values.Get("limit")
+		r.Limit = &limit
+	}
+	if cursor := values.Get("cursor"); cursor != "" {
+		r.Cursor = &cursor
+	}
 	return nil
 }
 
@@ -46,6 +92,28 @@ func (r *ReminderListRequest) Validate() error {
 	if (r.StartDate != nil && !utils.IsValidDateTime(*r.StartDate)) || (r.EndDate != nil && !utils.IsValidDateTime(*r.EndDate)) {
 		errors = append(errors, &ErrInvalidDateFormat{})
 	}
+	if r.IncludeShared != nil {
+		if _, err := strconv.ParseBool(*r.IncludeShared); err != nil {
+			errors = append(errors, &ErrInvalidIncludeShared{})
+		}
+	}
+	if r.AsOf != nil && !utils.IsValidDateTime(*r.AsOf) {
+		errors = append(errors, &ErrInvalidAsOf{})
+	}
+	if r.Sort != nil && !models.IsValidReminderSort(*r.Sort) {
+		errors = append(errors, &ErrInvalidSort{})
+	}
+	if r.Sort != nil && *r.Sort == models.ReminderSortRelevance && r.Search == nil {
+		errors = append(errors, &ErrSearchRequired{})
+	}
+	if r.Order != nil && *r.Order != "asc" && *r.Order != "desc" {
+		errors = append(errors, &ErrInvalidOrder{})
+	}
+	if r.Limit != nil {
+		if limit, err := strconv.Atoi(*r.Limit); err != nil || limit < 1 || limit > MaxReminderListLimit {
+			errors = append(errors, &ErrInvalidLimit{Max: MaxReminderListLimit})
+		}
+	}
 	if len(errors) > 0 {
 		return &ErrBadRequest{Errs: errors}
 	}
@@ -62,9 +130,37 @@ func (r *ReminderListRequest) ToDomain() *domain.ReminderListDomain {
 		ed, _ := utils.ParseDateTime(*r.EndDate)
 		endDate = &ed
 	}
+	includeShared := false
+	if r.IncludeShared != nil {
+		includeShared, _ = strconv.ParseBool(*r.IncludeShared)
+	}
+	// Searches list the best matches first unless asked otherwise.
+	sort := models.ReminderSortStartAt
+	if r.Sort != nil {
+		sort = *r.Sort
+	} else if r.Search != nil {
+		sort = models.ReminderSortRelevance
+	}
+	limit := DefaultReminderListLimit
+	if r.Limit != nil {
+		limit, _ = strconv.Atoi(*r.Limit)
+	}
+	var asOf *time.Time
+	if r.AsOf != nil {
+		a, _ := utils.ParseDateTime(*r.AsOf)
+		asOf = &a
+	}
 	return &domain.ReminderListDomain{
-		StartDate: startDate,
-		EndDate:   endDate,
-		Search:    r.Search,
+		UserID:        r.UserID,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		Search:        r.Search,
+		Medication:    r.Medication,
+		IncludeShared: includeShared,
+		Sort:          sort,
+		Descending:    r.Order != nil && *r.Order == "desc",
+		Limit:         limit,
+		Cursor:        r.Cursor,
+		AsOf:          asOf,
 	}
 }
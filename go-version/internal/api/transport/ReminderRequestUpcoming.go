@@ -0,0 +1,87 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultUpcomingLimit and MaxUpcomingLimit are the default and largest
+	// number of upcoming occurrences listed.
+	DefaultUpcomingLimit = 10
+	MaxUpcomingLimit     = 100
+)
+
+type ReminderUpcomingRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoURLParams
+
+	// Query Params
+	Limit *string `json:"limit"`
+	// AsOf is when occurrences are listed from, now by default.
+	AsOf *string `json:"as_of"`
+	// IncludeShared adds the reminders shared with the user as a caregiver.
+	IncludeShared *string `json:"include_shared"`
+}
+
+func (r *ReminderUpcomingRequest) ParseFromQuery(values url.Values) error {
+	if values.Has("limit") {
+		limit := values.Get("limit")
+		r.Limit = &limit
+	}
+	if asOf := values.Get("as_of"); asOf != "" {
+		r.AsOf = &asOf
+	}
+	if values.Has("include_shared") {
+		includeShared := values.Get("include_shared")
+		r.IncludeShared = &includeShared
+	}
+	return nil
+}
+
+func (r *ReminderUpcomingRequest) Validate() error {
+	var errors []error
+	if r.Limit != nil {
+		if limit, err := strconv.Atoi(*r.Limit); err != nil || limit < 1 || limit > MaxUpcomingLimit {
+			errors = append(errors, &ErrInvalidLimit{Max: MaxUpcomingLimit})
+		}
+	}
+	if r.AsOf != nil && !utils.IsValidDateTime(*r.AsOf) {
+		errors = append(errors, &ErrInvalidAsOf{})
+	}
+	if r.IncludeShared != nil {
+		if _, err := strconv.ParseBool(*r.IncludeShared); err != nil {
+			errors = append(errors, &ErrInvalidIncludeShared{})
+		}
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *ReminderUpcomingRequest) ToDomain() *domain.ReminderUpcomingDomain {
+	limit := DefaultUpcomingLimit
+	if r.Limit != nil {
+		limit, _ = strconv.Atoi(*r.Limit)
+	}
+	var asOf *time.Time
+	if r.AsOf != nil {
+		a, _ := utils.ParseDateTime(*r.AsOf)
+		asOf = &a
+	}
+	includeShared := false
+	if r.IncludeShared != nil {
+		includeShared, _ = strconv.ParseBool(*r.IncludeShared)
+	}
+	return &domain.ReminderUpcomingDomain{
+		UserID:        r.UserID,
+		AsOf:          asOf,
+		IncludeShared: includeShared,
+		Limit:         limit,
+	}
+}
@@ -21,6 +21,11 @@ type ReminderUpdateRequest struct {
 	RRule       *string `json:"rrule" db:"rrule"`
 	Description *string `json:"description" db:"description"`
 	StartAt     *string `json:"start_at" db:"start_at"`
+	Critical    *bool   `json:"critical" db:"critical"`
+
+	// Medication replaces the reminder's medication, or removes it when
+	// null.
+	Medication OptionalMedication `json:"medication" db:"-"`
 }
 
 func (r *ReminderUpdateRequest) ParseFromBody(req *http.Request) error {
@@ -47,8 +52,12 @@ func (r *ReminderUpdateRequest) Validate() error {
 		errors = append(errors, &ErrInvalidStartAt{})
 	}
 
+	if r.Medication.Value != nil {
+		errors = append(errors, r.Medication.Value.Validate()...)
+	}
+
 	// at least one field must be supplied
-	if r.RRule == nil && r.Description == nil && r.StartAt == nil {
+	if r.RRule == nil && r.Description == nil && r.StartAt == nil && r.Critical == nil && !r.Medication.Set {
 		errors = append(errors, &ErrNoFieldsToUpdate{})
 	}
 
@@ -65,10 +74,18 @@ func (r *ReminderUpdateRequest) ToDomain() *domain.ReminderUpdateDomain {
 		sa, _ := utils.ParseDateTime(*r.StartAt)
 		startAt = &sa
 	}
+	var medication *domain.MedicationDomain
+	if r.Medication.Value != nil {
+		medication = r.Medication.Value.ToDomain()
+	}
 	return &domain.ReminderUpdateDomain{
-		ReminderID:  r.ReminderID,
-		RRule:       r.RRule,
-		Description: r.Description,
-		StartAt:     startAt,
+		UserID:           r.UserID,
+		ReminderID:       r.ReminderID,
+		RRule:            r.RRule,
+		Description:      r.Description,
+		StartAt:          startAt,
+		Critical:         r.Critical,
+		Medication:       medication,
+		RemoveMedication: r.Medication.Set && r.Medication.Value == nil,
 	}
 }
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ReminderSupplyDeleteRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+}
+
+func (r *ReminderSupplyDeleteRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *ReminderSupplyDeleteRequest) Validate() error {
+	return nil
+}
+
+func (r *ReminderSupplyDeleteRequest) ToDomain() *domain.ReminderSupplyDeleteDomain {
+	return &domain.ReminderSupplyDeleteDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+	}
+}
@@ -0,0 +1,34 @@
+package transport
+
+import (
+	"net/http"
+
+	"go-version/internal/api/domain"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type ReminderSupplyGetRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+}
+
+func (r *ReminderSupplyGetRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *ReminderSupplyGetRequest) Validate() error {
+	return nil
+}
+
+func (r *ReminderSupplyGetRequest) ToDomain() *domain.ReminderSupplyGetDomain {
+	return &domain.ReminderSupplyGetDomain{
+		UserID:     r.UserID,
+		ReminderID: r.ReminderID,
+	}
+}
@@ -0,0 +1,73 @@
+package transport
+
+import (
+	"encoding/json"
+	"go-version/internal/api/domain"
+	"go-version/internal/api/models"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const maxRefillThresholdDays = 365
+
+// maxSupplyQuantity is the most a supply can hold, far more than any
+// prescription, so its run-out can be walked out.
+const maxSupplyQuantity = 1_000_000
+
+type ReminderSupplySetRequest struct {
+	UserIDContext
+	NoQueryParams
+
+	// URL Params
+	ReminderID string `json:"-" db:"-"`
+
+	// Request Body
+	Quantity            *float64 `json:"quantity"`
+	DosePerOccurrence   *float64 `json:"dose_per_occurrence"`
+	RefillThresholdDays *int     `json:"refill_threshold_days"`
+}
+
+func (r *ReminderSupplySetRequest) ParseFromBody(req *http.Request) error {
+	return json.NewDecoder(req.Body).Decode(r)
+}
+
+func (r *ReminderSupplySetRequest) ParseFromURLParams(req *http.Request) error {
+	r.ReminderID = chi.URLParam(req, "reminderId")
+	return nil
+}
+
+func (r *ReminderSupplySetRequest) Validate() error {
+	var errors []error
+	if r.Quantity == nil || *r.Quantity < 0 || *r.Quantity > maxSupplyQuantity {
+		errors = append(errors, &ErrInvalidSupply{Reason: "quantity must be between 0 and 1000000"})
+	}
+	if r.DosePerOccurrence != nil && *r.DosePerOccurrence <= 0 {
+		errors = append(errors, &ErrInvalidSupply{Reason: "dose_per_occurrence must be greater than 0"})
+	}
+	if r.RefillThresholdDays != nil && (*r.RefillThresholdDays < 0 || *r.RefillThresholdDays > maxRefillThresholdDays) {
+		errors = append(errors, &ErrInvalidSupply{Reason: "refill_threshold_days must be between 0 and 365"})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *ReminderSupplySetRequest) ToDomain() *domain.ReminderSupplySetDomain {
+	dosePerOccurrence := 1.0
+	if r.DosePerOccurrence != nil {
+		dosePerOccurrence = *r.DosePerOccurrence
+	}
+	refillThresholdDays := models.DefaultRefillThresholdDays
+	if r.RefillThresholdDays != nil {
+		refillThresholdDays = *r.RefillThresholdDays
+	}
+	return &domain.ReminderSupplySetDomain{
+		UserID:              r.UserID,
+		ReminderID:          r.ReminderID,
+		Quantity:            *r.Quantity,
+		DosePerOccurrence:   dosePerOccurrence,
+		RefillThresholdDays: refillThresholdDays,
+	}
+}
@@ -0,0 +1,69 @@
+package transport
+
+import (
+	"go-version/internal/api/domain"
+	"go-version/internal/api/utils"
+	"net/url"
+)
+
+// maxTimelineDays bounds the range of a timeline, since every occurrence of
+// every reminder within it is listed.
+const maxTimelineDays = 92
+
+type TimelineGetRequest struct {
+	UserIDContext
+	NoRequestBody
+	NoURLParams
+
+	// Query Params
+	StartDate *string `json:"start_date"`
+	EndDate   *string `json:"end_date"`
+}
+
+func (r *TimelineGetRequest) ParseFromQuery(values url.Values) error {
+	startDate := values.Get("start_date")
+	endDate := values.Get("end_date")
+
+	if startDate != "" {
+		r.StartDate = &startDate
+	}
+	if endDate != "" {
+		r.EndDate = &endDate
+	}
+	return nil
+}
+
+func (r *TimelineGetRequest) Validate() error {
+	var errors []error
+	if r.StartDate == nil {
+		errors = append(errors, &ErrStartDateRequired{})
+	}
+	if r.EndDate == nil {
+		errors = append(errors, &ErrEndDateRequired{})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+
+	startDate, startErr := utils.ParseDateTime(*r.StartDate)
+	endDate, endErr := utils.ParseDateTime(*r.EndDate)
+	if startErr != nil || endErr != nil {
+		errors = append(errors, &ErrInvalidDateFormat{})
+	} else if !endDate.After(startDate) || endDate.Sub(startDate).Hours() > maxTimelineDays*24 {
+		errors = append(errors, &ErrInvalidDateRange{MaxDays: maxTimelineDays})
+	}
+	if len(errors) > 0 {
+		return &ErrBadRequest{Errs: errors}
+	}
+	return nil
+}
+
+func (r *TimelineGetRequest) ToDomain() *domain.TimelineDomain {
+	startDate, _ := utils.ParseDateTime(*r.StartDate)
+	endDate, _ := utils.ParseDateTime(*r.EndDate)
+	return &domain.TimelineDomain{
+		UserID:    r.UserID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+}
@@ -1,6 +1,11 @@
 package transport
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+
+	"go-version/internal/api/models"
+)
 
 type ErrBadRequest struct {
 	Errs []error
@@ -94,3 +99,339 @@ type ErrInvalidDateFormat struct{}
 func (e *ErrInvalidDateFormat) Error() string {
 	return "date is not in a valid datetime format"
 }
+
+type ErrEndpointRequired struct{}
+
+func (e *ErrEndpointRequired) Error() string {
+	return "endpoint is required"
+}
+
+type ErrSubscriptionKeysRequired struct{}
+
+func (e *ErrSubscriptionKeysRequired) Error() string {
+	return "keys.p256dh and keys.auth are required"
+}
+
+type ErrDeviceTokenRequired struct{}
+
+func (e *ErrDeviceTokenRequired) Error() string {
+	return "token is required"
+}
+
+type ErrPlatformRequired struct{}
+
+func (e *ErrPlatformRequired) Error() string {
+	return "platform is required"
+}
+
+type ErrInvalidPlatform struct{}
+
+func (e *ErrInvalidPlatform) Error() string {
+	return "platform must be one of: ios, android"
+}
+
+type ErrInvalidEmail struct{}
+
+func (e *ErrInvalidEmail) Error() string {
+	return "email is not a valid email address"
+}
+
+type ErrEscalationStepsRequired struct{}
+
+func (e *ErrEscalationStepsRequired) Error() string {
+	return "at least one escalation step is required"
+}
+
+type ErrTooManyEscalationSteps struct {
+	Max int
+}
+
+func (e *ErrTooManyEscalationSteps) Error() string {
+	return fmt.Sprintf("at most %d escalation steps are allowed", e.Max)
+}
+
+type ErrInvalidEscalationStep struct {
+	Index  int
+	Reason string
+}
+
+func (e *ErrInvalidEscalationStep) Error() string {
+	return fmt.Sprintf("steps[%d]: %s", e.Index, e.Reason)
+}
+
+type ErrOccurrenceAtRequired struct{}
+
+func (e *ErrOccurrenceAtRequired) Error() string {
+	return "occurrence_at is required"
+}
+
+type ErrStartTimeRequired struct{}
+
+func (e *ErrStartTimeRequired) Error() string {
+	return "start_time is required"
+}
+
+type ErrEndTimeRequired struct{}
+
+func (e *ErrEndTimeRequired) Error() string {
+	return "end_time is required"
+}
+
+type ErrInvalidClockTime struct {
+	Field string
+}
+
+func (e *ErrInvalidClockTime) Error() string {
+	return e.Field + " must be a 24-hour time in HH:MM format"
+}
+
+type ErrEmptyQuietHours struct{}
+
+func (e *ErrEmptyQuietHours) Error() string {
+	return "start_time and end_time must differ"
+}
+
+type ErrTimeZoneRequired struct{}
+
+func (e *ErrTimeZoneRequired) Error() string {
+	return "time_zone is required"
+}
+
+type ErrInvalidTimeZone struct{}
+
+func (e *ErrInvalidTimeZone) Error() string {
+	return "time_zone must be an IANA time zone name"
+}
+
+type ErrFrequencyRequired struct{}
+
+func (e *ErrFrequencyRequired) Error() string {
+	return "frequency is required"
+}
+
+type ErrInvalidFrequency struct{}
+
+func (e *ErrInvalidFrequency) Error() string {
+	return "frequency must be one of: daily, weekly"
+}
+
+type ErrSendTimeRequired struct{}
+
+func (e *ErrSendTimeRequired) Error() string {
+	return "send_time is required"
+}
+
+type ErrInvalidWeekday struct{}
+
+func (e *ErrInvalidWeekday) Error() string {
+	return "weekday must be a lowercase day name such as monday"
+}
+
+type ErrChannelRequired struct{}
+
+func (e *ErrChannelRequired) Error() string {
+	return "channel is required"
+}
+
+type ErrInvalidChannel struct{}
+
+func (e *ErrInvalidChannel) Error() string {
+	return "channel must be one of: web_push, mobile_push"
+}
+
+type ErrFeedTokenRequired struct{}
+
+func (e *ErrFeedTokenRequired) Error() string {
+	return "feed token is required"
+}
+
+type ErrCalendarRequired struct{}
+
+func (e *ErrCalendarRequired) Error() string {
+	return "a text/calendar request body is required"
+}
+
+type ErrCalendarTooLarge struct {
+	Max int
+}
+
+func (e *ErrCalendarTooLarge) Error() string {
+	return fmt.Sprintf("calendar must be at most %d bytes", e.Max)
+}
+
+type ErrInvalidDryRun struct{}
+
+func (e *ErrInvalidDryRun) Error() string {
+	return "dry_run must be true or false"
+}
+
+type ErrInvalidDepth struct{}
+
+func (e *ErrInvalidDepth) Error() string {
+	return "Depth must be 0, 1 or infinity"
+}
+
+type ErrReportRequired struct{}
+
+func (e *ErrReportRequired) Error() string {
+	return "a calendar-query or calendar-multiget request body is required"
+}
+
+type ErrInvalidPatient struct{}
+
+func (e *ErrInvalidPatient) Error() string {
+	return "patient must be a patient id or a Patient reference"
+}
+
+type ErrFHIRResourceRequired struct{}
+
+func (e *ErrFHIRResourceRequired) Error() string {
+	return "a MedicationRequest or Bundle request body is required"
+}
+
+type ErrFHIRResourceTooLarge struct {
+	Max int
+}
+
+func (e *ErrFHIRResourceTooLarge) Error() string {
+	return fmt.Sprintf("request body must be at most %d bytes", e.Max)
+}
+
+type ErrInvalidFHIRResource struct {
+	Reason string
+}
+
+func (e *ErrInvalidFHIRResource) Error() string {
+	return "invalid FHIR resource: " + e.Reason
+}
+
+type ErrInvalidMedication struct {
+	Reason string
+}
+
+func (e *ErrInvalidMedication) Error() string {
+	return "medication is invalid: " + e.Reason
+}
+
+type ErrInvalidSupply struct {
+	Reason string
+}
+
+func (e *ErrInvalidSupply) Error() string {
+	return "supply is invalid: " + e.Reason
+}
+
+type ErrInvalidDose struct {
+	Reason string
+}
+
+func (e *ErrInvalidDose) Error() string {
+	return "dose is invalid: " + e.Reason
+}
+
+type ErrCheckInQuestionsRequired struct{}
+
+func (e *ErrCheckInQuestionsRequired) Error() string {
+	return "at least one question is required"
+}
+
+type ErrTooManyCheckInQuestions struct {
+	Max int
+}
+
+func (e *ErrTooManyCheckInQuestions) Error() string {
+	return fmt.Sprintf("at most %d questions are allowed", e.Max)
+}
+
+type ErrInvalidCheckInQuestion struct {
+	Index  int
+	Reason string
+}
+
+func (e *ErrInvalidCheckInQuestion) Error() string {
+	return fmt.Sprintf("questions[%d]: %s", e.Index, e.Reason)
+}
+
+type ErrCheckInAnswersRequired struct{}
+
+func (e *ErrCheckInAnswersRequired) Error() string {
+	return "answers is required"
+}
+
+type ErrInvalidJournalEntry struct {
+	Reason string
+}
+
+func (e *ErrInvalidJournalEntry) Error() string {
+	return "journal entry is invalid: " + e.Reason
+}
+
+type ErrInvalidDateRange struct {
+	MaxDays int
+}
+
+func (e *ErrInvalidDateRange) Error() string {
+	return fmt.Sprintf("end_date must be after start_date and at most %d days later", e.MaxDays)
+}
+
+type ErrInvalidAsOf struct{}
+
+func (e *ErrInvalidAsOf) Error() string {
+	return "as_of must be a valid date and time"
+}
+
+type ErrInvalidIncludeShared struct{}
+
+func (e *ErrInvalidIncludeShared) Error() string {
+	return "include_shared must be true or false"
+}
+
+type ErrInvalidLimit struct {
+	Max int
+}
+
+func (e *ErrInvalidLimit) Error() string {
+	return fmt.Sprintf("limit must be a number between 1 and %d", e.Max)
+}
+
+type ErrInvalidSort struct{}
+
+func (e *ErrInvalidSort) Error() string {
+	return "sort must be one of: " + strings.Join(models.ReminderSorts, ", ")
+}
+
+type ErrSearchRequired struct{}
+
+func (e *ErrSearchRequired) Error() string {
+	return "search is required to sort by relevance"
+}
+
+type ErrInvalidOrder struct{}
+
+func (e *ErrInvalidOrder) Error() string {
+	return "order must be asc or desc"
+}
+
+type ErrInvalidCaregiverInvitation struct {
+	Reason string
+}
+
+func (e *ErrInvalidCaregiverInvitation) Error() string {
+	return "caregiver invitation is invalid: " + e.Reason
+}
+
+type ErrInvalidOrganization struct {
+	Reason string
+}
+
+func (e *ErrInvalidOrganization) Error() string {
+	return "organization is invalid: " + e.Reason
+}
+
+type ErrInvalidOrganizationMember struct {
+	Reason string
+}
+
+func (e *ErrInvalidOrganizationMember) Error() string {
+	return "organization member is invalid: " + e.Reason
+}
@@ -2,8 +2,10 @@ package transport
 
 import (
 	"go-version/internal/contextkeys"
+	"mime"
 	"net/http"
 	"net/url"
+	"slices"
 )
 
 type RequestParser[T any] interface {
@@ -17,6 +19,17 @@ type RequestParser[T any] interface {
 
 type BaseRequest struct{}
 
+// bodyMediaTypes is implemented by requests whose body is not JSON.
+type bodyMediaTypes interface {
+	BodyMediaTypes() []string
+}
+
+// headerParser is implemented by requests that read request headers, such
+// as conditional request preconditions.
+type headerParser interface {
+	ParseFromHeaders(header http.Header) error
+}
+
 func ParseRequest[T any, P RequestParser[T]](r *http.Request, req P) error {
 	if err := req.ParseFromURLParams(r); err != nil {
 		return err
@@ -28,7 +41,18 @@ func ParseRequest[T any, P RequestParser[T]](r *http.Request, req P) error {
 		}
 	}
 
-	if r.Method != "GET" && r.Method != "DELETE" && r.Header.Get("Content-Type") == "application/json" {
+	if h, ok := any(req).(headerParser); ok {
+		if err := h.ParseFromHeaders(r.Header); err != nil {
+			return err
+		}
+	}
+
+	expected := []string{"application/json"}
+	if b, ok := any(req).(bodyMediaTypes); ok {
+		expected = b.BodyMediaTypes()
+	}
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if r.Method != "GET" && r.Method != "DELETE" && slices.Contains(expected, mediaType) {
 		req.ParseFromBody(r)
 	}
 
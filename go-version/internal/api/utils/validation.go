@@ -1,22 +1,59 @@
 package utils
 
-import "github.com/teambition/rrule-go"
+import (
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
 
-func IsValidRRule(rruleStr string) bool {
-	rrule, err := rrule.StrToRRule(rruleStr)
+	"github.com/teambition/rrule-go"
+)
 
-	// Basic validation checks needed because rrule-go is lenient
-	if rrule != nil && rrule.Options.Count < 1 {
+// IsValidRRule reports whether rruleStr parses as a recurrence rule. Rules
+// need not end; whether the series they describe is acceptable is left to
+// recurrence.Check.
+func IsValidRRule(rruleStr string) bool {
+	if _, err := rrule.StrToRRule(rruleStr); err != nil {
 		return false
 	}
-	if rrule != nil && rrule.Options.Interval < 1 {
-		return false
+
+	// Basic validation checks needed because rrule-go is lenient, and reads
+	// a COUNT or INTERVAL below 1 as if it were left out
+	for _, part := range strings.Split(strings.ToUpper(rruleStr), ";") {
+		name, value, _ := strings.Cut(part, "=")
+		if name != "COUNT" && name != "INTERVAL" {
+			continue
+		}
+		if n, err := strconv.Atoi(value); err != nil || n < 1 {
+			return false
+		}
 	}
 
-	return err == nil
+	return true
 }
 
 func IsValidDateTime(dateTimeStr string) bool {
 	_, err := ParseDateTime(dateTimeStr)
 	return err == nil
 }
+
+func IsValidEmail(email string) bool {
+	address, err := mail.ParseAddress(email)
+	return err == nil && address.Address == email
+}
+
+// IsValidClockTime reports whether s is a 24-hour "HH:MM" time of day.
+func IsValidClockTime(s string) bool {
+	_, err := time.Parse("15:04", s)
+	return err == nil && len(s) == len("15:04")
+}
+
+// IsValidTimeZone reports whether s names an IANA time zone such as
+// "Europe/Amsterdam".
+func IsValidTimeZone(s string) bool {
+	if s == "" || s == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(s)
+	return err == nil
+}
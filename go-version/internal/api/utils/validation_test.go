@@ -32,6 +32,17 @@ func TestIsValidRRule(t *testing.T) {
 			expectValid: true,
 		},
 
+		{
+			name:        "valid until",
+			input:       "FREQ=WEEKLY;BYDAY=TU;UNTIL=20301231T000000Z",
+			expectValid: true,
+		},
+		{
+			name:        "valid without end",
+			input:       "FREQ=DAILY;BYHOUR=8,20",
+			expectValid: true,
+		},
+
 		// Invalid RRule strings
 		{
 			name:        "invalid frequency",
@@ -112,3 +123,49 @@ func TestIsValidRRule(t *testing.T) {
 		})
 	}
 }
+
+func TestIsValidClockTime(t *testing.T) {
+	testCases := []struct {
+		input       string
+		expectValid bool
+	}{
+		{"00:00", true},
+		{"07:30", true},
+		{"23:59", true},
+		{"24:00", false},
+		{"7:30", false},
+		{"07:60", false},
+		{"07:30:00", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			if valid := IsValidClockTime(tc.input); valid != tc.expectValid {
+				t.Errorf("IsValidClockTime(%q) = %v; want %v", tc.input, valid, tc.expectValid)
+			}
+		})
+	}
+}
+
+func TestIsValidTimeZone(t *testing.T) {
+	testCases := []struct {
+		input       string
+		expectValid bool
+	}{
+		{"UTC", true},
+		{"Europe/Amsterdam", true},
+		{"America/New_York", true},
+		{"Mars/Olympus_Mons", false},
+		{"Local", false},
+		{"", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			if valid := IsValidTimeZone(tc.input); valid != tc.expectValid {
+				t.Errorf("IsValidTimeZone(%q) = %v; want %v", tc.input, valid, tc.expectValid)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+// Package caldav reads and writes the WebDAV and CalDAV (RFC 4918, RFC 4791)
+// XML bodies needed to serve reminders as a calendar collection.
+package caldav
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	NamespaceDAV            = "DAV:"
+	NamespaceCalDAV         = "urn:ietf:params:xml:ns:caldav"
+	NamespaceCalendarServer = "http://calendarserver.org/ns/"
+
+	ReportCalendarQuery    = "calendar-query"
+	ReportCalendarMultiget = "calendar-multiget"
+
+	timeRangeLayout = "20060102T150405Z"
+)
+
+// Properties defined by the specifications, plus the calendar server
+// extension that clients use to detect collection changes.
+var (
+	PropResourceType                  = xml.Name{Space: NamespaceDAV, Local: "resourcetype"}
+	PropDisplayName                   = xml.Name{Space: NamespaceDAV, Local: "displayname"}
+	PropCurrentUserPrincipal          = xml.Name{Space: NamespaceDAV, Local: "current-user-principal"}
+	PropPrincipalURL                  = xml.Name{Space: NamespaceDAV, Local: "principal-URL"}
+	PropCurrentUserPrivilegeSet       = xml.Name{Space: NamespaceDAV, Local: "current-user-privilege-set"}
+	PropSupportedReportSet            = xml.Name{Space: NamespaceDAV, Local: "supported-report-set"}
+	PropGetETag                       = xml.Name{Space: NamespaceDAV, Local: "getetag"}
+	PropGetContentType                = xml.Name{Space: NamespaceDAV, Local: "getcontenttype"}
+	PropCalendarHomeSet               = xml.Name{Space: NamespaceCalDAV, Local: "calendar-home-set"}
+	PropSupportedCalendarComponentSet = xml.Name{Space: NamespaceCalDAV, Local: "supported-calendar-component-set"}
+	PropCalendarData                  = xml.Name{Space: NamespaceCalDAV, Local: "calendar-data"}
+	PropGetCTag                       = xml.Name{Space: NamespaceCalendarServer, Local: "getctag"}
+)
+
+var prefixes = map[string]string{
+	NamespaceDAV:            "D",
+	NamespaceCalDAV:         "C",
+	NamespaceCalendarServer: "CS",
+}
+
+// ErrMalformedBody is returned when a request body is not the XML the
+// method expects.
+type ErrMalformedBody struct {
+	Reason string
+}
+
+func (e *ErrMalformedBody) Error() string {
+	return "malformed request body: " + e.Reason
+}
+
+// Prop is a property value. Value holds the property's content as XML and
+// must already be escaped; use Text for plain values.
+type Prop struct {
+	Name  xml.Name
+	Value string
+}
+
+// Text returns s escaped for use as a Prop value.
+func Text(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// Href returns an href element for path.
+func Href(path string) string {
+	return "<D:href>" + Text(path) + "</D:href>"
+}
+
+// Element returns an empty element such as a resource type or privilege.
+func Element(name xml.Name) string {
+	return "<" + qualify(name) + "/>"
+}
+
+// Response describes one resource in a multistatus body. Found properties
+// are reported with status 200 and NotFound ones with status 404.
+type Response struct {
+	Href     string
+	Found    []Prop
+	NotFound []xml.Name
+}
+
+// Select splits props into those requested and those requested but missing.
+// A nil request selects every property.
+func Select(props []Prop, requested []xml.Name) (found []Prop, notFound []xml.Name) {
+	if requested == nil {
+		return props, nil
+	}
+	for _, name := range requested {
+		ok := false
+		for _, prop := range props {
+			if prop.Name == name {
+				found = append(found, prop)
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			notFound = append(notFound, name)
+		}
+	}
+	return found, notFound
+}
+
+// WriteMultistatus writes a 207 Multi-Status body.
+func WriteMultistatus(w io.Writer, responses []Response) error {
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString(`<?xml version="1.0" encoding="utf-8"?>` + "\n")
+	bw.WriteString(`<D:multistatus xmlns:D="DAV:" xmlns:C="` + NamespaceCalDAV + `" xmlns:CS="` + NamespaceCalendarServer + `">`)
+	for _, response := range responses {
+		bw.WriteString("<D:response>")
+		bw.WriteString(Href(response.Href))
+		if len(response.Found) > 0 || len(response.NotFound) == 0 {
+			bw.WriteString("<D:propstat><D:prop>")
+			for _, prop := range response.Found {
+				name := qualify(prop.Name)
+				bw.WriteString("<" + name + ">" + prop.Value + "</" + name + ">")
+			}
+			bw.WriteString("</D:prop><D:status>HTTP/1.1 200 OK</D:status></D:propstat>")
+		}
+		if len(response.NotFound) > 0 {
+			bw.WriteString("<D:propstat><D:prop>")
+			for _, name := range response.NotFound {
+				bw.WriteString(Element(name))
+			}
+			bw.WriteString("</D:prop><D:status>HTTP/1.1 404 Not Found</D:status></D:propstat>")
+		}
+		bw.WriteString("</D:response>")
+	}
+	bw.WriteString("</D:multistatus>")
+
+	return bw.Flush()
+}
+
+// WriteError writes a DAV:error body naming the precondition that failed.
+func WriteError(w http.ResponseWriter, status int, condition xml.Name, description string) {
+	w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	w.WriteHeader(status)
+	io.WriteString(w, `<?xml version="1.0" encoding="utf-8"?>`+"\n")
+	io.WriteString(w, `<D:error xmlns:D="DAV:" xmlns:C="`+NamespaceCalDAV+`">`)
+	io.WriteString(w, Element(condition))
+	if description != "" {
+		io.WriteString(w, "<D:responsedescription>"+Text(description)+"</D:responsedescription>")
+	}
+	io.WriteString(w, "</D:error>")
+}
+
+// qualify returns the prefixed name of an element. Names outside the known
+// namespaces declare their namespace inline.
+func qualify(name xml.Name) string {
+	if prefix, ok := prefixes[name.Space]; ok {
+		return prefix + ":" + name.Local
+	}
+	return `X:` + name.Local + ` xmlns:X="` + Text(name.Space) + `"`
+}
+
+// Propfind is a parsed PROPFIND body. Props is nil when all properties are
+// requested.
+type Propfind struct {
+	Props []xml.Name
+}
+
+type propfindXML struct {
+	XMLName xml.Name  `xml:"DAV: propfind"`
+	AllProp *struct{} `xml:"DAV: allprop"`
+	Prop    *propXML  `xml:"DAV: prop"`
+}
+
+type propXML struct {
+	Names []nameXML `xml:",any"`
+}
+
+type nameXML struct {
+	XMLName xml.Name
+}
+
+func (p *propXML) names() []xml.Name {
+	names := make([]xml.Name, len(p.Names))
+	for i, name := range p.Names {
+		names[i] = name.XMLName
+	}
+	return names
+}
+
+// ParsePropfind parses a PROPFIND body. An empty body requests all
+// properties.
+func ParsePropfind(r io.Reader) (*Propfind, error) {
+	var body propfindXML
+	if err := xml.NewDecoder(r).Decode(&body); err != nil {
+		if errors.Is(err, io.EOF) {
+			return &Propfind{}, nil
+		}
+		return nil, &ErrMalformedBody{Reason: err.Error()}
+	}
+
+	if body.Prop == nil || body.AllProp != nil {
+		return &Propfind{}, nil
+	}
+	return &Propfind{Props: body.Prop.names()}, nil
+}
+
+// Report is a parsed calendar-query or calendar-multiget REPORT body.
+type Report struct {
+	Kind  string
+	Props []xml.Name
+	// Hrefs lists the resources of a calendar-multiget.
+	Hrefs []string
+	// Component is the component type a calendar-query filters on, if any.
+	Component string
+	// Start and End bound a calendar-query time-range filter. Either may be
+	// zero when the range is open.
+	Start time.Time
+	End   time.Time
+}
+
+type reportXML struct {
+	XMLName xml.Name
+	Prop    *propXML   `xml:"DAV: prop"`
+	Hrefs   []string   `xml:"DAV: href"`
+	Filter  *filterXML `xml:"urn:ietf:params:xml:ns:caldav filter"`
+}
+
+type filterXML struct {
+	CompFilter *compFilterXML `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type compFilterXML struct {
+	Name       string         `xml:"name,attr"`
+	TimeRange  *timeRangeXML  `xml:"urn:ietf:params:xml:ns:caldav time-range"`
+	CompFilter *compFilterXML `xml:"urn:ietf:params:xml:ns:caldav comp-filter"`
+}
+
+type timeRangeXML struct {
+	Start string `xml:"start,attr"`
+	End   string `xml:"end,attr"`
+}
+
+// ParseReport parses a REPORT body. Only calendar-query and
+// calendar-multiget are supported.
+func ParseReport(r io.Reader) (*Report, error) {
+	var body reportXML
+	if err := xml.NewDecoder(r).Decode(&body); err != nil {
+		return nil, &ErrMalformedBody{Reason: err.Error()}
+	}
+
+	if body.XMLName.Space != NamespaceCalDAV ||
+		(body.XMLName.Local != ReportCalendarQuery && body.XMLName.Local != ReportCalendarMultiget) {
+		return nil, &ErrMalformedBody{Reason: "unsupported report " + body.XMLName.Local}
+	}
+
+	report := &Report{Kind: body.XMLName.Local, Hrefs: body.Hrefs}
+	if body.Prop != nil {
+		report.Props = body.Prop.names()
+	}
+
+	// A calendar-query filters on VCALENDAR, and optionally on one of its
+	// component types within it.
+	if body.Filter != nil && body.Filter.CompFilter != nil {
+		comp := body.Filter.CompFilter.CompFilter
+		if comp != nil {
+			report.Component = strings.ToUpper(comp.Name)
+			if comp.TimeRange != nil {
+				var err error
+				if report.Start, err = parseTimeRange(comp.TimeRange.Start); err != nil {
+					return nil, err
+				}
+				if report.End, err = parseTimeRange(comp.TimeRange.End); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func parseTimeRange(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(timeRangeLayout, value)
+	if err != nil {
+		return time.Time{}, &ErrMalformedBody{Reason: "time-range must be a UTC date-time"}
+	}
+	return t, nil
+}
@@ -0,0 +1,153 @@
+package caldav
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParsePropfind(t *testing.T) {
+	testCases := []struct {
+		name          string
+		body          string
+		expectedProps []xml.Name
+		expectedError bool
+	}{
+		{
+			name:          "empty body requests all properties",
+			body:          "",
+			expectedProps: nil,
+		},
+		{
+			name:          "allprop",
+			body:          `<propfind xmlns="DAV:"><allprop/></propfind>`,
+			expectedProps: nil,
+		},
+		{
+			name:          "named properties",
+			body:          `<?xml version="1.0"?><d:propfind xmlns:d="DAV:" xmlns:cs="http://calendarserver.org/ns/"><d:prop><d:getetag/><cs:getctag/></d:prop></d:propfind>`,
+			expectedProps: []xml.Name{PropGetETag, PropGetCTag},
+		},
+		{
+			name:          "not a propfind",
+			body:          `<d:propertyupdate xmlns:d="DAV:"/>`,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			propfind, err := ParsePropfind(strings.NewReader(tc.body))
+
+			if tc.expectedError {
+				var malformedErr *ErrMalformedBody
+				if !errors.As(err, &malformedErr) {
+					t.Errorf("Expected ErrMalformedBody, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(propfind.Props) != len(tc.expectedProps) {
+				t.Fatalf("Expected props %v, got %v", tc.expectedProps, propfind.Props)
+			}
+			for i := range tc.expectedProps {
+				if propfind.Props[i] != tc.expectedProps[i] {
+					t.Errorf("Expected prop %v, got %v", tc.expectedProps[i], propfind.Props[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseReport(t *testing.T) {
+	t.Run("calendar-query with time range", func(t *testing.T) {
+		body := `<c:calendar-query xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+			<d:prop><d:getetag/><c:calendar-data/></d:prop>
+			<c:filter><c:comp-filter name="VCALENDAR"><c:comp-filter name="VEVENT">
+				<c:time-range start="20231001T000000Z" end="20231101T000000Z"/>
+			</c:comp-filter></c:comp-filter></c:filter>
+		</c:calendar-query>`
+
+		report, err := ParseReport(strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if report.Kind != ReportCalendarQuery || report.Component != "VEVENT" {
+			t.Errorf("Unexpected report %+v", report)
+		}
+		if len(report.Props) != 2 || report.Props[1] != PropCalendarData {
+			t.Errorf("Unexpected props %v", report.Props)
+		}
+		if !report.Start.Equal(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)) || !report.End.Equal(time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Unexpected time range %v - %v", report.Start, report.End)
+		}
+	})
+
+	t.Run("calendar-multiget", func(t *testing.T) {
+		body := `<c:calendar-multiget xmlns:d="DAV:" xmlns:c="urn:ietf:params:xml:ns:caldav">
+			<d:prop><d:getetag/></d:prop>
+			<d:href>/a.ics</d:href><d:href>/b.ics</d:href>
+		</c:calendar-multiget>`
+
+		report, err := ParseReport(strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if report.Kind != ReportCalendarMultiget || len(report.Hrefs) != 2 || report.Hrefs[1] != "/b.ics" {
+			t.Errorf("Unexpected report %+v", report)
+		}
+	})
+
+	t.Run("unsupported report", func(t *testing.T) {
+		_, err := ParseReport(strings.NewReader(`<d:sync-collection xmlns:d="DAV:"/>`))
+		var malformedErr *ErrMalformedBody
+		if !errors.As(err, &malformedErr) {
+			t.Errorf("Expected ErrMalformedBody, got %v", err)
+		}
+	})
+}
+
+func TestWriteMultistatus(t *testing.T) {
+	found, notFound := Select([]Prop{
+		{Name: PropGetETag, Value: Text(`"abc"`)},
+		{Name: PropDisplayName, Value: Text("Reminders & more")},
+	}, []xml.Name{PropGetETag, {Space: "urn:example", Local: "color"}})
+
+	var buf bytes.Buffer
+	err := WriteMultistatus(&buf, []Response{{Href: "/r/1.ics", Found: found, NotFound: notFound}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The body must be well-formed and round-trip through a namespace
+	// aware decoder.
+	var decoded struct {
+		XMLName   xml.Name `xml:"DAV: multistatus"`
+		Responses []struct {
+			Href      string `xml:"DAV: href"`
+			Propstats []struct {
+				Status string `xml:"DAV: status"`
+				ETag   string `xml:"DAV: prop>getetag"`
+			} `xml:"DAV: propstat"`
+		} `xml:"DAV: response"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Multistatus is not well-formed: %v\n%s", err, buf.String())
+	}
+
+	if len(decoded.Responses) != 1 || decoded.Responses[0].Href != "/r/1.ics" {
+		t.Fatalf("Unexpected responses %+v", decoded.Responses)
+	}
+	propstats := decoded.Responses[0].Propstats
+	if len(propstats) != 2 || propstats[0].ETag != `"abc"` || !strings.Contains(propstats[1].Status, "404") {
+		t.Errorf("Unexpected propstats %+v", propstats)
+	}
+	if strings.Contains(buf.String(), "displayname") {
+		t.Error("Expected only requested properties to be written")
+	}
+}
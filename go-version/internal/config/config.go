@@ -0,0 +1,277 @@
+// Package config loads the application configuration. Values come from, in
+// increasing order of precedence, the defaults, an optional YAML or TOML
+// file, a .env file and the environment.
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"go-version/internal/dal"
+	"go-version/internal/recurrence"
+
+	"github.com/BurntSushi/toml"
+	"github.com/joho/godotenv"
+	"github.com/teambition/rrule-go"
+	"gopkg.in/yaml.v3"
+)
+
+type Config struct {
+	Server   ServerConfig   `yaml:"server" toml:"server"`
+	Database DatabaseConfig `yaml:"database" toml:"database"`
+	Auth     AuthConfig     `yaml:"auth" toml:"auth"`
+	WebPush  WebPushConfig  `yaml:"web_push" toml:"web_push"`
+	APNs     APNsConfig     `yaml:"apns" toml:"apns"`
+	FCM      FCMConfig      `yaml:"fcm" toml:"fcm"`
+
+	Recurrence RecurrenceConfig `yaml:"recurrence" toml:"recurrence"`
+}
+
+type ServerConfig struct {
+	Host string `yaml:"host" toml:"host"`
+	Port int    `yaml:"port" toml:"port"`
+}
+
+// Addr is the address the server listens on.
+func (c ServerConfig) Addr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+type DatabaseConfig struct {
+	// Driver is sqlite or postgres.
+	Driver string `yaml:"driver" toml:"driver"`
+	// Location is a file path for SQLite and a connection string for
+	// PostgreSQL.
+	Location string `yaml:"location" toml:"location"`
+}
+
+type AuthConfig struct {
+	// JWTSigningKey signs the API keys handed out to users.
+	JWTSigningKey string `yaml:"jwt_signing_key" toml:"jwt_signing_key"`
+}
+
+// WebPushConfig configures browser push. Without a key pair, one is
+// generated and stored in the database on first start.
+type WebPushConfig struct {
+	Subject    string `yaml:"subject" toml:"subject"`
+	PublicKey  string `yaml:"public_key" toml:"public_key"`
+	PrivateKey string `yaml:"private_key" toml:"private_key"`
+}
+
+// APNsConfig configures iOS push. It is enabled when PrivateKeyPath is set.
+type APNsConfig struct {
+	BaseURL        string `yaml:"base_url" toml:"base_url"`
+	Topic          string `yaml:"topic" toml:"topic"`
+	KeyID          string `yaml:"key_id" toml:"key_id"`
+	TeamID         string `yaml:"team_id" toml:"team_id"`
+	PrivateKeyPath string `yaml:"private_key_path" toml:"private_key_path"`
+}
+
+// FCMConfig configures Android push. It is enabled when ProjectID is set.
+type FCMConfig struct {
+	BaseURL     string `yaml:"base_url" toml:"base_url"`
+	ProjectID   string `yaml:"project_id" toml:"project_id"`
+	AccessToken string `yaml:"access_token" toml:"access_token"`
+}
+
+// RecurrenceConfig caps the recurrence rules reminders may use, so that
+// checking one takes bounded time.
+type RecurrenceConfig struct {
+	// MaxFrequency is the most frequent FREQ allowed, such as MINUTELY.
+	MaxFrequency   string `yaml:"max_frequency" toml:"max_frequency"`
+	MaxOccurrences int    `yaml:"max_occurrences" toml:"max_occurrences"`
+	// HorizonDays is how soon after its start a reminder must first occur.
+	HorizonDays int `yaml:"horizon_days" toml:"horizon_days"`
+	// MaxStreamedOccurrences caps the occurrences of one streamed listing.
+	MaxStreamedOccurrences int `yaml:"max_streamed_occurrences" toml:"max_streamed_occurrences"`
+}
+
+// Limits returns the limits to check rules against. The configuration must
+// be valid.
+func (c RecurrenceConfig) Limits() recurrence.Limits {
+	frequency, _ := rrule.StrToFreq(c.MaxFrequency)
+	return recurrence.Limits{
+		MaxFrequency:   frequency,
+		MaxOccurrences: c.MaxOccurrences,
+		Horizon:        time.Duration(c.HorizonDays) * 24 * time.Hour,
+	}
+}
+
+// Default returns the configuration used when nothing overrides it.
+func Default() *Config {
+	limits := recurrence.DefaultLimits()
+	return &Config{
+		Server: ServerConfig{
+			Port: 8080,
+		},
+		Database: DatabaseConfig{
+			Driver:   dal.DriverSQLite,
+			Location: "./database.sqlite",
+		},
+		Recurrence: RecurrenceConfig{
+			MaxFrequency:           limits.MaxFrequency.String(),
+			MaxOccurrences:         limits.MaxOccurrences,
+			HorizonDays:            int(limits.Horizon.Hours() / 24),
+			MaxStreamedOccurrences: 100000,
+		},
+	}
+}
+
+// Load reads the configuration from the file, which may be empty to use
+// none, ./.env if it exists and the environment, then validates it.
+func Load(file string) (*Config, error) {
+	dotenv, err := godotenv.Read(".env")
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("reading .env: %w", err)
+	}
+
+	return load(file, func(name string) (string, bool) {
+		if value, ok := os.LookupEnv(name); ok {
+			return value, true
+		}
+		value, ok := dotenv[name]
+		return value, ok
+	})
+}
+
+func load(file string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	cfg := Default()
+
+	if file != "" {
+		if err := cfg.readFile(file); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.readEnv(lookupEnv); err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) readFile(file string) error {
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("reading config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(contents, c)
+	case ".toml":
+		err = toml.Unmarshal(contents, c)
+	default:
+		return fmt.Errorf("config file %s must be .yaml, .yml or .toml", file)
+	}
+	if err != nil {
+		return fmt.Errorf("parsing config file %s: %w", file, err)
+	}
+	return nil
+}
+
+// readEnv overrides the configuration with the environment variables that
+// are set. Empty variables count as unset, so that a .env copied from
+// .env.example does not blank out the config file.
+func (c *Config) readEnv(lookupEnv func(string) (string, bool)) error {
+	vars := map[string]*string{
+		"HOST":                         &c.Server.Host,
+		"DB_DRIVER":                    &c.Database.Driver,
+		"DB_LOCATION":                  &c.Database.Location,
+		"JWT_SUPER_SECRET_SIGNING_KEY": &c.Auth.JWTSigningKey,
+		"VAPID_SUBJECT":                &c.WebPush.Subject,
+		"VAPID_PUBLIC_KEY":             &c.WebPush.PublicKey,
+		"VAPID_PRIVATE_KEY":            &c.WebPush.PrivateKey,
+		"APNS_BASE_URL":                &c.APNs.BaseURL,
+		"APNS_TOPIC":                   &c.APNs.Topic,
+		"APNS_KEY_ID":                  &c.APNs.KeyID,
+		"APNS_TEAM_ID":                 &c.APNs.TeamID,
+		"APNS_PRIVATE_KEY_PATH":        &c.APNs.PrivateKeyPath,
+		"FCM_BASE_URL":                 &c.FCM.BaseURL,
+		"FCM_PROJECT_ID":               &c.FCM.ProjectID,
+		"FCM_ACCESS_TOKEN":             &c.FCM.AccessToken,
+		"RRULE_MAX_FREQUENCY":          &c.Recurrence.MaxFrequency,
+	}
+	for name, target := range vars {
+		if value, ok := lookupEnv(name); ok && value != "" {
+			*target = value
+		}
+	}
+
+	ints := map[string]*int{
+		"PORT":                           &c.Server.Port,
+		"RRULE_MAX_OCCURRENCES":          &c.Recurrence.MaxOccurrences,
+		"RRULE_HORIZON_DAYS":             &c.Recurrence.HorizonDays,
+		"RRULE_MAX_STREAMED_OCCURRENCES": &c.Recurrence.MaxStreamedOccurrences,
+	}
+	for name, target := range ints {
+		if value, ok := lookupEnv(name); ok && value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("%s must be a number, got %q", name, value)
+			}
+			*target = n
+		}
+	}
+	return nil
+}
+
+// Validate reports every setting that would stop the application from
+// working, so that it fails on startup rather than on first use.
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.Server.Port < 1 || c.Server.Port > 65535 {
+		errs = append(errs, fmt.Errorf("server port must be between 1 and 65535, got %d", c.Server.Port))
+	}
+
+	if c.Database.Driver != dal.DriverSQLite && c.Database.Driver != dal.DriverPostgres {
+		errs = append(errs, fmt.Errorf("database driver must be sqlite or postgres, got %q", c.Database.Driver))
+	}
+	if c.Database.Location == "" {
+		errs = append(errs, errors.New("database location is required"))
+	}
+
+	if c.Auth.JWTSigningKey == "" {
+		errs = append(errs, errors.New("JWT signing key is required (JWT_SUPER_SECRET_SIGNING_KEY)"))
+	}
+
+	if (c.WebPush.PublicKey == "") != (c.WebPush.PrivateKey == "") {
+		errs = append(errs, errors.New("web push public and private keys must be set together"))
+	}
+
+	if c.APNs.PrivateKeyPath != "" && (c.APNs.Topic == "" || c.APNs.KeyID == "" || c.APNs.TeamID == "") {
+		errs = append(errs, errors.New("APNs needs a topic, key ID and team ID along with its private key"))
+	}
+
+	if c.FCM.ProjectID != "" && c.FCM.AccessToken == "" {
+		errs = append(errs, errors.New("FCM needs an access token along with its project ID"))
+	}
+
+	if _, err := rrule.StrToFreq(c.Recurrence.MaxFrequency); err != nil {
+		errs = append(errs, fmt.Errorf("recurrence max frequency must be a FREQ such as MINUTELY, got %q", c.Recurrence.MaxFrequency))
+	}
+	if c.Recurrence.MaxOccurrences < 1 {
+		errs = append(errs, fmt.Errorf("recurrence max occurrences must be at least 1, got %d", c.Recurrence.MaxOccurrences))
+	}
+	if c.Recurrence.MaxStreamedOccurrences < 1 {
+		errs = append(errs, fmt.Errorf("recurrence max streamed occurrences must be at least 1, got %d", c.Recurrence.MaxStreamedOccurrences))
+	}
+	if maxDays := recurrence.MaxSpanYears * 365; c.Recurrence.HorizonDays < 1 || c.Recurrence.HorizonDays > maxDays {
+		errs = append(errs, fmt.Errorf("recurrence horizon must be between 1 and %d days, got %d", maxDays, c.Recurrence.HorizonDays))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration: %w", errors.Join(errs...))
+	}
+	return nil
+}
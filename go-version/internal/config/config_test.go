@@ -0,0 +1,246 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+func fakeEnv(vars map[string]string) func(string) (string, bool) {
+	return func(name string) (string, bool) {
+		value, ok := vars[name]
+		return value, ok
+	}
+}
+
+func writeFile(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	cfg, err := load("", fakeEnv(map[string]string{
+		"JWT_SUPER_SECRET_SIGNING_KEY": "secret",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if cfg.Server.Addr() != ":8080" {
+		t.Errorf("Expected default address :8080, got %s", cfg.Server.Addr())
+	}
+	if cfg.Database.Driver != "sqlite" || cfg.Database.Location != "./database.sqlite" {
+		t.Errorf("Expected the default SQLite database, got %+v", cfg.Database)
+	}
+	if cfg.Auth.JWTSigningKey != "secret" {
+		t.Errorf("Expected the signing key from the environment, got %q", cfg.Auth.JWTSigningKey)
+	}
+}
+
+func TestLoad_Files(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contents string
+	}{
+		{
+			name: "config.yaml",
+			contents: `
+server:
+  host: 127.0.0.1
+  port: 9090
+database:
+  driver: postgres
+  location: postgres://localhost/reminders
+auth:
+  jwt_signing_key: from-file
+fcm:
+  project_id: project
+  access_token: token
+`,
+		},
+		{
+			name: "config.toml",
+			contents: `
+[server]
+host = "127.0.0.1"
+port = 9090
+
+[database]
+driver = "postgres"
+location = "postgres://localhost/reminders"
+
+[auth]
+jwt_signing_key = "from-file"
+
+[fcm]
+project_id = "project"
+access_token = "token"
+`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg, err := load(writeFile(t, tc.name, tc.contents), fakeEnv(nil))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if cfg.Server.Addr() != "127.0.0.1:9090" {
+				t.Errorf("Expected address 127.0.0.1:9090, got %s", cfg.Server.Addr())
+			}
+			if cfg.Database.Driver != "postgres" || cfg.Database.Location != "postgres://localhost/reminders" {
+				t.Errorf("Expected the PostgreSQL database from the file, got %+v", cfg.Database)
+			}
+			if cfg.Auth.JWTSigningKey != "from-file" {
+				t.Errorf("Expected the signing key from the file, got %q", cfg.Auth.JWTSigningKey)
+			}
+			if cfg.FCM.ProjectID != "project" || cfg.FCM.AccessToken != "token" {
+				t.Errorf("Expected FCM settings from the file, got %+v", cfg.FCM)
+			}
+		})
+	}
+}
+
+func TestLoad_EnvironmentOverridesFile(t *testing.T) {
+	file := writeFile(t, "config.yml", `
+server:
+  port: 9090
+auth:
+  jwt_signing_key: from-file
+web_push:
+  subject: mailto:file@example.com
+`)
+
+	cfg, err := load(file, fakeEnv(map[string]string{
+		"PORT":                           "7070",
+		"JWT_SUPER_SECRET_SIGNING_KEY":   "from-env",
+		"VAPID_SUBJECT":                  "",
+		"RRULE_MAX_FREQUENCY":            "HOURLY",
+		"RRULE_HORIZON_DAYS":             "30",
+		"RRULE_MAX_STREAMED_OCCURRENCES": "500",
+	}))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if cfg.Server.Port != 7070 {
+		t.Errorf("Expected the port from the environment, got %d", cfg.Server.Port)
+	}
+	if cfg.Auth.JWTSigningKey != "from-env" {
+		t.Errorf("Expected the signing key from the environment, got %q", cfg.Auth.JWTSigningKey)
+	}
+	if cfg.WebPush.Subject != "mailto:file@example.com" {
+		t.Errorf("Expected an empty variable to leave the file value, got %q", cfg.WebPush.Subject)
+	}
+	limits := cfg.Recurrence.Limits()
+	if limits.MaxFrequency != rrule.HOURLY || limits.Horizon != 30*24*time.Hour {
+		t.Errorf("Expected recurrence limits from the environment, got %+v", limits)
+	}
+	if cfg.Recurrence.MaxStreamedOccurrences != 500 {
+		t.Errorf("Expected the streamed occurrence cap from the environment, got %d", cfg.Recurrence.MaxStreamedOccurrences)
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	testCases := []struct {
+		name          string
+		file          string
+		env           map[string]string
+		expectedError string
+	}{
+		{
+			name:          "missing signing key",
+			expectedError: "JWT signing key is required",
+		},
+		{
+			name:          "port is not a number",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "PORT": "http"},
+			expectedError: "PORT must be a number",
+		},
+		{
+			name:          "port out of range",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "PORT": "70000"},
+			expectedError: "server port must be between 1 and 65535",
+		},
+		{
+			name:          "unknown driver",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "DB_DRIVER": "mysql"},
+			expectedError: "database driver must be sqlite or postgres",
+		},
+		{
+			name:          "half a VAPID key pair",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "VAPID_PRIVATE_KEY": "key"},
+			expectedError: "web push public and private keys must be set together",
+		},
+		{
+			name:          "APNs without a team",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "APNS_PRIVATE_KEY_PATH": "key.p8", "APNS_TOPIC": "topic", "APNS_KEY_ID": "id"},
+			expectedError: "APNs needs a topic, key ID and team ID",
+		},
+		{
+			name:          "unknown recurrence frequency",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "RRULE_MAX_FREQUENCY": "FORTNIGHTLY"},
+			expectedError: "recurrence max frequency must be a FREQ",
+		},
+		{
+			name:          "no occurrences allowed",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "RRULE_MAX_OCCURRENCES": "0"},
+			expectedError: "recurrence max occurrences must be at least 1",
+		},
+		{
+			name:          "no streamed occurrences allowed",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "RRULE_MAX_STREAMED_OCCURRENCES": "-1"},
+			expectedError: "recurrence max streamed occurrences must be at least 1",
+		},
+		{
+			name:          "horizon is not a number",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "RRULE_HORIZON_DAYS": "forever"},
+			expectedError: "RRULE_HORIZON_DAYS must be a number",
+		},
+		{
+			name:          "horizon too long",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret", "RRULE_HORIZON_DAYS": "200000"},
+			expectedError: "recurrence horizon must be between 1 and 146000 days",
+		},
+		{
+			name:          "unsupported file extension",
+			file:          "config.json",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret"},
+			expectedError: "must be .yaml, .yml or .toml",
+		},
+		{
+			name:          "missing file",
+			file:          "missing.yaml",
+			env:           map[string]string{"JWT_SUPER_SECRET_SIGNING_KEY": "secret"},
+			expectedError: "reading config file",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			file := ""
+			if tc.file == "missing.yaml" {
+				file = filepath.Join(t.TempDir(), tc.file)
+			} else if tc.file != "" {
+				file = writeFile(t, tc.file, "{}")
+			}
+
+			_, err := load(file, fakeEnv(tc.env))
+			if err == nil {
+				t.Fatalf("Expected an error containing %q, got none", tc.expectedError)
+			}
+			if !strings.Contains(err.Error(), tc.expectedError) {
+				t.Errorf("Expected an error containing %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
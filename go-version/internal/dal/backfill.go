@@ -0,0 +1,59 @@
+package dal
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"go-version/internal/recurrence"
+)
+
+// backfillOccurrenceBounds stores the first and last occurrences of the
+// reminders created before migration 14 added them, which range queries
+// would otherwise treat as unbounded and never skip. Rules that cannot be
+// expanded are left unbounded rather than failing the migration.
+func backfillOccurrenceBounds(ctx context.Context, tx *sql.Tx) error {
+	type reminder struct {
+		id      string
+		rrule   string
+		startAt time.Time
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT id, rrule, start_at FROM reminders WHERE first_occurrence_at IS NULL`)
+	if err != nil {
+		return err
+	}
+	var reminders []reminder
+	for rows.Next() {
+		var r reminder
+		if err := rows.Scan(&r.id, &r.rrule, &r.startAt); err != nil {
+			rows.Close()
+			return err
+		}
+		reminders = append(reminders, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, r := range reminders {
+		first, last, err := recurrence.Bounds(r.rrule, r.startAt)
+		if err != nil || first == nil {
+			continue
+		}
+
+		// Stored in UTC, as the store stores them, so that SQLite compares
+		// them in order.
+		var lastUTC *time.Time
+		if last != nil {
+			utc := last.UTC()
+			lastUTC = &utc
+		}
+		_, err = tx.ExecContext(ctx, `UPDATE reminders SET first_occurrence_at=$1, last_occurrence_at=$2 WHERE id=$3`, first.UTC(), lastUTC, r.id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
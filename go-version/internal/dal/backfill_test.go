@@ -0,0 +1,77 @@
+package dal
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMigrator_BackfillsOccurrenceBounds(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewDatabaseConn(ctx, DriverSQLite, filepath.Join(t.TempDir(), "backfill.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := NewMigrator(db, DriverSQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+
+	// Reminders as they were left before the bounds were stored, brought
+	// back to before the backfill by rolling it back.
+	startAt := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	if _, err := db.Exec(`INSERT INTO users (id, email, name, password, api_key) VALUES ('user-1', 'a@example.com', 'A', 'x', 'key')`); err != nil {
+		t.Fatal(err)
+	}
+	for id, rule := range map[string]string{
+		"bounded":   "FREQ=DAILY;COUNT=5",
+		"unbounded": "FREQ=WEEKLY",
+		"invalid":   "FREQ=MONTHLY;BYDAY=11FR",
+	} {
+		if _, err := db.Exec(`INSERT INTO reminders (id, user_id, rrule, start_at) VALUES ($1, 'user-1', $2, $3)`, id, rule, startAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for {
+		rolledBack, err := migrator.Down(ctx)
+		if err != nil || rolledBack == nil {
+			t.Fatalf("Expected the backfill to be rolled back, got %+v, %v", rolledBack, err)
+		}
+		if rolledBack.Version == 18 {
+			break
+		}
+	}
+
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+
+	bounds := func(id string) (first, last sql.NullTime) {
+		t.Helper()
+		err := db.QueryRow(`SELECT first_occurrence_at, last_occurrence_at FROM reminders WHERE id=$1`, id).Scan(&first, &last)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return first, last
+	}
+
+	first, last := bounds("bounded")
+	if !first.Valid || !first.Time.Equal(startAt) || !last.Valid || !last.Time.Equal(startAt.AddDate(0, 0, 4)) {
+		t.Errorf("Expected the bounded series' bounds, got %v and %v", first, last)
+	}
+	first, last = bounds("unbounded")
+	if !first.Valid || !first.Time.Equal(startAt) || last.Valid {
+		t.Errorf("Expected only a first occurrence for the unbounded series, got %v and %v", first, last)
+	}
+	first, last = bounds("invalid")
+	if first.Valid || last.Valid {
+		t.Errorf("Expected a rule that cannot be expanded to stay unbounded, got %v and %v", first, last)
+	}
+}
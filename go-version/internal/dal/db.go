@@ -3,15 +3,43 @@ package dal
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 
+	_ "github.com/lib/pq"  // PostgreSQL driver
 	_ "modernc.org/sqlite" // SQLite driver
 )
 
-func NewDatabaseConn(ctx context.Context, dbLocation string) (*sql.DB, error) {
-	db, err := sql.Open("sqlite", dbLocation)
+// Database drivers the stores can run against.
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+// NewDatabaseConn opens a connection to the database. dataSource is a file
+// path for SQLite and a connection string for PostgreSQL.
+func NewDatabaseConn(ctx context.Context, driver, dataSource string) (*sql.DB, error) {
+	if driver != DriverSQLite && driver != DriverPostgres {
+		return nil, fmt.Errorf("unsupported database driver %q", driver)
+	}
+	if driver == DriverSQLite {
+		dataSource = withForeignKeys(dataSource)
+	}
+
+	db, err := sql.Open(driver, dataSource)
 	if err != nil {
 		return nil, err
 	}
 
 	return db, nil
 }
+
+// withForeignKeys turns on foreign keys for every SQLite connection, which
+// SQLite leaves off, so deleting a row also deletes the rows that
+// reference it ON DELETE CASCADE.
+func withForeignKeys(dataSource string) string {
+	if strings.Contains(dataSource, "?") {
+		return dataSource + "&_pragma=foreign_keys(1)"
+	}
+	return dataSource + "?_pragma=foreign_keys(1)"
+}
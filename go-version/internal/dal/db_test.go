@@ -0,0 +1,60 @@
+package dal
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewDatabaseConn_SQLiteCascadesDeletes(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewDatabaseConn(ctx, DriverSQLite, filepath.Join(t.TempDir(), "cascade.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := NewMigrator(db, DriverSQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(ctx); err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+
+	for _, statement := range []string{
+		`INSERT INTO users (id, email, name, password, api_key) VALUES ('user-1', 'a@example.com', 'A', 'x', 'key')`,
+		`INSERT INTO reminders (id, user_id, rrule, start_at) VALUES ('reminder-1', 'user-1', 'FREQ=DAILY', '` + time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC).String() + `')`,
+		`INSERT INTO escalation_policies (id, reminder_id, user_id) VALUES ('policy-1', 'reminder-1', 'user-1')`,
+		`INSERT INTO reminder_medications (reminder_id, user_id, name) VALUES ('reminder-1', 'user-1', 'Aspirin')`,
+		`INSERT INTO reminder_supplies (reminder_id, user_id, quantity) VALUES ('reminder-1', 'user-1', 10)`,
+		`INSERT INTO check_in_questionnaires (id, reminder_id, user_id) VALUES ('questionnaire-1', 'reminder-1', 'user-1')`,
+		`INSERT INTO caregiver_grants (id, owner_id, email, permission) VALUES ('grant-1', 'user-1', 'b@example.com', 'view')`,
+		`INSERT INTO caregiver_grant_reminders (grant_id, reminder_id) VALUES ('grant-1', 'reminder-1')`,
+	} {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("%s: %v", statement, err)
+		}
+	}
+
+	if _, err := db.Exec(`DELETE FROM reminders WHERE id = 'reminder-1'`); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, table := range []string{
+		"escalation_policies",
+		"reminder_medications",
+		"reminder_supplies",
+		"check_in_questionnaires",
+		"caregiver_grant_reminders",
+	} {
+		var count int
+		if err := db.QueryRow(`SELECT COUNT(*) FROM ` + table + ` WHERE reminder_id = 'reminder-1'`).Scan(&count); err != nil {
+			t.Fatal(err)
+		}
+		if count != 0 {
+			t.Errorf("Expected the reminder's %s to be deleted, %d left", table, count)
+		}
+	}
+}
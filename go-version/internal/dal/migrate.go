@@ -0,0 +1,232 @@
+package dal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+
+	"go-version/migrations"
+)
+
+// Migration is one version of the schema.
+type Migration struct {
+	Version int
+	Name    string
+	up      string
+	down    string
+	// step, when set, runs after the up SQL in the same transaction, for
+	// changes SQL cannot make.
+	step func(ctx context.Context, tx *sql.Tx) error
+}
+
+// steps are the Go steps of migrations, by version.
+var steps = map[int]func(ctx context.Context, tx *sql.Tx) error{
+	18: backfillOccurrenceBounds,
+}
+
+// MigrationStatus reports whether a migration has been applied.
+type MigrationStatus struct {
+	Migration
+	Applied bool
+}
+
+// ErrDirtyDatabase is returned when a migration was left half applied,
+// which only happens to databases migrated by golang-migrate, as Migrator
+// applies each migration in a transaction.
+type ErrDirtyDatabase struct {
+	Version int
+}
+
+func (e *ErrDirtyDatabase) Error() string {
+	return fmt.Sprintf("database is dirty at migration %d, fix it by hand and set schema_migrations.dirty to false", e.Version)
+}
+
+// Migrator applies the embedded migrations for a driver. The current
+// version is kept in schema_migrations, laid out as golang-migrate lays it
+// out, so that databases it migrated carry on from where they were.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, driver string) (*Migrator, error) {
+	switch driver {
+	case DriverSQLite:
+		return newMigrator(db, migrations.SQLite)
+	case DriverPostgres:
+		files, err := fs.Sub(migrations.Postgres, "postgres")
+		if err != nil {
+			return nil, err
+		}
+		return newMigrator(db, files)
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", driver)
+	}
+}
+
+func newMigrator(db *sql.DB, files fs.FS) (*Migrator, error) {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, name := range names {
+		base, direction, _ := strings.Cut(strings.TrimSuffix(name, ".sql"), ".")
+		versionPart, migrationName, ok := strings.Cut(base, "_")
+		version, err := strconv.Atoi(versionPart)
+		if !ok || err != nil || version < 1 || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("invalid migration file name %q", name)
+		}
+
+		contents, err := fs.ReadFile(files, name)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: migrationName}
+			byVersion[version] = migration
+		}
+		if direction == "up" {
+			migration.up = string(contents)
+		} else {
+			migration.down = string(contents)
+		}
+	}
+
+	m := &Migrator{db: db}
+	for _, migration := range byVersion {
+		if migration.up == "" || migration.down == "" {
+			return nil, fmt.Errorf("migration %d needs both an up and a down file", migration.Version)
+		}
+		migration.step = steps[migration.Version]
+		m.migrations = append(m.migrations, *migration)
+	}
+	slices.SortFunc(m.migrations, func(a, b Migration) int { return a.Version - b.Version })
+
+	return m, nil
+}
+
+// Up applies every pending migration in order and returns those it applied,
+// stopping at the first that fails.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	current, err := m.currentVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range m.migrations {
+		if migration.Version <= current {
+			continue
+		}
+		if err := m.apply(ctx, migration.up, migration.step, migration.Version); err != nil {
+			return applied, fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		applied = append(applied, migration)
+	}
+	return applied, nil
+}
+
+// Down rolls back the latest applied migration and returns it, or nil when
+// none is applied.
+func (m *Migrator) Down(ctx context.Context) (*Migration, error) {
+	current, err := m.currentVersion(ctx)
+	if err != nil || current == 0 {
+		return nil, err
+	}
+
+	i := slices.IndexFunc(m.migrations, func(migration Migration) bool { return migration.Version == current })
+	if i < 0 {
+		return nil, fmt.Errorf("database is at migration %d, which this build does not know", current)
+	}
+
+	previous := 0
+	if i > 0 {
+		previous = m.migrations[i-1].Version
+	}
+
+	migration := m.migrations[i]
+	if err := m.apply(ctx, migration.down, nil, previous); err != nil {
+		return nil, fmt.Errorf("migration %d_%s: %w", migration.Version, migration.Name, err)
+	}
+	return &migration, nil
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	current, err := m.currentVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, len(m.migrations))
+	for i, migration := range m.migrations {
+		statuses[i] = MigrationStatus{Migration: migration, Applied: migration.Version <= current}
+	}
+	return statuses, nil
+}
+
+// currentVersion returns the version of the latest applied migration, or 0
+// when none is.
+func (m *Migrator) currentVersion(ctx context.Context) (int, error) {
+	_, err := m.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT NOT NULL PRIMARY KEY,
+			dirty BOOLEAN NOT NULL
+		)
+	`)
+	if err != nil {
+		return 0, err
+	}
+
+	var version int
+	var dirty bool
+	err = m.db.QueryRowContext(ctx, `SELECT version, dirty FROM schema_migrations`).Scan(&version, &dirty)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	if dirty {
+		return 0, &ErrDirtyDatabase{Version: version}
+	}
+	return version, nil
+}
+
+// apply runs the migration SQL and then its step, if any, and records
+// version as current, or no version when it is 0, in a single transaction.
+func (m *Migrator) apply(ctx context.Context, statements string, step func(ctx context.Context, tx *sql.Tx) error, version int) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, statements); err != nil {
+		return err
+	}
+	if step != nil {
+		if err := step(ctx, tx); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM schema_migrations`); err != nil {
+		return err
+	}
+	if version > 0 {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2)`, version, false); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
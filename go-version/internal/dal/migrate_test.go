@@ -0,0 +1,175 @@
+package dal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestMigrator_SQLite(t *testing.T) {
+	db, err := NewDatabaseConn(context.Background(), DriverSQLite, filepath.Join(t.TempDir(), "migrate.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	testMigrateUpAndDown(t, db, DriverSQLite)
+}
+
+func TestMigrator_Postgres(t *testing.T) {
+	dsn := os.Getenv("TEST_POSTGRES_URL")
+	if dsn == "" {
+		t.Skip("TEST_POSTGRES_URL is not set")
+	}
+
+	admin, err := NewDatabaseConn(context.Background(), DriverPostgres, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := fmt.Sprintf("migrate_%d", time.Now().UnixNano())
+	if _, err := admin.Exec(`CREATE SCHEMA ` + schema); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		admin.Exec(`DROP SCHEMA ` + schema + ` CASCADE`)
+		admin.Close()
+	})
+
+	separator := " "
+	if strings.Contains(dsn, "://") {
+		separator = "?"
+		if strings.Contains(dsn, "?") {
+			separator = "&"
+		}
+	}
+	db, err := NewDatabaseConn(context.Background(), DriverPostgres, dsn+separator+"search_path="+schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	testMigrateUpAndDown(t, db, DriverPostgres)
+}
+
+// testMigrateUpAndDown applies every migration, rolls each back one at a
+// time, then applies them all again, which only works if every down
+// migration undoes its up migration.
+func testMigrateUpAndDown(t *testing.T, db *sql.DB, driver string) {
+	ctx := context.Background()
+	migrator, err := NewMigrator(db, driver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrator.migrations) == 0 {
+		t.Fatal("Expected embedded migrations")
+	}
+	latest := migrator.migrations[len(migrator.migrations)-1]
+
+	applied, err := migrator.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up: %v", err)
+	}
+	if len(applied) != len(migrator.migrations) {
+		t.Errorf("Expected %d migrations to be applied, got %d", len(migrator.migrations), len(applied))
+	}
+	if _, err := db.Exec(`SELECT COUNT(*) FROM reminders`); err != nil {
+		t.Errorf("Expected the reminders table to exist: %v", err)
+	}
+
+	applied, err = migrator.Up(ctx)
+	if err != nil || len(applied) != 0 {
+		t.Errorf("Expected nothing left to apply, got %v, %v", applied, err)
+	}
+
+	for i := len(migrator.migrations) - 1; i >= 0; i-- {
+		rolledBack, err := migrator.Down(ctx)
+		if err != nil {
+			t.Fatalf("Down: %v", err)
+		}
+		if rolledBack == nil || rolledBack.Version != migrator.migrations[i].Version {
+			t.Fatalf("Expected migration %d to be rolled back, got %+v", migrator.migrations[i].Version, rolledBack)
+		}
+	}
+
+	rolledBack, err := migrator.Down(ctx)
+	if err != nil || rolledBack != nil {
+		t.Errorf("Expected nothing left to roll back, got %+v, %v", rolledBack, err)
+	}
+	if _, err := db.Exec(`SELECT COUNT(*) FROM reminders`); err == nil {
+		t.Errorf("Expected the reminders table to be dropped")
+	}
+
+	applied, err = migrator.Up(ctx)
+	if err != nil || len(applied) != len(migrator.migrations) {
+		t.Fatalf("Expected every migration to apply again, got %d, %v", len(applied), err)
+	}
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status: %v", err)
+	}
+	for _, status := range statuses {
+		if !status.Applied {
+			t.Errorf("Expected migration %d to be applied", status.Version)
+		}
+	}
+	if statuses[len(statuses)-1].Version != latest.Version {
+		t.Errorf("Expected the latest status to be migration %d, got %d", latest.Version, statuses[len(statuses)-1].Version)
+	}
+}
+
+func TestMigrator_FailedMigrationIsRolledBack(t *testing.T) {
+	db, err := NewDatabaseConn(context.Background(), DriverSQLite, filepath.Join(t.TempDir(), "migrate.sqlite"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	migrator, err := newMigrator(db, fstest.MapFS{
+		"1_create_a.up.sql":   {Data: []byte(`CREATE TABLE a (id TEXT);`)},
+		"1_create_a.down.sql": {Data: []byte(`DROP TABLE a;`)},
+		"2_create_b.up.sql":   {Data: []byte(`CREATE TABLE b (id TEXT); NOT SQL;`)},
+		"2_create_b.down.sql": {Data: []byte(`DROP TABLE b;`)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	applied, err := migrator.Up(context.Background())
+	if err == nil || len(applied) != 1 {
+		t.Fatalf("Expected only the first migration to apply, got %v, %v", applied, err)
+	}
+	if _, err := db.Exec(`SELECT COUNT(*) FROM b`); err == nil {
+		t.Errorf("Expected the failed migration to leave nothing behind")
+	}
+
+	statuses, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !statuses[0].Applied || statuses[1].Applied {
+		t.Errorf("Expected only the first migration to be applied, got %+v", statuses)
+	}
+}
+
+func TestNewMigrator_RequiresUpAndDown(t *testing.T) {
+	_, err := newMigrator(nil, fstest.MapFS{
+		"1_create_a.up.sql": {Data: []byte(`CREATE TABLE a (id TEXT);`)},
+	})
+	if err == nil {
+		t.Errorf("Expected a migration without a down file to be rejected")
+	}
+
+	_, err = newMigrator(nil, fstest.MapFS{
+		"create_a.up.sql": {Data: []byte(`CREATE TABLE a (id TEXT);`)},
+	})
+	if err == nil {
+		t.Errorf("Expected a migration without a version to be rejected")
+	}
+}
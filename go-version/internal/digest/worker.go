@@ -0,0 +1,176 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/notify"
+	"go-version/internal/recurrence"
+)
+
+// maxDigestLines keeps a digest within the payload limits of push services.
+const maxDigestLines = 20
+
+// Worker periodically sends each subscribed user one message listing the
+// reminders of their coming day or week.
+type Worker struct {
+	digestStore   store.DigestSubscriptionStoreInterface
+	reminderStore store.ReminderStoreInterface
+	limits        recurrence.Limits
+	channels      map[string]notify.Sender
+	interval      time.Duration
+	now           func() time.Time
+}
+
+func NewWorker(
+	digestStore store.DigestSubscriptionStoreInterface,
+	reminderStore store.ReminderStoreInterface,
+	limits recurrence.Limits,
+	channels map[string]notify.Sender,
+	interval time.Duration,
+) *Worker {
+	return &Worker{
+		digestStore:   digestStore,
+		reminderStore: reminderStore,
+		limits:        limits,
+		channels:      channels,
+		interval:      interval,
+		now:           time.Now,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Evaluate(ctx, w.now()); err != nil {
+				fmt.Println("Error sending digests:", err)
+			}
+		}
+	}
+}
+
+// Evaluate sends every digest that is due at now and has not been sent for
+// its period yet. A digest is sent at most once per period, even if delivery
+// fails, and never for a period that was due before the subscription was
+// made.
+func (w *Worker) Evaluate(ctx context.Context, now time.Time) error {
+	subscriptions, err := w.digestStore.ListDigestSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range subscriptions {
+		sub := &subscriptions[i]
+
+		start, end, dueAt, err := sub.CurrentPeriod(now)
+		if err != nil {
+			fmt.Printf("Skipping digest for user %s: %v\n", sub.UserId, err)
+			continue
+		}
+		if sub.LastSentAt != nil && !sub.LastSentAt.Before(start) {
+			continue
+		}
+		if sub.CreatedAt != nil && dueAt.Before(*sub.CreatedAt) {
+			continue
+		}
+
+		if err := w.send(ctx, sub, start, end); err != nil {
+			fmt.Printf("Error sending digest to user %s: %v\n", sub.UserId, err)
+		}
+
+		if err := w.digestStore.MarkDigestSent(ctx, sub.UserId, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *Worker) send(ctx context.Context, sub *models.DigestSubscription, start, end time.Time) error {
+	sender, ok := w.channels[sub.Channel]
+	if !ok {
+		return fmt.Errorf("channel %s is not available", sub.Channel)
+	}
+
+	// The period is half-open while occurrence expansion includes its end.
+	last := end.Add(-time.Second)
+	reminders, err := w.reminderStore.ListReminders(ctx, &store.ReminderListFilters{
+		UserID:    sub.UserId,
+		StartDate: &start,
+		EndDate:   &last,
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range reminders {
+		reminders[i].PopulateMetadataFields(&start, &last, w.limits)
+	}
+
+	msg := NewDigestMessage(sub, start, reminders)
+	if msg == nil {
+		return nil
+	}
+	return sender.Send(ctx, msg)
+}
+
+type digestEntry struct {
+	at          time.Time
+	description string
+}
+
+// NewDigestMessage summarises the populated occurrences of reminders in a
+// single message, or returns nil if there are none.
+func NewDigestMessage(sub *models.DigestSubscription, start time.Time, reminders []models.Reminder) *notify.Message {
+	var entries []digestEntry
+	for _, reminder := range reminders {
+		description := "Reminder"
+		if reminder.Description != nil && *reminder.Description != "" {
+			description = *reminder.Description
+		}
+		for _, occurrence := range reminder.Occurrences {
+			entries = append(entries, digestEntry{at: occurrence.In(start.Location()), description: description})
+		}
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].at.Before(entries[j].at)
+	})
+
+	title := "Today's reminders"
+	layout := "15:04"
+	if sub.Frequency == models.DigestFrequencyWeekly {
+		title = "This week's reminders"
+		layout = "Mon 15:04"
+	}
+
+	var lines []string
+	for i, entry := range entries {
+		if i == maxDigestLines {
+			lines = append(lines, fmt.Sprintf("and %d more", len(entries)-maxDigestLines))
+			break
+		}
+		lines = append(lines, entry.at.Format(layout)+" "+entry.description)
+	}
+
+	return &notify.Message{
+		UserID:       sub.UserId,
+		Title:        title,
+		Body:         strings.Join(lines, "\n"),
+		OccurrenceAt: start,
+	}
+}
@@ -0,0 +1,160 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/api/store/mocks"
+	"go-version/internal/api/utils"
+	"go-version/internal/notify"
+	"go-version/internal/recurrence"
+
+	"go.uber.org/mock/gomock"
+)
+
+type recordingSender struct {
+	messages []*notify.Message
+}
+
+func (s *recordingSender) Send(ctx context.Context, msg *notify.Message) error {
+	s.messages = append(s.messages, msg)
+	return nil
+}
+
+func TestWorker_Evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	digestStore := mocks.NewMockDigestSubscriptionStoreInterface(ctrl)
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+
+	now := time.Date(2023, 10, 4, 7, 31, 0, 0, time.UTC)
+	dayStart := time.Date(2023, 10, 4, 0, 0, 0, 0, time.UTC)
+	sentToday := time.Date(2023, 10, 4, 7, 30, 0, 0, time.UTC)
+	sentYesterday := time.Date(2023, 10, 3, 7, 30, 0, 0, time.UTC)
+
+	digestStore.EXPECT().ListDigestSubscriptions(gomock.Any()).Return([]models.DigestSubscription{
+		{UserId: "user-123", Frequency: models.DigestFrequencyDaily, SendTime: "07:30", TimeZone: "UTC", Channel: models.ChannelWebPush, LastSentAt: &sentYesterday},
+		{UserId: "user-456", Frequency: models.DigestFrequencyDaily, SendTime: "07:30", TimeZone: "UTC", Channel: models.ChannelWebPush, LastSentAt: &sentToday},
+	}, nil).Times(1)
+
+	reminderStore.EXPECT().
+		ListReminders(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filters *store.ReminderListFilters) ([]models.Reminder, error) {
+			if filters.UserID != "user-123" || !filters.StartDate.Equal(dayStart) {
+				t.Errorf("Unexpected filters %+v", filters)
+			}
+			return []models.Reminder{
+				{Id: "evening", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=10", Description: utils.StringPtr("Evening pills"), StartAt: time.Date(2023, 10, 1, 20, 0, 0, 0, time.UTC)},
+				{Id: "morning", UserId: "user-123", RRule: "FREQ=HOURLY;INTERVAL=4;COUNT=30", StartAt: time.Date(2023, 10, 3, 8, 0, 0, 0, time.UTC)},
+			}, nil
+		}).
+		Times(1)
+
+	digestStore.EXPECT().MarkDigestSent(gomock.Any(), "user-123", now).Return(nil).Times(1)
+
+	sender := &recordingSender{}
+	worker := NewWorker(digestStore, reminderStore, recurrence.DefaultLimits(), map[string]notify.Sender{models.ChannelWebPush: sender}, time.Minute)
+
+	if err := worker.Evaluate(context.Background(), now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(sender.messages) != 1 {
+		t.Fatalf("Expected 1 digest, got %d", len(sender.messages))
+	}
+	want := strings.Join([]string{
+		"00:00 Reminder",
+		"04:00 Reminder",
+		"08:00 Reminder",
+		"12:00 Reminder",
+		"16:00 Reminder",
+		"20:00 Evening pills",
+		"20:00 Reminder",
+	}, "\n")
+	if msg := sender.messages[0]; msg.UserID != "user-123" || msg.Title != "Today's reminders" || msg.Body != want {
+		t.Errorf("Unexpected digest %q: %q", msg.Title, msg.Body)
+	}
+}
+
+func TestWorker_EvaluateSkipsPeriodsDueBeforeSubscribing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	digestStore := mocks.NewMockDigestSubscriptionStoreInterface(ctrl)
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+
+	createdAt := time.Date(2023, 10, 4, 9, 0, 0, 0, time.UTC)
+	subscriptions := []models.DigestSubscription{
+		{UserId: "user-123", Frequency: models.DigestFrequencyDaily, SendTime: "07:30", TimeZone: "UTC", Channel: models.ChannelWebPush, CreatedAt: &createdAt},
+	}
+	digestStore.EXPECT().ListDigestSubscriptions(gomock.Any()).Return(subscriptions, nil).Times(2)
+
+	nextDay := time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)
+	reminderStore.EXPECT().
+		ListReminders(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, filters *store.ReminderListFilters) ([]models.Reminder, error) {
+			if !filters.StartDate.Equal(nextDay) {
+				t.Errorf("Unexpected filters %+v", filters)
+			}
+			return []models.Reminder{
+				{Id: "evening", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=10", Description: utils.StringPtr("Evening pills"), StartAt: time.Date(2023, 10, 1, 20, 0, 0, 0, time.UTC)},
+			}, nil
+		}).
+		Times(1)
+
+	sentAt := time.Date(2023, 10, 5, 7, 31, 0, 0, time.UTC)
+	digestStore.EXPECT().MarkDigestSent(gomock.Any(), "user-123", sentAt).Return(nil).Times(1)
+
+	sender := &recordingSender{}
+	worker := NewWorker(digestStore, reminderStore, recurrence.DefaultLimits(), map[string]notify.Sender{models.ChannelWebPush: sender}, time.Minute)
+
+	// The day's digest was due at 07:30, before the subscription was made.
+	if err := worker.Evaluate(context.Background(), time.Date(2023, 10, 4, 9, 1, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(sender.messages) != 0 {
+		t.Fatalf("Expected no digest for the day subscribed on, got %d", len(sender.messages))
+	}
+
+	if err := worker.Evaluate(context.Background(), sentAt); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(sender.messages) != 1 {
+		t.Fatalf("Expected the next day's digest, got %d", len(sender.messages))
+	}
+}
+
+func TestNewDigestMessage(t *testing.T) {
+	sub := &models.DigestSubscription{UserId: "user-123", Frequency: models.DigestFrequencyWeekly, Weekday: "monday"}
+	start := time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	if msg := NewDigestMessage(sub, start, []models.Reminder{{Id: "empty"}}); msg != nil {
+		t.Errorf("Expected no digest without occurrences, got %+v", msg)
+	}
+
+	var occurrences []time.Time
+	for i := 0; i < maxDigestLines+5; i++ {
+		occurrences = append(occurrences, start.Add(time.Duration(i)*time.Hour))
+	}
+	msg := NewDigestMessage(sub, start, []models.Reminder{{Id: "many", Occurrences: occurrences}})
+	if msg == nil {
+		t.Fatal("Expected a digest")
+	}
+
+	lines := strings.Split(msg.Body, "\n")
+	if len(lines) != maxDigestLines+1 {
+		t.Fatalf("Expected %d lines, got %d", maxDigestLines+1, len(lines))
+	}
+	if lines[0] != "Mon 00:00 Reminder" {
+		t.Errorf("Unexpected first line %q", lines[0])
+	}
+	if last := lines[len(lines)-1]; last != fmt.Sprintf("and %d more", 5) {
+		t.Errorf("Unexpected last line %q", last)
+	}
+}
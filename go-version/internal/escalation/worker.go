@@ -0,0 +1,218 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/notify"
+	"go-version/internal/recurrence"
+
+	"github.com/google/uuid"
+)
+
+// defaultLookback bounds how far back the worker looks for unacknowledged
+// occurrences, so a restart after downtime catches up on recent misses
+// without alerting caregivers about week-old doses.
+const defaultLookback = 24 * time.Hour
+
+// Worker periodically evaluates every escalation policy against the
+// occurrence schedule of its reminder and notifies contacts about
+// occurrences that have not been acknowledged in time.
+type Worker struct {
+	escalationStore store.EscalationStoreInterface
+	reminderStore   store.ReminderStoreInterface
+	contactStore    store.ContactStoreInterface
+	userStore       store.UserStoreInterface
+	quietHoursStore store.QuietHoursStoreInterface
+	limits          recurrence.Limits
+	channels        map[string]notify.Sender
+	interval        time.Duration
+	lookback        time.Duration
+	now             func() time.Time
+}
+
+func NewWorker(
+	escalationStore store.EscalationStoreInterface,
+	reminderStore store.ReminderStoreInterface,
+	contactStore store.ContactStoreInterface,
+	userStore store.UserStoreInterface,
+	quietHoursStore store.QuietHoursStoreInterface,
+	limits recurrence.Limits,
+	channels map[string]notify.Sender,
+	interval time.Duration,
+) *Worker {
+	return &Worker{
+		escalationStore: escalationStore,
+		reminderStore:   reminderStore,
+		contactStore:    contactStore,
+		userStore:       userStore,
+		quietHoursStore: quietHoursStore,
+		limits:          limits,
+		channels:        channels,
+		interval:        interval,
+		lookback:        defaultLookback,
+		now:             time.Now,
+	}
+}
+
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Evaluate(ctx, w.now()); err != nil {
+				fmt.Println("Error evaluating escalation policies:", err)
+			}
+		}
+	}
+}
+
+// Evaluate sends every escalation that is due at now and has not been sent
+// yet. Each attempt, successful or not, is recorded so it is never repeated.
+func (w *Worker) Evaluate(ctx context.Context, now time.Time) error {
+	policies, err := w.escalationStore.ListPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := range policies {
+		policy := &policies[i]
+
+		reminder, err := w.reminderStore.GetReminderByID(ctx, policy.UserId, policy.ReminderId)
+		if err != nil {
+			fmt.Printf("Skipping escalation policy %s: %v\n", policy.Id, err)
+			continue
+		}
+
+		quietHours, err := w.quietHoursStore.GetQuietHours(ctx, policy.UserId)
+		if err != nil {
+			var notFoundErr *store.NoQuietHoursFoundError
+			if !errors.As(err, &notFoundErr) {
+				fmt.Printf("Skipping escalation policy %s: %v\n", policy.Id, err)
+				continue
+			}
+			quietHours = nil
+		}
+
+		for _, step := range policy.Steps {
+			if err := w.evaluateStep(ctx, now, policy, reminder, quietHours, step); err != nil {
+				fmt.Printf("Error evaluating escalation policy %s step %d: %v\n", policy.Id, step.Position, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// evaluateStep escalates the occurrences that have gone unacknowledged for
+// the step's delay. The delay runs from when the occurrence was delivered,
+// so an occurrence held back by quiet hours is not escalated before the
+// user has been reminded.
+func (w *Worker) evaluateStep(ctx context.Context, now time.Time, policy *models.EscalationPolicy, reminder *models.Reminder, quietHours *models.QuietHours, step models.EscalationStep) error {
+	windowEnd := now.Add(-step.Delay())
+	windowStart := windowEnd.Add(-w.lookback)
+	if quietHours != nil && !reminder.Critical {
+		windowStart = windowStart.Add(-models.MaxQuietHoursDeferral)
+	}
+	if policy.CreatedAt != nil && policy.CreatedAt.After(windowStart) {
+		windowStart = *policy.CreatedAt
+	}
+	if !windowEnd.After(windowStart) {
+		return nil
+	}
+
+	occurrences, err := reminder.OccurrencesBetween(windowStart, windowEnd, w.limits)
+	if err != nil {
+		return err
+	}
+
+	for _, occurrence := range occurrences {
+		if reminder.DeliveryTime(occurrence, quietHours).After(windowEnd) {
+			continue
+		}
+
+		acknowledged, err := w.escalationStore.IsAcknowledged(ctx, reminder.Id, occurrence)
+		if err != nil {
+			return err
+		}
+		if acknowledged {
+			continue
+		}
+
+		sent, err := w.escalationStore.HasEvent(ctx, reminder.Id, step.Position, occurrence)
+		if err != nil {
+			return err
+		}
+		if sent {
+			continue
+		}
+
+		event := &models.EscalationEvent{
+			Id:           uuid.New().String(),
+			PolicyId:     policy.Id,
+			ReminderId:   reminder.Id,
+			UserId:       reminder.UserId,
+			StepPosition: step.Position,
+			ContactId:    step.ContactId,
+			Channel:      step.Channel,
+			OccurrenceAt: occurrence,
+			Status:       models.EscalationStatusSent,
+		}
+
+		if err := w.escalate(ctx, reminder, step, occurrence); err != nil {
+			errMsg := err.Error()
+			event.Status = models.EscalationStatusFailed
+			event.Error = &errMsg
+		}
+
+		if _, err := w.escalationStore.CreateEvent(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (w *Worker) escalate(ctx context.Context, reminder *models.Reminder, step models.EscalationStep, occurrence time.Time) error {
+	sender, ok := w.channels[step.Channel]
+	if !ok {
+		return fmt.Errorf("channel %s is not available", step.Channel)
+	}
+
+	contact, err := w.contactStore.GetContact(ctx, reminder.UserId, step.ContactId)
+	if err != nil {
+		return err
+	}
+
+	// Contacts are reached through their own Folia account.
+	contactUser, err := w.userStore.GetUserByEmail(ctx, contact.Email)
+	if err != nil {
+		return fmt.Errorf("contact %s has no account: %w", contact.Email, err)
+	}
+
+	patientName := "Someone you care for"
+	if patient, err := w.userStore.GetUser(ctx, reminder.UserId); err == nil {
+		patientName = patient.Name
+	}
+
+	description := "a reminder"
+	if reminder.Description != nil && *reminder.Description != "" {
+		description = fmt.Sprintf("%q", *reminder.Description)
+	}
+
+	return sender.Send(ctx, &notify.Message{
+		UserID:       contactUser.Id,
+		ReminderID:   reminder.Id,
+		Title:        "Missed reminder",
+		Body:         fmt.Sprintf("%s has not marked %s as done. It was due at %s.", patientName, description, occurrence.Format("Jan 2 15:04 MST")),
+		OccurrenceAt: occurrence,
+	})
+}
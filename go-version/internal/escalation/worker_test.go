@@ -0,0 +1,176 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/api/store/mocks"
+	"go-version/internal/api/utils"
+	"go-version/internal/notify"
+	"go-version/internal/recurrence"
+
+	"go.uber.org/mock/gomock"
+)
+
+type recordingSender struct {
+	messages []*notify.Message
+	err      error
+}
+
+func (s *recordingSender) Send(ctx context.Context, msg *notify.Message) error {
+	s.messages = append(s.messages, msg)
+	return s.err
+}
+
+func TestWorker_Evaluate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	escalationStore := mocks.NewMockEscalationStoreInterface(ctrl)
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	contactStore := mocks.NewMockContactStoreInterface(ctrl)
+	userStore := mocks.NewMockUserStoreInterface(ctrl)
+	quietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+	quietHoursStore.EXPECT().GetQuietHours(gomock.Any(), "user-123").Return(nil, &store.NoQuietHoursFoundError{UserID: "user-123"}).AnyTimes()
+
+	startAt := time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2023, 10, 3, 12, 0, 0, 0, time.UTC)
+	// Oct 4 09:00 is 45 minutes overdue: the 30 minute step is due, the
+	// 60 minute step is not. Oct 3 09:00 predates the policy.
+	now := time.Date(2023, 10, 4, 9, 45, 0, 0, time.UTC)
+	occurrence := time.Date(2023, 10, 4, 9, 0, 0, 0, time.UTC)
+
+	escalationStore.EXPECT().ListPolicies(gomock.Any()).Return([]models.EscalationPolicy{
+		{
+			Id:         "policy-1",
+			ReminderId: "reminder-1",
+			UserId:     "user-123",
+			CreatedAt:  &createdAt,
+			Steps: []models.EscalationStep{
+				{Position: 1, DelayMinutes: 30, ContactId: "contact-1", Channel: models.ChannelWebPush},
+				{Position: 2, DelayMinutes: 60, ContactId: "contact-1", Channel: models.ChannelWebPush},
+			},
+		},
+	}, nil).Times(1)
+
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(&models.Reminder{
+		Id:          "reminder-1",
+		UserId:      "user-123",
+		RRule:       "FREQ=DAILY;COUNT=10",
+		Description: utils.StringPtr("Take medication"),
+		StartAt:     startAt,
+	}, nil).Times(1)
+
+	escalationStore.EXPECT().IsAcknowledged(gomock.Any(), "reminder-1", occurrence).Return(false, nil).Times(1)
+	escalationStore.EXPECT().HasEvent(gomock.Any(), "reminder-1", 1, occurrence).Return(false, nil).Times(1)
+	contactStore.EXPECT().GetContact(gomock.Any(), "user-123", "contact-1").Return(&models.Contact{
+		Id:     "contact-1",
+		UserId: "user-123",
+		Name:   "Sam",
+		Email:  "sam@example.com",
+	}, nil).Times(1)
+	userStore.EXPECT().GetUserByEmail(gomock.Any(), "sam@example.com").Return(&models.User{Id: "user-456"}, nil).Times(1)
+	userStore.EXPECT().GetUser(gomock.Any(), "user-123").Return(&models.User{Id: "user-123", Name: "Alex"}, nil).Times(1)
+
+	var recorded *models.EscalationEvent
+	escalationStore.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *models.EscalationEvent) (*models.EscalationEvent, error) {
+			recorded = event
+			return event, nil
+		}).
+		Times(1)
+
+	sender := &recordingSender{}
+	worker := NewWorker(escalationStore, reminderStore, contactStore, userStore, quietHoursStore, recurrence.DefaultLimits(), map[string]notify.Sender{
+		models.ChannelWebPush: sender,
+	}, time.Minute)
+
+	if err := worker.Evaluate(context.Background(), now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(sender.messages) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(sender.messages))
+	}
+	if msg := sender.messages[0]; msg.UserID != "user-456" || !msg.OccurrenceAt.Equal(occurrence) {
+		t.Errorf("Unexpected message %+v", msg)
+	}
+	if recorded == nil || recorded.Status != models.EscalationStatusSent || recorded.StepPosition != 1 {
+		t.Errorf("Expected a sent event for step 1, got %+v", recorded)
+	}
+}
+
+func TestWorker_EvaluateSkipsAcknowledgedAndRecordsFailures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	escalationStore := mocks.NewMockEscalationStoreInterface(ctrl)
+	reminderStore := mocks.NewMockReminderStoreInterface(ctrl)
+	contactStore := mocks.NewMockContactStoreInterface(ctrl)
+	userStore := mocks.NewMockUserStoreInterface(ctrl)
+	quietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+	quietHoursStore.EXPECT().GetQuietHours(gomock.Any(), "user-123").Return(nil, &store.NoQuietHoursFoundError{UserID: "user-123"}).AnyTimes()
+
+	startAt := time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2023, 10, 2, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2023, 10, 4, 10, 0, 0, 0, time.UTC)
+	acknowledged := time.Date(2023, 10, 3, 9, 0, 0, 0, time.UTC)
+	missed := time.Date(2023, 10, 4, 9, 0, 0, 0, time.UTC)
+
+	escalationStore.EXPECT().ListPolicies(gomock.Any()).Return([]models.EscalationPolicy{
+		{
+			Id:         "policy-1",
+			ReminderId: "reminder-1",
+			UserId:     "user-123",
+			CreatedAt:  &createdAt,
+			Steps: []models.EscalationStep{
+				{Position: 1, DelayMinutes: 60, ContactId: "contact-1", Channel: models.ChannelMobilePush},
+			},
+		},
+	}, nil).Times(1)
+
+	reminderStore.EXPECT().GetReminderByID(gomock.Any(), "user-123", "reminder-1").Return(&models.Reminder{
+		Id:      "reminder-1",
+		UserId:  "user-123",
+		RRule:   "FREQ=DAILY;COUNT=10",
+		StartAt: startAt,
+	}, nil).Times(1)
+
+	escalationStore.EXPECT().IsAcknowledged(gomock.Any(), "reminder-1", acknowledged).Return(true, nil).Times(1)
+	escalationStore.EXPECT().IsAcknowledged(gomock.Any(), "reminder-1", missed).Return(false, nil).Times(1)
+	escalationStore.EXPECT().HasEvent(gomock.Any(), "reminder-1", 1, missed).Return(false, nil).Times(1)
+	contactStore.EXPECT().GetContact(gomock.Any(), "user-123", "contact-1").Return(&models.Contact{
+		Id:    "contact-1",
+		Email: "sam@example.com",
+	}, nil).Times(1)
+	userStore.EXPECT().GetUserByEmail(gomock.Any(), "sam@example.com").Return(&models.User{Id: "user-456"}, nil).Times(1)
+	userStore.EXPECT().GetUser(gomock.Any(), "user-123").Return(nil, errors.New("database error")).Times(1)
+
+	var recorded *models.EscalationEvent
+	escalationStore.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *models.EscalationEvent) (*models.EscalationEvent, error) {
+			recorded = event
+			return event, nil
+		}).
+		Times(1)
+
+	sender := &recordingSender{err: errors.New("no devices")}
+	worker := NewWorker(escalationStore, reminderStore, contactStore, userStore, quietHoursStore, recurrence.DefaultLimits(), map[string]notify.Sender{
+		models.ChannelMobilePush: sender,
+	}, time.Minute)
+
+	if err := worker.Evaluate(context.Background(), now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if recorded == nil || recorded.Status != models.EscalationStatusFailed {
+		t.Fatalf("Expected a failed event, got %+v", recorded)
+	}
+	if recorded.Error == nil || *recorded.Error != "no devices" {
+		t.Errorf("Expected delivery error to be recorded, got %v", recorded.Error)
+	}
+}
@@ -0,0 +1,181 @@
+// Package fhir holds the subset of HL7 FHIR R4 resources used to exchange
+// reminders as MedicationRequests, and converts between FHIR Timing and
+// RRULEs.
+package fhir
+
+import "encoding/json"
+
+const (
+	ResourceTypeBundle            = "Bundle"
+	ResourceTypeMedicationRequest = "MedicationRequest"
+	ResourceTypeOperationOutcome  = "OperationOutcome"
+	ResourceTypePatient           = "Patient"
+
+	BundleTypeSearchset     = "searchset"
+	BundleTypeBatch         = "batch"
+	BundleTypeBatchResponse = "batch-response"
+
+	IssueSeverityError       = "error"
+	IssueSeverityWarning     = "warning"
+	IssueSeverityInformation = "information"
+
+	IssueCodeInvalid       = "invalid"
+	IssueCodeNotSupported  = "not-supported"
+	IssueCodeProcessing    = "processing"
+	IssueCodeInformational = "informational"
+	IssueCodeForbidden     = "forbidden"
+	IssueCodeNotFound      = "not-found"
+	IssueCodeException     = "exception"
+
+	// MediaType is the content type of FHIR JSON.
+	MediaType = "application/fhir+json"
+)
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+// Label returns the concept's text, or the display of its first coding.
+func (c *CodeableConcept) Label() string {
+	if c == nil {
+		return ""
+	}
+	if c.Text != "" {
+		return c.Text
+	}
+	for _, coding := range c.Coding {
+		if coding.Display != "" {
+			return coding.Display
+		}
+	}
+	return ""
+}
+
+type Reference struct {
+	Reference string `json:"reference,omitempty"`
+	Display   string `json:"display,omitempty"`
+}
+
+type Period struct {
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+// TimingRepeat describes when a Timing repeats. Elements reminders cannot
+// represent are kept so that their presence can be reported.
+type TimingRepeat struct {
+	BoundsPeriod   *Period          `json:"boundsPeriod,omitempty"`
+	BoundsDuration *json.RawMessage `json:"boundsDuration,omitempty"`
+	BoundsRange    *json.RawMessage `json:"boundsRange,omitempty"`
+	Count          *int             `json:"count,omitempty"`
+	CountMax       *int             `json:"countMax,omitempty"`
+	Duration       *float64         `json:"duration,omitempty"`
+	DurationUnit   string           `json:"durationUnit,omitempty"`
+	Frequency      *int             `json:"frequency,omitempty"`
+	FrequencyMax   *int             `json:"frequencyMax,omitempty"`
+	Period         *float64         `json:"period,omitempty"`
+	PeriodMax      *float64         `json:"periodMax,omitempty"`
+	PeriodUnit     string           `json:"periodUnit,omitempty"`
+	DayOfWeek      []string         `json:"dayOfWeek,omitempty"`
+	TimeOfDay      []string         `json:"timeOfDay,omitempty"`
+	When           []string         `json:"when,omitempty"`
+	Offset         *int             `json:"offset,omitempty"`
+}
+
+type Timing struct {
+	Event  []string         `json:"event,omitempty"`
+	Repeat *TimingRepeat    `json:"repeat,omitempty"`
+	Code   *CodeableConcept `json:"code,omitempty"`
+}
+
+type Quantity struct {
+	Value *float64 `json:"value,omitempty"`
+	Unit  string   `json:"unit,omitempty"`
+}
+
+type DoseAndRate struct {
+	DoseQuantity *Quantity `json:"doseQuantity,omitempty"`
+}
+
+type Dosage struct {
+	Sequence           *int             `json:"sequence,omitempty"`
+	Text               string           `json:"text,omitempty"`
+	PatientInstruction string           `json:"patientInstruction,omitempty"`
+	Timing             *Timing          `json:"timing,omitempty"`
+	Route              *CodeableConcept `json:"route,omitempty"`
+	DoseAndRate        []DoseAndRate    `json:"doseAndRate,omitempty"`
+}
+
+type MedicationRequest struct {
+	ResourceType              string           `json:"resourceType"`
+	ID                        string           `json:"id,omitempty"`
+	Status                    string           `json:"status"`
+	Intent                    string           `json:"intent"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	Subject                   *Reference       `json:"subject,omitempty"`
+	AuthoredOn                string           `json:"authoredOn,omitempty"`
+	DosageInstruction         []Dosage         `json:"dosageInstruction,omitempty"`
+}
+
+type OperationOutcomeIssue struct {
+	Severity    string   `json:"severity"`
+	Code        string   `json:"code"`
+	Diagnostics string   `json:"diagnostics,omitempty"`
+	Expression  []string `json:"expression,omitempty"`
+}
+
+type OperationOutcome struct {
+	ResourceType string                  `json:"resourceType"`
+	Issue        []OperationOutcomeIssue `json:"issue"`
+}
+
+// NewOperationOutcome returns an outcome holding the given issues.
+func NewOperationOutcome(issues ...OperationOutcomeIssue) *OperationOutcome {
+	return &OperationOutcome{ResourceType: ResourceTypeOperationOutcome, Issue: issues}
+}
+
+type BundleSearch struct {
+	Mode string `json:"mode"`
+}
+
+type BundleResponse struct {
+	Status   string            `json:"status"`
+	Location string            `json:"location,omitempty"`
+	Outcome  *OperationOutcome `json:"outcome,omitempty"`
+}
+
+type BundleEntry struct {
+	FullURL  string          `json:"fullUrl,omitempty"`
+	Resource json.RawMessage `json:"resource,omitempty"`
+	Search   *BundleSearch   `json:"search,omitempty"`
+	Response *BundleResponse `json:"response,omitempty"`
+}
+
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Type         string        `json:"type"`
+	Total        *int          `json:"total,omitempty"`
+	Entry        []BundleEntry `json:"entry"`
+}
+
+// NewBundle returns an empty bundle of the given type.
+func NewBundle(bundleType string) *Bundle {
+	return &Bundle{ResourceType: ResourceTypeBundle, Type: bundleType, Entry: []BundleEntry{}}
+}
+
+// AddResource appends a resource entry to the bundle.
+func (b *Bundle) AddResource(fullURL string, resource any, search *BundleSearch) error {
+	raw, err := json.Marshal(resource)
+	if err != nil {
+		return err
+	}
+	b.Entry = append(b.Entry, BundleEntry{FullURL: fullURL, Resource: raw, Search: search})
+	return nil
+}
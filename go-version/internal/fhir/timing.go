@@ -0,0 +1,425 @@
+package fhir
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+// ErrUnrepresentable is returned when a Timing cannot be converted to a
+// reminder RRULE.
+type ErrUnrepresentable struct {
+	Reason string
+}
+
+func (e *ErrUnrepresentable) Error() string {
+	return "timing cannot be represented as a reminder: " + e.Reason
+}
+
+var periodUnits = map[rrule.Frequency]string{
+	rrule.YEARLY:   "a",
+	rrule.MONTHLY:  "mo",
+	rrule.WEEKLY:   "wk",
+	rrule.DAILY:    "d",
+	rrule.HOURLY:   "h",
+	rrule.MINUTELY: "min",
+}
+
+// unitMinutes is the length of the fixed-length period units.
+var unitMinutes = map[string]int{
+	"wk":  7 * 24 * 60,
+	"d":   24 * 60,
+	"h":   60,
+	"min": 1,
+}
+
+// daysOfWeek is indexed by rrule.Weekday.Day(), which starts on Monday.
+var daysOfWeek = []string{"mon", "tue", "wed", "thu", "fri", "sat", "sun"}
+
+var weekdays = []rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR, rrule.SA, rrule.SU}
+
+const timeOfDayLayout = "15:04:05"
+
+// TimingFromRRule describes a reminder's RRULE, starting at start, as a
+// Timing. Rule parts Timing has no equivalent for are left out, and each
+// one is described in the returned notes, so an empty result means the
+// conversion is lossless.
+func TimingFromRRule(rruleStr string, start time.Time) (*Timing, []string, error) {
+	opt, err := rrule.StrToROption(rruleStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	opt.Dtstart = start
+
+	var notes []string
+	lose := func(format string, args ...any) {
+		notes = append(notes, fmt.Sprintf(format, args...))
+	}
+
+	repeat := &TimingRepeat{}
+
+	unit, ok := periodUnits[opt.Freq]
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported frequency %s", opt.Freq)
+	}
+	interval := max(opt.Interval, 1)
+	period := float64(interval)
+	repeat.Period, repeat.PeriodUnit = &period, unit
+
+	subDaily := opt.Freq == rrule.HOURLY || opt.Freq == rrule.MINUTELY
+
+	perPeriod := 1
+	if !subDaily {
+		times := timesOfDay(opt, start)
+		repeat.TimeOfDay = times
+		perPeriod = len(times)
+	} else if len(opt.Byhour) > 0 || len(opt.Byminute) > 0 || len(opt.Bysecond) > 0 {
+		lose("BYHOUR, BYMINUTE and BYSECOND of %s rules are not represented", strings.ToLower(opt.Freq.String()))
+	}
+
+	switch {
+	case len(opt.Byweekday) > 0 && opt.Freq <= rrule.MONTHLY:
+		lose("BYDAY of %s rules is not represented", strings.ToLower(opt.Freq.String()))
+	case len(opt.Byweekday) > 0:
+		for _, day := range opt.Byweekday {
+			if day.N() != 0 {
+				lose("BYDAY %s is represented without its ordinal", day)
+			}
+			name := daysOfWeek[day.Day()]
+			if !slices.Contains(repeat.DayOfWeek, name) {
+				repeat.DayOfWeek = append(repeat.DayOfWeek, name)
+			}
+		}
+	case opt.Freq == rrule.WEEKLY:
+		repeat.DayOfWeek = []string{daysOfWeek[(int(start.Weekday())+6)%7]}
+	}
+	if opt.Freq == rrule.WEEKLY {
+		perPeriod *= len(repeat.DayOfWeek)
+	}
+	repeat.Frequency = &perPeriod
+
+	for name, present := range map[string]bool{
+		"BYMONTH":    len(opt.Bymonth) > 0,
+		"BYMONTHDAY": len(opt.Bymonthday) > 0,
+		"BYYEARDAY":  len(opt.Byyearday) > 0,
+		"BYWEEKNO":   len(opt.Byweekno) > 0,
+		"BYSETPOS":   len(opt.Bysetpos) > 0,
+		"BYEASTER":   len(opt.Byeaster) > 0,
+	} {
+		if present {
+			lose("%s is not represented", name)
+		}
+	}
+	slices.Sort(notes)
+
+	bounds := &Period{Start: FormatDateTime(start)}
+	if opt.Count > 0 {
+		count := opt.Count
+		repeat.Count = &count
+	}
+	if opt.Count > 0 || !opt.Until.IsZero() {
+		rule, err := rrule.NewRRule(*opt)
+		if err != nil {
+			return nil, nil, err
+		}
+		if occurrences := rule.All(); len(occurrences) > 0 {
+			bounds.End = FormatDateTime(occurrences[len(occurrences)-1])
+		}
+	}
+	repeat.BoundsPeriod = bounds
+
+	return &Timing{Repeat: repeat}, notes, nil
+}
+
+// timesOfDay lists the times a daily or longer rule occurs at, which
+// default to the time of its start.
+func timesOfDay(opt *rrule.ROption, start time.Time) []string {
+	hours, minutes, seconds := opt.Byhour, opt.Byminute, opt.Bysecond
+	if len(hours) == 0 {
+		hours = []int{start.Hour()}
+	}
+	if len(minutes) == 0 {
+		minutes = []int{start.Minute()}
+	}
+	if len(seconds) == 0 {
+		seconds = []int{start.Second()}
+	}
+
+	var times []string
+	for _, h := range hours {
+		for _, m := range minutes {
+			for _, s := range seconds {
+				times = append(times, fmt.Sprintf("%02d:%02d:%02d", h, m, s))
+			}
+		}
+	}
+	slices.Sort(times)
+	return slices.Compact(times)
+}
+
+// RRuleFromTiming converts a Timing to a reminder RRULE and its start.
+// Reminders are bounded, so the Timing must have a count or an end, and
+// its start is taken from boundsPeriod. A boundsPeriod end is turned into a
+// COUNT, and one that allows more than maxOccurrences is rejected. Elements
+// that can be dropped without changing when the reminder fires, or
+// approximated, are described in the returned notes; anything else is an
+// ErrUnrepresentable.
+func RRuleFromTiming(timing *Timing, maxOccurrences int) (string, time.Time, []string, error) {
+	fail := func(format string, args ...any) (string, time.Time, []string, error) {
+		return "", time.Time{}, nil, &ErrUnrepresentable{Reason: fmt.Sprintf(format, args...)}
+	}
+
+	if timing == nil {
+		return fail("timing is required")
+	}
+	if timing.Code != nil && timing.Repeat == nil {
+		return fail("timing.code without timing.repeat is not supported")
+	}
+
+	repeat := timing.Repeat
+	if repeat == nil {
+		if len(timing.Event) != 1 {
+			return fail("timing without repeat must have exactly one event")
+		}
+		start, err := ParseDateTime(timing.Event[0])
+		if err != nil {
+			return fail("timing.event: %s", err)
+		}
+		return "FREQ=DAILY;COUNT=1", start.UTC(), nil, nil
+	}
+
+	var notes []string
+	if len(timing.Event) > 0 {
+		notes = append(notes, "timing.event is ignored in favour of timing.repeat")
+	}
+	if timing.Code != nil {
+		notes = append(notes, "timing.code is ignored in favour of timing.repeat")
+	}
+
+	switch {
+	case len(repeat.When) > 0 || repeat.Offset != nil:
+		return fail("times relative to events such as meals (repeat.when) are not supported")
+	case repeat.BoundsDuration != nil || repeat.BoundsRange != nil:
+		return fail("only repeat.boundsPeriod is supported as bounds")
+	case repeat.BoundsPeriod == nil || repeat.BoundsPeriod.Start == "":
+		return fail("repeat.boundsPeriod.start is required")
+	}
+	if repeat.Duration != nil {
+		notes = append(notes, "repeat.duration is ignored; reminders have no duration")
+	}
+	if repeat.CountMax != nil {
+		notes = append(notes, "repeat.countMax is ignored; repeat.count is used")
+	}
+	if repeat.FrequencyMax != nil {
+		notes = append(notes, "repeat.frequencyMax is ignored; repeat.frequency is used")
+	}
+	if repeat.PeriodMax != nil {
+		notes = append(notes, "repeat.periodMax is ignored; repeat.period is used")
+	}
+
+	local, err := ParseDateTime(repeat.BoundsPeriod.Start)
+	if err != nil {
+		return fail("repeat.boundsPeriod.start: %s", err)
+	}
+	// Reminders are stored and expanded in UTC, so times of day are moved
+	// from the start's offset to UTC.
+	_, offset := local.Zone()
+	start := local.UTC()
+	crossesDay := local.Day() != start.Day()
+
+	frequency := 1
+	if repeat.Frequency != nil {
+		frequency = *repeat.Frequency
+	}
+	if frequency < 1 {
+		return fail("repeat.frequency must be at least 1")
+	}
+	period := 1.0
+	if repeat.Period != nil {
+		period = *repeat.Period
+	}
+	if period < 1 || period != float64(int(period)) {
+		return fail("repeat.period must be a whole number")
+	}
+
+	opt := rrule.ROption{Dtstart: start, Interval: int(period)}
+	var freqOK bool
+	for freq, unit := range periodUnits {
+		if unit == repeat.PeriodUnit {
+			opt.Freq, freqOK = freq, true
+		}
+	}
+	if !freqOK {
+		return fail("repeat.periodUnit %q is not supported", repeat.PeriodUnit)
+	}
+
+	for _, day := range repeat.DayOfWeek {
+		i := slices.Index(daysOfWeek, day)
+		if i < 0 {
+			return fail("repeat.dayOfWeek %q is not a day", day)
+		}
+		opt.Byweekday = append(opt.Byweekday, weekdays[i])
+	}
+	if len(opt.Byweekday) > 0 && opt.Freq <= rrule.MONTHLY {
+		return fail("repeat.dayOfWeek is only supported with periods of weeks or shorter")
+	}
+
+	if len(repeat.TimeOfDay) > 0 {
+		if opt.Freq == rrule.HOURLY || opt.Freq == rrule.MINUTELY {
+			return fail("repeat.timeOfDay is only supported with periods of a day or longer")
+		}
+		crossesDay, err = setTimesOfDay(&opt, repeat.TimeOfDay, offset)
+		if err != nil {
+			return fail("%s", err)
+		}
+		perPeriod := len(repeat.TimeOfDay)
+		if opt.Freq == rrule.WEEKLY && len(opt.Byweekday) > 0 {
+			perPeriod *= len(opt.Byweekday)
+		}
+		if repeat.Frequency != nil && frequency != perPeriod {
+			notes = append(notes, "repeat.frequency is ignored; the times in repeat.timeOfDay are used")
+		}
+	} else if frequency > 1 {
+		// Doses without set times are spread evenly over the period, which
+		// is only possible with whole minutes between them.
+		minutes, ok := unitMinutes[repeat.PeriodUnit]
+		if !ok || len(opt.Byweekday) > 0 {
+			return fail("repeat.frequency above 1 needs repeat.timeOfDay for this period")
+		}
+		total := minutes * opt.Interval
+		if total%frequency != 0 {
+			return fail("%d times per %g%s cannot be spread evenly", frequency, period, repeat.PeriodUnit)
+		}
+		step := total / frequency
+		if step%60 == 0 {
+			opt.Freq, opt.Interval = rrule.HOURLY, step/60
+		} else {
+			opt.Freq, opt.Interval = rrule.MINUTELY, step
+		}
+		notes = append(notes, fmt.Sprintf("%d times per %g%s were spread evenly, every %d minutes from repeat.boundsPeriod.start", frequency, period, repeat.PeriodUnit, step))
+	}
+
+	// Days of the week, months and years are counted in UTC too, which
+	// only matches the Timing when no time falls on another day in UTC.
+	if offset != 0 && crossesDay && (len(opt.Byweekday) > 0 || opt.Freq <= rrule.WEEKLY) {
+		return fail("times at offset %s fall on a different day in UTC", local.Format("-07:00"))
+	}
+
+	count, err := boundedCount(opt, repeat, maxOccurrences)
+	if err != nil {
+		return fail("%s", err)
+	}
+	opt.Count = count
+	opt.Dtstart = time.Time{}
+
+	return opt.RRuleString(), start, notes, nil
+}
+
+// setTimesOfDay sets the BYHOUR, BYMINUTE and BYSECOND parts producing
+// exactly the given times, moved to UTC from offset, when one rule can. It
+// reports whether moving any time changed its day.
+func setTimesOfDay(opt *rrule.ROption, times []string, offset int) (bool, error) {
+	var hours, minutes, seconds []int
+	crossesDay := false
+	seen := map[string]bool{}
+	for _, value := range times {
+		t, err := time.Parse(timeOfDayLayout, value)
+		if err != nil {
+			return false, fmt.Errorf("repeat.timeOfDay %q is not a time", value)
+		}
+		utc := t.Add(-time.Duration(offset) * time.Second)
+		crossesDay = crossesDay || utc.Day() != t.Day()
+		t = utc
+		key := t.Format(timeOfDayLayout)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		hours = appendUnique(hours, t.Hour())
+		minutes = appendUnique(minutes, t.Minute())
+		seconds = appendUnique(seconds, t.Second())
+	}
+
+	// A rule fires at every combination of its hours, minutes and seconds.
+	if len(hours)*len(minutes)*len(seconds) != len(seen) {
+		return false, fmt.Errorf("repeat.timeOfDay %s cannot be expressed by one rule", strings.Join(times, ", "))
+	}
+	opt.Byhour, opt.Byminute, opt.Bysecond = hours, minutes, seconds
+	return crossesDay, nil
+}
+
+func appendUnique(values []int, v int) []int {
+	if slices.Contains(values, v) {
+		return values
+	}
+	values = append(values, v)
+	slices.Sort(values)
+	return values
+}
+
+// boundedCount returns the number of occurrences allowed by both
+// repeat.count and repeat.boundsPeriod.end. The end may be arbitrarily far
+// off, so occurrences are only counted up to maxOccurrences.
+func boundedCount(opt rrule.ROption, repeat *TimingRepeat, maxOccurrences int) (int, error) {
+	count := 0
+	if repeat.Count != nil {
+		if *repeat.Count < 1 {
+			return 0, fmt.Errorf("repeat.count must be at least 1")
+		}
+		count = *repeat.Count
+	}
+
+	if repeat.BoundsPeriod.End != "" {
+		end, err := ParseDateTime(repeat.BoundsPeriod.End)
+		if err != nil {
+			return 0, fmt.Errorf("repeat.boundsPeriod.end: %s", err)
+		}
+		opt.Until = end
+		if count > 0 {
+			opt.Count = count
+		}
+		rule, err := rrule.NewRRule(opt)
+		if err != nil {
+			return 0, err
+		}
+		count = 0
+		next := rule.Iterator()
+		for _, ok := next(); ok; _, ok = next() {
+			count++
+			if count > maxOccurrences {
+				return 0, fmt.Errorf("repeat.boundsPeriod has more than the %d occurrences allowed", maxOccurrences)
+			}
+		}
+		if count == 0 {
+			return 0, fmt.Errorf("no occurrences fall within repeat.boundsPeriod")
+		}
+	}
+
+	if count == 0 {
+		return 0, fmt.Errorf("repeat.count or repeat.boundsPeriod.end is required")
+	}
+	return count, nil
+}
+
+// FormatDateTime formats t as a FHIR dateTime.
+func FormatDateTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
+
+// ParseDateTime parses a FHIR dateTime with at least a day. Dates and
+// times without an offset are read as UTC.
+func ParseDateTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	if _, err := strconv.Atoi(strings.ReplaceAll(value, "-", "")); err == nil {
+		return time.Time{}, fmt.Errorf("%q must include a day", value)
+	}
+	return time.Time{}, fmt.Errorf("%q is not a dateTime", value)
+}
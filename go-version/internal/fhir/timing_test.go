@@ -0,0 +1,169 @@
+package fhir
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+func TestTimingFromRRule(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	timing, notes, err := TimingFromRRule("FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=8,20;COUNT=6", start)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(notes) != 0 {
+		t.Errorf("Expected a lossless conversion, got %v", notes)
+	}
+
+	repeat := timing.Repeat
+	if repeat.PeriodUnit != "wk" || *repeat.Period != 1 || *repeat.Frequency != 4 {
+		t.Errorf("Expected 4 times every 1 wk, got %d every %g %s", *repeat.Frequency, *repeat.Period, repeat.PeriodUnit)
+	}
+	if !slices.Equal(repeat.DayOfWeek, []string{"mon", "wed"}) {
+		t.Errorf("Unexpected dayOfWeek %v", repeat.DayOfWeek)
+	}
+	if !slices.Equal(repeat.TimeOfDay, []string{"08:00:00", "20:00:00"}) {
+		t.Errorf("Unexpected timeOfDay %v", repeat.TimeOfDay)
+	}
+	if *repeat.Count != 6 {
+		t.Errorf("Expected count 6, got %d", *repeat.Count)
+	}
+	if repeat.BoundsPeriod.Start != "2024-01-01T08:00:00Z" || repeat.BoundsPeriod.End != "2024-01-08T20:00:00Z" {
+		t.Errorf("Unexpected boundsPeriod %+v", repeat.BoundsPeriod)
+	}
+}
+
+func TestTimingFromRRuleReportsLostParts(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	_, notes, err := TimingFromRRule("FREQ=MONTHLY;BYDAY=1MO;BYSETPOS=1;COUNT=3", start)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"BYDAY of monthly rules is not represented", "BYSETPOS is not represented"}
+	if !slices.Equal(notes, expected) {
+		t.Errorf("Expected notes %v, got %v", expected, notes)
+	}
+}
+
+func TestRRuleFromTimingRoundTrip(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	for _, rule := range []string{
+		"FREQ=DAILY;COUNT=5",
+		"FREQ=DAILY;INTERVAL=2;BYHOUR=8,20;COUNT=7",
+		"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=8,20;COUNT=6",
+		"FREQ=HOURLY;INTERVAL=6;COUNT=4",
+		"FREQ=DAILY;BYDAY=SA,SU;COUNT=4",
+	} {
+		timing, _, err := TimingFromRRule(rule, start)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", rule, err)
+		}
+		converted, convertedStart, notes, err := RRuleFromTiming(timing, 10000)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", rule, err)
+		}
+		if len(notes) != 0 {
+			t.Errorf("%s: expected no notes, got %v", rule, notes)
+		}
+
+		want := occurrences(t, rule, start)
+		got := occurrences(t, converted, convertedStart)
+		if !slices.EqualFunc(want, got, time.Time.Equal) {
+			t.Errorf("%s: converted to %s, which occurs at %v instead of %v", rule, converted, got, want)
+		}
+	}
+}
+
+func TestRRuleFromTiming(t *testing.T) {
+	three, nine := 3, 9
+	timing := &Timing{Repeat: &TimingRepeat{
+		Frequency:    &three,
+		PeriodUnit:   "d",
+		Count:        &nine,
+		BoundsPeriod: &Period{Start: "2024-01-01T08:00:00+02:00"},
+	}}
+
+	rule, start, notes, err := RRuleFromTiming(timing, 10000)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rule != "FREQ=HOURLY;INTERVAL=8;COUNT=9" {
+		t.Errorf("Unexpected rule %s", rule)
+	}
+	if !start.Equal(time.Date(2024, 1, 1, 6, 0, 0, 0, time.UTC)) || start.Location() != time.UTC {
+		t.Errorf("Expected the start in UTC, got %v", start)
+	}
+	if len(notes) != 1 {
+		t.Errorf("Expected a note about spreading doses, got %v", notes)
+	}
+}
+
+func TestRRuleFromTimingCountsUntilEnd(t *testing.T) {
+	timing := &Timing{Repeat: &TimingRepeat{
+		PeriodUnit:   "wk",
+		DayOfWeek:    []string{"mon"},
+		TimeOfDay:    []string{"08:00:00", "20:00:00"},
+		BoundsPeriod: &Period{Start: "2024-01-01", End: "2024-02-01"},
+	}}
+
+	rule, _, _, err := RRuleFromTiming(timing, 10000)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rule != "FREQ=WEEKLY;INTERVAL=1;COUNT=10;BYDAY=MO;BYHOUR=8,20;BYMINUTE=0;BYSECOND=0" {
+		t.Errorf("Unexpected rule %s", rule)
+	}
+
+	if _, _, _, err := RRuleFromTiming(timing, 9); err == nil {
+		t.Errorf("Expected an end allowing more than the maximum occurrences to be rejected")
+	}
+}
+
+func TestRRuleFromTimingRejectsUnrepresentable(t *testing.T) {
+	one, five := 1, 5
+	bounds := &Period{Start: "2024-01-01T08:00:00Z"}
+
+	for name, repeat := range map[string]*TimingRepeat{
+		"unbounded":            {PeriodUnit: "d", BoundsPeriod: bounds},
+		"no start":             {PeriodUnit: "d", Count: &five},
+		"meal times":           {PeriodUnit: "d", Count: &five, BoundsPeriod: bounds, When: []string{"ACM"}},
+		"seconds":              {PeriodUnit: "s", Count: &five, BoundsPeriod: bounds},
+		"fractional period":    {PeriodUnit: "d", Period: ptr(0.5), Count: &five, BoundsPeriod: bounds},
+		"uneven spread":        {PeriodUnit: "d", Frequency: ptr(7), Count: &five, BoundsPeriod: bounds},
+		"times of a month":     {PeriodUnit: "mo", Frequency: ptr(2), Count: &five, BoundsPeriod: bounds},
+		"mismatched times":     {PeriodUnit: "d", TimeOfDay: []string{"08:00:00", "20:30:00"}, Count: &five, BoundsPeriod: bounds},
+		"hourly time of day":   {PeriodUnit: "h", Frequency: &one, TimeOfDay: []string{"08:00:00"}, Count: &five, BoundsPeriod: bounds},
+		"day moved by offset":  {PeriodUnit: "wk", DayOfWeek: []string{"mon"}, TimeOfDay: []string{"01:00:00"}, Count: &five, BoundsPeriod: &Period{Start: "2024-01-01T00:00:00+02:00"}},
+		"monthly day of week":  {PeriodUnit: "mo", DayOfWeek: []string{"mon"}, Count: &five, BoundsPeriod: bounds},
+		"unknown day of week":  {PeriodUnit: "d", DayOfWeek: []string{"someday"}, Count: &five, BoundsPeriod: bounds},
+		"end before the start": {PeriodUnit: "d", BoundsPeriod: &Period{Start: "2024-01-02", End: "2024-01-01"}},
+		"end centuries away":   {PeriodUnit: "h", Frequency: &one, BoundsPeriod: &Period{Start: "2024-01-01", End: "2999-01-01"}},
+	} {
+		_, _, _, err := RRuleFromTiming(&Timing{Repeat: repeat}, 10000)
+		var unrepresentable *ErrUnrepresentable
+		if !errors.As(err, &unrepresentable) {
+			t.Errorf("%s: expected ErrUnrepresentable, got %v", name, err)
+		}
+	}
+}
+
+func occurrences(t *testing.T, rule string, start time.Time) []time.Time {
+	t.Helper()
+	r, err := rrule.StrToRRule(rule)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", rule, err)
+	}
+	r.DTStart(start)
+	return r.All()
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
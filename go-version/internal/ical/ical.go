@@ -0,0 +1,172 @@
+// Package ical renders reminders as an RFC 5545 iCalendar feed.
+package ical
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"go-version/internal/api/models"
+)
+
+const (
+	productID = "-//Folia//Reminders//EN"
+
+	dateTimeLayout = "20060102T150405"
+
+	// maxLineOctets is the longest a content line may be before it must be
+	// folded, excluding the CRLF.
+	maxLineOctets = 75
+)
+
+// WriteCalendar writes a VCALENDAR containing one recurring VEVENT per
+// reminder. Occurrences are instantaneous, so events have no duration.
+func WriteCalendar(w io.Writer, name string, reminders []models.Reminder) error {
+	cw := &contentWriter{w: bufio.NewWriter(w)}
+
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:" + productID)
+	cw.line("CALSCALE:GREGORIAN")
+	cw.line("METHOD:PUBLISH")
+	if name != "" {
+		cw.line("X-WR-CALNAME:" + escapeText(name))
+	}
+
+	written := map[int]bool{}
+	for i := range reminders {
+		_, offset := reminders[i].StartAt.Zone()
+		if !written[offset] {
+			writeTimeZone(cw, offset)
+			written[offset] = true
+		}
+	}
+
+	for i := range reminders {
+		writeEvent(cw, &reminders[i])
+	}
+
+	cw.line("END:VCALENDAR")
+
+	if cw.err != nil {
+		return cw.err
+	}
+	return cw.w.Flush()
+}
+
+// writeTimeZone writes a VTIMEZONE fixed at offset seconds east of UTC.
+// Reminders recur in the offset of their start, so anchoring each event to
+// a zone with that offset makes calendar clients expand BYHOUR, BYDAY and
+// the like on the same instants as the server.
+func writeTimeZone(cw *contentWriter, offset int) {
+	cw.line("BEGIN:VTIMEZONE")
+	cw.line("TZID:" + timeZoneID(offset))
+	cw.line("BEGIN:STANDARD")
+	cw.line("DTSTART:19700101T000000")
+	cw.line("TZOFFSETFROM:" + formatOffset(offset))
+	cw.line("TZOFFSETTO:" + formatOffset(offset))
+	cw.line("TZNAME:" + timeZoneID(offset))
+	cw.line("END:STANDARD")
+	cw.line("END:VTIMEZONE")
+}
+
+// timeZoneID names the zone fixed at offset seconds east of UTC, such as
+// "UTC+0200". Parse resolves these names back to the offset.
+func timeZoneID(offset int) string {
+	if offset == 0 {
+		return "UTC"
+	}
+	return "UTC" + formatOffset(offset)
+}
+
+// formatOffset formats an offset as a UTC-OFFSET value, such as "+0530".
+func formatOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign = "-"
+		offset = -offset
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+	return s
+}
+
+func writeEvent(cw *contentWriter, reminder *models.Reminder) {
+	summary := "Reminder"
+	if reminder.Description != nil && *reminder.Description != "" {
+		summary = *reminder.Description
+	}
+
+	stamp := reminder.StartAt
+	if reminder.UpdatedAt != nil {
+		stamp = *reminder.UpdatedAt
+	} else if reminder.CreatedAt != nil {
+		stamp = *reminder.CreatedAt
+	}
+
+	cw.line("BEGIN:VEVENT")
+	// Reminder ids are UUIDs, so they are globally unique as required of
+	// UIDs. Using them unchanged lets CalDAV clients name resources by UID.
+	cw.line("UID:" + reminder.Id)
+	cw.line("DTSTAMP:" + stamp.UTC().Format(dateTimeLayout) + "Z")
+	_, offset := reminder.StartAt.Zone()
+	cw.line("DTSTART;TZID=" + timeZoneID(offset) + ":" + reminder.StartAt.Format(dateTimeLayout))
+	if reminder.RRule != "" {
+		cw.line("RRULE:" + reminder.RRule)
+	}
+	cw.line("SUMMARY:" + escapeText(summary))
+	cw.line("TRANSP:TRANSPARENT")
+	cw.line("END:VEVENT")
+}
+
+// escapeText escapes a TEXT property value as described in RFC 5545
+// section 3.3.11.
+func escapeText(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	)
+	return replacer.Replace(s)
+}
+
+// contentWriter writes CRLF terminated content lines, folding them at 75
+// octets without splitting UTF-8 sequences. The first error is kept and
+// later writes are skipped.
+type contentWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (cw *contentWriter) line(s string) {
+	if cw.err != nil {
+		return
+	}
+
+	limit := maxLineOctets
+	for len(s) > limit {
+		cut := limit
+		// Back up to the start of a UTF-8 sequence.
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		cw.write(s[:cut] + "\r\n ")
+		s = s[cut:]
+		// Continuation lines begin with a space, which counts.
+		limit = maxLineOctets - 1
+	}
+	cw.write(s + "\r\n")
+}
+
+func (cw *contentWriter) write(s string) {
+	if cw.err != nil {
+		return
+	}
+	_, cw.err = cw.w.WriteString(s)
+}
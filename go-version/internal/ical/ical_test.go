@@ -0,0 +1,175 @@
+package ical
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"go-version/internal/api/models"
+	"go-version/internal/recurrence"
+)
+
+func TestWriteCalendar(t *testing.T) {
+	description := "Take pills; with water, not juice"
+	reminders := []models.Reminder{
+		{
+			Id:          "reminder-1",
+			RRule:       "FREQ=DAILY;COUNT=10",
+			StartAt:     time.Date(2023, 10, 1, 9, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+			Description: &description,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCalendar(&buf, "My reminders", reminders); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-CALNAME:My reminders\r\n",
+		"UID:reminder-1\r\n",
+		"TZOFFSETTO:+0200\r\n",
+		"DTSTART;TZID=UTC+0200:20231001T090000\r\n",
+		"RRULE:FREQ=DAILY;COUNT=10\r\n",
+		`SUMMARY:Take pills\; with water\, not juice` + "\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	if strings.Count(out, "BEGIN:VEVENT") != 1 {
+		t.Errorf("Expected one event, got:\n%s", out)
+	}
+}
+
+func TestWriteCalendar_KeepsTheStartOffset(t *testing.T) {
+	reminders := []models.Reminder{
+		{
+			Id:      "reminder-1",
+			RRule:   "FREQ=WEEKLY;BYDAY=MO;BYHOUR=8,20",
+			StartAt: time.Date(2023, 10, 2, 8, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+		},
+		{
+			Id:      "reminder-2",
+			RRule:   "FREQ=DAILY;BYHOUR=22",
+			StartAt: time.Date(2023, 10, 1, 22, 0, 0, 0, time.FixedZone("IST", 5*60*60+30*60)),
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCalendar(&buf, "", reminders); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"TZID:UTC-0400\r\n",
+		"TZOFFSETTO:-0400\r\n",
+		"DTSTART;TZID=UTC-0400:20231002T080000\r\n",
+		"TZID:UTC+0530\r\n",
+		"TZOFFSETTO:+0530\r\n",
+		"DTSTART;TZID=UTC+0530:20231001T220000\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+
+	// Read back, the events must recur on the same instants as the
+	// reminders.
+	entries, err := Parse(strings.NewReader(out))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != len(reminders) {
+		t.Fatalf("Expected %d entries, got %d", len(reminders), len(entries))
+	}
+	after := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	for i, entry := range entries {
+		want, err := recurrence.From(reminders[i].RRule, reminders[i].StartAt, after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := recurrence.From(entry.RRule, entry.Start, after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for n := 0; n < 10; n++ {
+			wantAt, _ := want()
+			gotAt, _ := got()
+			if !gotAt.Equal(wantAt) {
+				t.Errorf("%s: expected occurrence %d at %v, got %v", reminders[i].Id, n, wantAt, gotAt)
+			}
+		}
+	}
+}
+
+func TestEscapeText(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{in: "plain", want: "plain"},
+		{in: `back\slash`, want: `back\\slash`},
+		{in: "a;b,c", want: `a\;b\,c`},
+		{in: "line\r\nbreak\nhere", want: `line\nbreak\nhere`},
+	}
+
+	for _, tc := range testCases {
+		if got := escapeText(tc.in); got != tc.want {
+			t.Errorf("escapeText(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestContentWriterFolding(t *testing.T) {
+	testCases := []struct {
+		name string
+		line string
+	}{
+		{name: "ascii", line: "SUMMARY:" + strings.Repeat("a", 200)},
+		{name: "multibyte", line: "SUMMARY:" + strings.Repeat("é", 100)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			cw := &contentWriter{w: bufio.NewWriter(&buf)}
+			cw.line(tc.line)
+			cw.w.Flush()
+
+			out := buf.String()
+			if !strings.HasSuffix(out, "\r\n") {
+				t.Fatalf("Expected output to end with CRLF, got %q", out)
+			}
+
+			physical := strings.Split(strings.TrimSuffix(out, "\r\n"), "\r\n")
+			if len(physical) < 2 {
+				t.Fatalf("Expected the line to be folded, got %q", out)
+			}
+
+			var unfolded strings.Builder
+			for i, p := range physical {
+				if len(p) > maxLineOctets {
+					t.Errorf("Line %d is %d octets long", i, len(p))
+				}
+				if i > 0 {
+					if !strings.HasPrefix(p, " ") {
+						t.Fatalf("Continuation line %d does not start with a space: %q", i, p)
+					}
+					p = p[1:]
+				}
+				unfolded.WriteString(p)
+			}
+			if unfolded.String() != tc.line {
+				t.Errorf("Unfolded line does not match the original")
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+package ical
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const dateLayout = "20060102"
+
+// Entry is a VEVENT or VTODO read from a calendar. Err is set when the
+// entry's properties cannot be interpreted, in which case the other fields
+// may be incomplete.
+type Entry struct {
+	Component string
+	UID       string
+	Summary   string
+	Status    string
+	RRule     string
+	Start     time.Time
+	HasStart  bool
+	ExDates   []time.Time
+	// RecurrenceID is set on entries that override a single occurrence of
+	// another entry.
+	RecurrenceID bool
+	Err          error
+}
+
+// ErrMalformedCalendar is returned by Parse when the input is not an
+// iCalendar document.
+type ErrMalformedCalendar struct {
+	Line   int
+	Reason string
+}
+
+func (e *ErrMalformedCalendar) Error() string {
+	if e.Line == 0 {
+		return "malformed calendar: " + e.Reason
+	}
+	return fmt.Sprintf("malformed calendar: content line %d: %s", e.Line, e.Reason)
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads the VEVENT and VTODO entries of an RFC 5545 calendar. Other
+// components, such as VTIMEZONE and VALARM, are ignored; TZID parameters
+// are resolved against the IANA time zone database instead, or are the
+// fixed-offset zones WriteCalendar names. Times without
+// a time zone and all-day dates are read as UTC.
+func Parse(r io.Reader) ([]Entry, error) {
+	lines, err := unfold(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		entries     []Entry
+		stack       []string
+		current     *Entry
+		sawCalendar bool
+	)
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, &ErrMalformedCalendar{Line: i + 1, Reason: err.Error()}
+		}
+
+		switch prop.name {
+		case "BEGIN":
+			component := strings.ToUpper(prop.value)
+			if len(stack) == 0 && component != "VCALENDAR" {
+				return nil, &ErrMalformedCalendar{Line: i + 1, Reason: "expected BEGIN:VCALENDAR"}
+			}
+			if component == "VCALENDAR" {
+				sawCalendar = true
+			}
+			stack = append(stack, component)
+			if len(stack) == 2 && (component == "VEVENT" || component == "VTODO") {
+				current = &Entry{Component: component}
+			}
+			continue
+		case "END":
+			component := strings.ToUpper(prop.value)
+			if len(stack) == 0 || stack[len(stack)-1] != component {
+				return nil, &ErrMalformedCalendar{Line: i + 1, Reason: "unexpected END:" + component}
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 1 && current != nil {
+				entries = append(entries, *current)
+				current = nil
+			}
+			continue
+		}
+
+		// Only the entry's own properties count, not those of nested
+		// components such as VALARM.
+		if current == nil || len(stack) != 2 {
+			continue
+		}
+		applyProperty(current, prop)
+	}
+
+	if !sawCalendar {
+		return nil, &ErrMalformedCalendar{Reason: "no VCALENDAR found"}
+	}
+	if len(stack) != 0 {
+		return nil, &ErrMalformedCalendar{Reason: "missing END:" + stack[len(stack)-1]}
+	}
+
+	return entries, nil
+}
+
+func applyProperty(entry *Entry, prop property) {
+	switch prop.name {
+	case "UID":
+		entry.UID = prop.value
+	case "SUMMARY":
+		entry.Summary = unescapeText(prop.value)
+	case "STATUS":
+		entry.Status = strings.ToUpper(prop.value)
+	case "RRULE":
+		if entry.RRule != "" {
+			entry.setErr(errors.New("more than one RRULE is not supported"))
+			return
+		}
+		entry.RRule = prop.value
+	case "RECURRENCE-ID":
+		entry.RecurrenceID = true
+	case "DTSTART":
+		start, err := parseDateTime(prop.value, prop.params)
+		if err != nil {
+			entry.setErr(fmt.Errorf("DTSTART: %w", err))
+			return
+		}
+		entry.Start, entry.HasStart = start, true
+	case "DUE":
+		// A to-do without a start date is due, and so reminded of, at DUE.
+		if entry.Component != "VTODO" || entry.HasStart {
+			return
+		}
+		due, err := parseDateTime(prop.value, prop.params)
+		if err != nil {
+			entry.setErr(fmt.Errorf("DUE: %w", err))
+			return
+		}
+		entry.Start, entry.HasStart = due, true
+	case "EXDATE":
+		for _, value := range strings.Split(prop.value, ",") {
+			exdate, err := parseDateTime(value, prop.params)
+			if err != nil {
+				entry.setErr(fmt.Errorf("EXDATE: %w", err))
+				return
+			}
+			entry.ExDates = append(entry.ExDates, exdate)
+		}
+	}
+}
+
+func (e *Entry) setErr(err error) {
+	if e.Err == nil {
+		e.Err = err
+	}
+}
+
+func parseDateTime(value string, params map[string]string) (time.Time, error) {
+	if params["VALUE"] == "DATE" || len(value) == len(dateLayout) {
+		return time.Parse(dateLayout, value)
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		return time.Parse(dateTimeLayout+"Z", value)
+	}
+
+	loc := time.UTC
+	if tzid := params["TZID"]; tzid != "" {
+		var err error
+		loc, err = time.LoadLocation(strings.TrimPrefix(tzid, "/"))
+		if err != nil {
+			loc, err = parseFixedZone(tzid)
+		}
+		if err != nil || tzid == "Local" {
+			return time.Time{}, fmt.Errorf("unknown time zone %q", tzid)
+		}
+	}
+	return time.ParseInLocation(dateTimeLayout, value, loc)
+}
+
+// parseFixedZone resolves the fixed-offset zones WriteCalendar names, such
+// as "UTC+0200".
+func parseFixedZone(tzid string) (*time.Location, error) {
+	offset, ok := strings.CutPrefix(tzid, "UTC")
+	if !ok {
+		return nil, fmt.Errorf("unknown time zone %q", tzid)
+	}
+
+	layout := "-0700"
+	if len(offset) == len("-070000") {
+		layout = "-070000"
+	}
+	t, err := time.Parse(layout, offset)
+	if err != nil {
+		return nil, err
+	}
+	_, seconds := t.Zone()
+	return time.FixedZone(tzid, seconds), nil
+}
+
+// parseProperty splits a content line into its name, parameters and value.
+// Parameter values may be quoted, and quoted values may contain ':' and ';'.
+func parseProperty(line string) (property, error) {
+	prop := property{params: map[string]string{}}
+
+	i := strings.IndexAny(line, ";:")
+	if i <= 0 {
+		return prop, errors.New("expected a property name")
+	}
+	prop.name = strings.ToUpper(line[:i])
+
+	for line[i] == ';' {
+		rest := line[i+1:]
+		eq := strings.IndexByte(rest, '=')
+		if eq <= 0 {
+			return prop, errors.New("malformed parameter")
+		}
+		name := strings.ToUpper(rest[:eq])
+		rest = rest[eq+1:]
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return prop, errors.New("unterminated quoted parameter")
+			}
+			value = rest[1 : end+1]
+			rest = rest[end+2:]
+		} else {
+			end := strings.IndexAny(rest, ";:")
+			if end < 0 {
+				return prop, errors.New("expected ':' before the value")
+			}
+			value = rest[:end]
+			rest = rest[end:]
+		}
+		prop.params[name] = value
+
+		i = len(line) - len(rest)
+		if i >= len(line) || (line[i] != ';' && line[i] != ':') {
+			return prop, errors.New("expected ':' before the value")
+		}
+	}
+
+	prop.value = line[i+1:]
+	return prop, nil
+}
+
+// unfold joins folded content lines. Both CRLF and bare LF line endings are
+// accepted, since exported files are often rewritten by other tools.
+func unfold(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+func unescapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
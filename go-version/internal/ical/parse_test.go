@@ -0,0 +1,130 @@
+package ical
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"go-version/internal/api/models"
+)
+
+func TestParse(t *testing.T) {
+	input := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Berlin",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:event-1",
+		"SUMMARY:Take pills\\, twice",
+		"DTSTART;TZID=Europe/Berlin:20231001T090000",
+		"RRULE:FREQ=DAILY;COUNT=5",
+		"EXDATE;TZID=Europe/Berlin:20231002T090000,20231003T090000",
+		"BEGIN:VALARM",
+		"SUMMARY:Alarm",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VTODO",
+		"UID:todo-1",
+		"SUMMARY:A very long summary that has been folded",
+		"  across two lines",
+		"DUE;VALUE=DATE:20231005",
+		"STATUS:COMPLETED",
+		"END:VTODO",
+		"BEGIN:VEVENT",
+		"UID:event-2",
+		"DTSTART;TZID=Nowhere/Special:20231001T090000",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	entries, err := Parse(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	event := entries[0]
+	if event.Component != "VEVENT" || event.UID != "event-1" || event.Summary != "Take pills, twice" {
+		t.Errorf("Unexpected event %+v", event)
+	}
+	if !event.HasStart || !event.Start.Equal(time.Date(2023, 10, 1, 9, 0, 0, 0, berlin)) {
+		t.Errorf("Unexpected DTSTART %v", event.Start)
+	}
+	if event.RRule != "FREQ=DAILY;COUNT=5" {
+		t.Errorf("Unexpected RRULE %q", event.RRule)
+	}
+	if len(event.ExDates) != 2 || !event.ExDates[1].Equal(time.Date(2023, 10, 3, 9, 0, 0, 0, berlin)) {
+		t.Errorf("Unexpected EXDATEs %v", event.ExDates)
+	}
+
+	todo := entries[1]
+	if todo.Summary != "A very long summary that has been folded across two lines" {
+		t.Errorf("Unexpected summary %q", todo.Summary)
+	}
+	if todo.Status != "COMPLETED" || !todo.Start.Equal(time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected to-do %+v", todo)
+	}
+
+	if entries[2].Err == nil {
+		t.Error("Expected an error for an unknown time zone")
+	}
+}
+
+func TestParse_Malformed(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{name: "not a calendar", input: "hello world"},
+		{name: "empty", input: ""},
+		{name: "unbalanced", input: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VCALENDAR\r\n"},
+		{name: "unterminated", input: "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VEVENT\r\n"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tc.input))
+			var malformedErr *ErrMalformedCalendar
+			if !errors.As(err, &malformedErr) {
+				t.Errorf("Expected ErrMalformedCalendar, got %v", err)
+			}
+		})
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	description := "Stretch; then rest, with a break\nafterwards"
+	reminders := []models.Reminder{
+		{
+			Id:          "reminder-1",
+			RRule:       "FREQ=WEEKLY;COUNT=4",
+			StartAt:     time.Date(2023, 10, 1, 7, 30, 0, 0, time.UTC),
+			Description: &description,
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCalendar(&buf, strings.Repeat("Calendar ", 20), reminders); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	entries, err := Parse(&buf)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+
+	entry := entries[0]
+	if entry.Summary != description || entry.RRule != reminders[0].RRule || !entry.Start.Equal(reminders[0].StartAt) {
+		t.Errorf("Round trip changed the reminder: %+v", entry)
+	}
+}
@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store"
+	"go-version/internal/recurrence"
+)
+
+const defaultTitle = "Reminder"
+
+// Dispatcher periodically expands the occurrences of every active reminder
+// and hands each occurrence that fell due since the previous tick to the
+// configured senders. Non-critical occurrences that fall within the user's
+// quiet hours are held back until the window ends.
+type Dispatcher struct {
+	reminderStore   store.ReminderStoreInterface
+	quietHoursStore store.QuietHoursStoreInterface
+	limits          recurrence.Limits
+	senders         []Sender
+	interval        time.Duration
+	now             func() time.Time
+}
+
+func NewDispatcher(reminderStore store.ReminderStoreInterface, quietHoursStore store.QuietHoursStoreInterface, limits recurrence.Limits, interval time.Duration, senders ...Sender) *Dispatcher {
+	return &Dispatcher{
+		reminderStore:   reminderStore,
+		quietHoursStore: quietHoursStore,
+		limits:          limits,
+		senders:         senders,
+		interval:        interval,
+		now:             time.Now,
+	}
+}
+
+// Run blocks until ctx is cancelled. Occurrences that were due before Run was
+// called are not delivered.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	last := d.now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := d.now()
+			if err := d.Dispatch(ctx, last, now); err != nil {
+				fmt.Println("Error dispatching reminders:", err)
+			}
+			last = now
+		}
+	}
+}
+
+// Dispatch delivers every occurrence whose delivery time falls in the
+// half-open window (from, to].
+func (d *Dispatcher) Dispatch(ctx context.Context, from, to time.Time) error {
+	reminders, err := d.reminderStore.ListActiveReminders(ctx, to)
+	if err != nil {
+		return err
+	}
+
+	quietHours, err := d.quietHoursStore.ListQuietHours(ctx)
+	if err != nil {
+		return err
+	}
+	quietHoursByUser := make(map[string]*models.QuietHours, len(quietHours))
+	for i := range quietHours {
+		quietHoursByUser[quietHours[i].UserId] = &quietHours[i]
+	}
+
+	for i := range reminders {
+		reminder := &reminders[i]
+		userQuietHours := quietHoursByUser[reminder.UserId]
+
+		// Look back far enough to pick up occurrences deferred into this
+		// window by quiet hours.
+		windowStart := from
+		if userQuietHours != nil && !reminder.Critical {
+			windowStart = from.Add(-models.MaxQuietHoursDeferral)
+		}
+
+		occurrences, err := reminder.OccurrencesBetween(windowStart, to, d.limits)
+		if err != nil {
+			continue
+		}
+		for _, occurrence := range occurrences {
+			deliverAt := reminder.DeliveryTime(occurrence, userQuietHours)
+			if !deliverAt.After(from) || deliverAt.After(to) {
+				continue
+			}
+			d.send(ctx, NewReminderMessage(reminder, occurrence))
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, msg *Message) {
+	for _, sender := range d.senders {
+		if err := sender.Send(ctx, msg); err != nil {
+			fmt.Printf("Error sending reminder %s to user %s: %v\n", msg.ReminderID, msg.UserID, err)
+		}
+	}
+}
+
+func NewReminderMessage(reminder *models.Reminder, occurrence time.Time) *Message {
+	body := "You have a reminder due"
+	if reminder.Description != nil && *reminder.Description != "" {
+		body = *reminder.Description
+	}
+
+	return &Message{
+		UserID:       reminder.UserId,
+		ReminderID:   reminder.Id,
+		Title:        defaultTitle,
+		Body:         body,
+		OccurrenceAt: occurrence,
+	}
+}
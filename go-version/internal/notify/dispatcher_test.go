@@ -0,0 +1,148 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go-version/internal/api/models"
+	"go-version/internal/api/store/mocks"
+	"go-version/internal/api/utils"
+	"go-version/internal/recurrence"
+
+	"go.uber.org/mock/gomock"
+)
+
+type recordingSender struct {
+	messages []*Message
+	err      error
+}
+
+func (s *recordingSender) Send(ctx context.Context, msg *Message) error {
+	s.messages = append(s.messages, msg)
+	return s.err
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	quietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+
+	startAt := time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC)
+	from := time.Date(2023, 10, 3, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2023, 10, 5, 9, 0, 0, 0, time.UTC)
+
+	mockStore.EXPECT().
+		ListActiveReminders(gomock.Any(), to).
+		Return([]models.Reminder{
+			{
+				Id:          "reminder-1",
+				UserId:      "user-123",
+				RRule:       "FREQ=DAILY;COUNT=10",
+				Description: utils.StringPtr("Take medication"),
+				StartAt:     startAt,
+			},
+			{
+				Id:      "reminder-2",
+				UserId:  "user-456",
+				RRule:   "FREQ=WEEKLY;COUNT=2",
+				StartAt: startAt,
+			},
+		}, nil).
+		Times(1)
+
+	quietHoursStore.EXPECT().ListQuietHours(gomock.Any()).Return(nil, nil).Times(1)
+
+	sender := &recordingSender{err: errors.New("delivery failed")}
+	dispatcher := NewDispatcher(mockStore, quietHoursStore, recurrence.DefaultLimits(), time.Minute, sender)
+
+	if err := dispatcher.Dispatch(context.Background(), from, to); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The window is (from, to]: Oct 4 and Oct 5 for the daily reminder and
+	// nothing for the weekly one (Oct 1 and Oct 8).
+	if len(sender.messages) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(sender.messages))
+	}
+	for i, want := range []time.Time{from.AddDate(0, 0, 1), to} {
+		msg := sender.messages[i]
+		if !msg.OccurrenceAt.Equal(want) {
+			t.Errorf("message %d: expected occurrence %v, got %v", i, want, msg.OccurrenceAt)
+		}
+		if msg.UserID != "user-123" || msg.Body != "Take medication" {
+			t.Errorf("message %d: unexpected contents %+v", i, msg)
+		}
+	}
+}
+
+func TestDispatcher_DispatchStoreError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	quietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+	mockStore.EXPECT().
+		ListActiveReminders(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("database error")).
+		Times(1)
+
+	sender := &recordingSender{}
+	dispatcher := NewDispatcher(mockStore, quietHoursStore, recurrence.DefaultLimits(), time.Minute, sender)
+
+	now := time.Now()
+	if err := dispatcher.Dispatch(context.Background(), now.Add(-time.Minute), now); err == nil {
+		t.Error("Expected error but got none")
+	}
+	if len(sender.messages) != 0 {
+		t.Errorf("Expected no messages, got %d", len(sender.messages))
+	}
+}
+
+func TestDispatcher_DispatchQuietHours(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mocks.NewMockReminderStoreInterface(ctrl)
+	quietHoursStore := mocks.NewMockQuietHoursStoreInterface(ctrl)
+
+	// Quiet from 22:00 to 07:00 in Amsterdam (UTC+2 in October), i.e. 20:00
+	// to 05:00 UTC. Both reminders fire daily at 01:00 UTC.
+	startAt := time.Date(2023, 10, 1, 1, 0, 0, 0, time.UTC)
+	windowEnd := time.Date(2023, 10, 3, 5, 0, 0, 0, time.UTC)
+
+	reminders := []models.Reminder{
+		{Id: "routine", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=10", StartAt: startAt},
+		{Id: "critical", UserId: "user-123", RRule: "FREQ=DAILY;COUNT=10", StartAt: startAt, Critical: true},
+	}
+	mockStore.EXPECT().ListActiveReminders(gomock.Any(), gomock.Any()).Return(reminders, nil).Times(2)
+	quietHoursStore.EXPECT().ListQuietHours(gomock.Any()).Return([]models.QuietHours{
+		{UserId: "user-123", StartTime: "22:00", EndTime: "07:00", TimeZone: "Europe/Amsterdam"},
+	}, nil).Times(2)
+
+	sender := &recordingSender{}
+	dispatcher := NewDispatcher(mockStore, quietHoursStore, recurrence.DefaultLimits(), time.Minute, sender)
+
+	// The tick covering 01:00 only delivers the critical reminder.
+	if err := dispatcher.Dispatch(context.Background(), startAt.AddDate(0, 0, 2).Add(-time.Minute), startAt.AddDate(0, 0, 2)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(sender.messages) != 1 || sender.messages[0].ReminderID != "critical" {
+		t.Fatalf("Expected only the critical reminder, got %+v", sender.messages)
+	}
+
+	// The tick covering the end of the window delivers the deferred one.
+	sender.messages = nil
+	if err := dispatcher.Dispatch(context.Background(), windowEnd.Add(-time.Minute), windowEnd); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(sender.messages) != 1 || sender.messages[0].ReminderID != "routine" {
+		t.Fatalf("Expected the deferred reminder, got %+v", sender.messages)
+	}
+	if want := startAt.AddDate(0, 0, 2); !sender.messages[0].OccurrenceAt.Equal(want) {
+		t.Errorf("Expected occurrence %v, got %v", want, sender.messages[0].OccurrenceAt)
+	}
+}
@@ -0,0 +1,20 @@
+package notify
+
+import (
+	"context"
+	"time"
+)
+
+// Message is a single reminder notification addressed to a user. Senders
+// decide how to reach the user (web push, mobile push, ...).
+type Message struct {
+	UserID       string
+	ReminderID   string
+	Title        string
+	Body         string
+	OccurrenceAt time.Time
+}
+
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
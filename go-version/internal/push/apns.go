@@ -0,0 +1,182 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	DefaultAPNsBaseURL = "https://api.push.apple.com"
+
+	// Apple rejects provider tokens older than an hour and throttles ones
+	// refreshed more often than every 20 minutes.
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+type APNsConfig struct {
+	BaseURL    string
+	Topic      string // the app's bundle id
+	KeyID      string
+	TeamID     string
+	PrivateKey *ecdsa.PrivateKey
+}
+
+// APNsProvider sends notifications through the APNs HTTP/2 provider API
+// using token based authentication.
+type APNsProvider struct {
+	config     APNsConfig
+	httpClient *http.Client
+	now        func() time.Time
+
+	mu          sync.Mutex
+	token       string
+	tokenIssued time.Time
+}
+
+func NewAPNsProvider(config APNsConfig, httpClient *http.Client) *APNsProvider {
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultAPNsBaseURL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &APNsProvider{
+		config:     config,
+		httpClient: httpClient,
+		now:        time.Now,
+	}
+}
+
+// ParseAPNsPrivateKey parses the PKCS#8 PEM encoded .p8 signing key
+// downloaded from the Apple developer portal.
+func ParseAPNsPrivateKey(pemBytes []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("apns private key is not PEM encoded")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	ecKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("apns private key is not an ECDSA key")
+	}
+	return ecKey, nil
+}
+
+func (p *APNsProvider) Platform() string {
+	return PlatformIOS
+}
+
+type apnsAlert struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type apnsAps struct {
+	Alert apnsAlert `json:"alert"`
+	Sound string    `json:"sound,omitempty"`
+}
+
+type apnsErrorResponse struct {
+	Reason string `json:"reason"`
+}
+
+func (p *APNsProvider) Send(ctx context.Context, token string, notification *Notification) error {
+	payload := map[string]interface{}{
+		"aps": apnsAps{
+			Alert: apnsAlert{Title: notification.Title, Body: notification.Body},
+			Sound: "default",
+		},
+	}
+	for key, value := range notification.Data {
+		if key != "aps" {
+			payload[key] = value
+		}
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	authToken, err := p.providerToken()
+	if err != nil {
+		return err
+	}
+
+	url := strings.TrimRight(p.config.BaseURL, "/") + "/3/device/" + token
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "bearer "+authToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("apns-topic", p.config.Topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var errResp apnsErrorResponse
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	json.Unmarshal(respBody, &errResp)
+
+	switch {
+	case resp.StatusCode == http.StatusGone,
+		errResp.Reason == "BadDeviceToken",
+		errResp.Reason == "Unregistered",
+		errResp.Reason == "DeviceTokenNotForTopic":
+		return &ErrInvalidToken{Platform: PlatformIOS, Reason: errResp.Reason}
+	}
+
+	return &ErrProviderResponse{Platform: PlatformIOS, StatusCode: resp.StatusCode, Reason: errResp.Reason}
+}
+
+// providerToken returns the cached ES256 provider token, signing a new one
+// once the cached token is close to expiring.
+func (p *APNsProvider) providerToken() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if p.token != "" && now.Sub(p.tokenIssued) < apnsTokenLifetime {
+		return p.token, nil
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": p.config.TeamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = p.config.KeyID
+
+	signed, err := token.SignedString(p.config.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	p.token = signed
+	p.tokenIssued = now
+	return signed, nil
+}
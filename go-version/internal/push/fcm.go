@@ -0,0 +1,134 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const DefaultFCMBaseURL = "https://fcm.googleapis.com"
+
+// TokenSource returns an OAuth 2.0 access token authorised for the
+// firebase.messaging scope.
+type TokenSource func(ctx context.Context) (string, error)
+
+// StaticTokenSource always returns the same access token.
+func StaticTokenSource(token string) TokenSource {
+	return func(ctx context.Context) (string, error) {
+		return token, nil
+	}
+}
+
+type FCMConfig struct {
+	BaseURL     string
+	ProjectID   string
+	TokenSource TokenSource
+}
+
+// FCMProvider sends notifications through the FCM HTTP v1 API.
+type FCMProvider struct {
+	config     FCMConfig
+	httpClient *http.Client
+}
+
+func NewFCMProvider(config FCMConfig, httpClient *http.Client) *FCMProvider {
+	if config.BaseURL == "" {
+		config.BaseURL = DefaultFCMBaseURL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &FCMProvider{config: config, httpClient: httpClient}
+}
+
+func (p *FCMProvider) Platform() string {
+	return PlatformAndroid
+}
+
+type fcmNotification struct {
+	Title string `json:"title,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+type fcmAndroidConfig struct {
+	Priority string `json:"priority"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Data         map[string]string `json:"data,omitempty"`
+	Android      fcmAndroidConfig  `json:"android"`
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			Type      string `json:"@type"`
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func (p *FCMProvider) Send(ctx context.Context, token string, notification *Notification) error {
+	body, err := json.Marshal(fcmRequest{
+		Message: fcmMessage{
+			Token:        token,
+			Notification: fcmNotification{Title: notification.Title, Body: notification.Body},
+			Data:         notification.Data,
+			Android:      fcmAndroidConfig{Priority: "high"},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	accessToken, err := p.config.TokenSource(ctx)
+	if err != nil {
+		return err
+	}
+
+	url := strings.TrimRight(p.config.BaseURL, "/") + "/v1/projects/" + p.config.ProjectID + "/messages:send"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var errResp fcmErrorResponse
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	json.Unmarshal(respBody, &errResp)
+
+	for _, detail := range errResp.Error.Details {
+		if detail.ErrorCode == "UNREGISTERED" {
+			return &ErrInvalidToken{Platform: PlatformAndroid, Reason: detail.ErrorCode}
+		}
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return &ErrInvalidToken{Platform: PlatformAndroid, Reason: errResp.Error.Status}
+	}
+
+	return &ErrProviderResponse{Platform: PlatformAndroid, StatusCode: resp.StatusCode, Reason: errResp.Error.Message}
+}
@@ -0,0 +1,49 @@
+package push
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	PlatformIOS     = "ios"
+	PlatformAndroid = "android"
+)
+
+// Notification is the platform independent content of a push message.
+type Notification struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// PushProvider delivers notifications to device tokens of one platform.
+type PushProvider interface {
+	Platform() string
+	Send(ctx context.Context, token string, notification *Notification) error
+}
+
+func IsValidPlatform(platform string) bool {
+	return platform == PlatformIOS || platform == PlatformAndroid
+}
+
+// ErrInvalidToken is returned when the provider reports that the device token
+// is no longer registered and should be discarded.
+type ErrInvalidToken struct {
+	Platform string
+	Reason   string
+}
+
+func (e *ErrInvalidToken) Error() string {
+	return fmt.Sprintf("%s device token is no longer valid: %s", e.Platform, e.Reason)
+}
+
+type ErrProviderResponse struct {
+	Platform   string
+	StatusCode int
+	Reason     string
+}
+
+func (e *ErrProviderResponse) Error() string {
+	return fmt.Sprintf("%s push provider responded with status %d: %s", e.Platform, e.StatusCode, e.Reason)
+}
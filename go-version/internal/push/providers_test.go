@@ -0,0 +1,223 @@
+package push
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestAPNsProvider(t *testing.T, baseURL string) (*APNsProvider, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	return NewAPNsProvider(APNsConfig{
+		BaseURL:    baseURL,
+		Topic:      "health.folia.app",
+		KeyID:      "KEY123",
+		TeamID:     "TEAM123",
+		PrivateKey: key,
+	}, nil), key
+}
+
+func TestAPNsProvider_Send(t *testing.T) {
+	var provider *APNsProvider
+	var key *ecdsa.PrivateKey
+
+	fakeAPNs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/3/device/device-token" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("apns-topic") != "health.folia.app" {
+			t.Errorf("apns-topic = %q", r.Header.Get("apns-topic"))
+		}
+		if r.Header.Get("apns-push-type") != "alert" {
+			t.Errorf("apns-push-type = %q", r.Header.Get("apns-push-type"))
+		}
+
+		token, err := jwt.Parse(strings.TrimPrefix(r.Header.Get("Authorization"), "bearer "), func(token *jwt.Token) (interface{}, error) {
+			if token.Header["kid"] != "KEY123" {
+				t.Errorf("kid = %v", token.Header["kid"])
+			}
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"ES256"}), jwt.WithIssuer("TEAM123"))
+		if err != nil || !token.Valid {
+			t.Errorf("invalid provider token: %v", err)
+		}
+
+		var payload map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&payload)
+		alert := payload["aps"].(map[string]interface{})["alert"].(map[string]interface{})
+		if alert["title"] != "Reminder" || alert["body"] != "Take medication" {
+			t.Errorf("unexpected alert %v", alert)
+		}
+		if payload["reminder_id"] != "reminder-1" {
+			t.Errorf("expected custom data in payload, got %v", payload)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer fakeAPNs.Close()
+
+	provider, key = newTestAPNsProvider(t, fakeAPNs.URL)
+
+	err := provider.Send(context.Background(), "device-token", &Notification{
+		Title: "Reminder",
+		Body:  "Take medication",
+		Data:  map[string]string{"reminder_id": "reminder-1"},
+	})
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+}
+
+func TestAPNsProvider_ProviderTokenIsCached(t *testing.T) {
+	provider, _ := newTestAPNsProvider(t, "http://localhost")
+
+	now := time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC)
+	provider.now = func() time.Time { return now }
+
+	first, _ := provider.providerToken()
+	now = now.Add(10 * time.Minute)
+	second, _ := provider.providerToken()
+	if first != second {
+		t.Errorf("expected provider token to be reused within its lifetime")
+	}
+
+	now = now.Add(apnsTokenLifetime)
+	third, _ := provider.providerToken()
+	if third == first {
+		t.Errorf("expected provider token to be refreshed after its lifetime")
+	}
+}
+
+func TestAPNsProvider_SendErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		status        int
+		reason        string
+		expectInvalid bool
+	}{
+		{"unregistered", http.StatusGone, "Unregistered", true},
+		{"bad device token", http.StatusBadRequest, "BadDeviceToken", true},
+		{"too many requests", http.StatusTooManyRequests, "TooManyRequests", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeAPNs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				json.NewEncoder(w).Encode(map[string]string{"reason": tc.reason})
+			}))
+			defer fakeAPNs.Close()
+
+			provider, _ := newTestAPNsProvider(t, fakeAPNs.URL)
+			err := provider.Send(context.Background(), "device-token", &Notification{Title: "Reminder"})
+
+			var invalidErr *ErrInvalidToken
+			if errors.As(err, &invalidErr) != tc.expectInvalid {
+				t.Errorf("unexpected error %v", err)
+			}
+			if err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestFCMProvider_Send(t *testing.T) {
+	fakeFCM := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/folia-test/messages:send" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer access-token" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+
+		var req fcmRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.Message.Token != "device-token" || req.Message.Notification.Title != "Reminder" {
+			t.Errorf("unexpected message %+v", req.Message)
+		}
+		if req.Message.Data["reminder_id"] != "reminder-1" {
+			t.Errorf("expected data to be forwarded, got %v", req.Message.Data)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"projects/folia-test/messages/1"}`))
+	}))
+	defer fakeFCM.Close()
+
+	provider := NewFCMProvider(FCMConfig{
+		BaseURL:     fakeFCM.URL,
+		ProjectID:   "folia-test",
+		TokenSource: StaticTokenSource("access-token"),
+	}, nil)
+
+	err := provider.Send(context.Background(), "device-token", &Notification{
+		Title: "Reminder",
+		Body:  "Take medication",
+		Data:  map[string]string{"reminder_id": "reminder-1"},
+	})
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+}
+
+func TestFCMProvider_SendErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		status        int
+		body          string
+		expectInvalid bool
+	}{
+		{
+			name:          "unregistered",
+			status:        http.StatusNotFound,
+			body:          `{"error":{"code":404,"status":"NOT_FOUND","details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`,
+			expectInvalid: true,
+		},
+		{
+			name:          "quota exceeded",
+			status:        http.StatusTooManyRequests,
+			body:          `{"error":{"code":429,"message":"quota exceeded","status":"RESOURCE_EXHAUSTED"}}`,
+			expectInvalid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeFCM := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			}))
+			defer fakeFCM.Close()
+
+			provider := NewFCMProvider(FCMConfig{
+				BaseURL:     fakeFCM.URL,
+				ProjectID:   "folia-test",
+				TokenSource: StaticTokenSource("access-token"),
+			}, nil)
+			err := provider.Send(context.Background(), "device-token", &Notification{Title: "Reminder"})
+
+			var invalidErr *ErrInvalidToken
+			if errors.As(err, &invalidErr) != tc.expectInvalid {
+				t.Errorf("unexpected error %v", err)
+			}
+			if err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,446 @@
+// Package recurrence checks and expands reminder recurrence rules in time
+// bounded by configurable limits rather than by the rule.
+//
+// rrule-go finds the next occurrence by scanning every period up to year
+// 9999, so a rule that rarely or never matches takes as long as the scan.
+// The Gregorian calendar repeats exactly every 400 years, so rules are
+// expanded on a copy moved forward by whole cycles, close enough to 9999
+// that the scan stays short, and the occurrences are moved back.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+const (
+	// cycleYears is the length of the Gregorian calendar cycle.
+	cycleYears = 400
+
+	// MaxSpanYears is how long a series that ends may run for.
+	MaxSpanYears = 400
+)
+
+// Limits caps the rules Check accepts. The zero value applies
+// DefaultLimits.
+type Limits struct {
+	// MaxFrequency is the most frequent FREQ accepted.
+	MaxFrequency rrule.Frequency
+	// MaxOccurrences caps the occurrences of a series that ends.
+	MaxOccurrences int
+	// Horizon is how soon after its start a series must first occur.
+	Horizon time.Duration
+}
+
+func DefaultLimits() Limits {
+	return Limits{
+		MaxFrequency:   rrule.MINUTELY,
+		MaxOccurrences: 10000,
+		Horizon:        5 * 366 * 24 * time.Hour,
+	}
+}
+
+// OrDefault returns the limits, or DefaultLimits for the zero value.
+func (l Limits) OrDefault() Limits {
+	if l == (Limits{}) {
+		return DefaultLimits()
+	}
+	return l
+}
+
+// Check returns why the rule cannot be used for a series starting at start,
+// or nil when it can.
+func Check(rruleStr string, start time.Time, limits Limits) error {
+	limits = limits.OrDefault()
+
+	opt, err := rrule.StrToROption(rruleStr)
+	if err != nil {
+		return err
+	}
+	if opt.Freq > limits.MaxFrequency {
+		return fmt.Errorf("FREQ=%s is more frequent than %s, the most frequent allowed", opt.Freq, limits.MaxFrequency)
+	}
+	if opt.Count > limits.MaxOccurrences {
+		return fmt.Errorf("COUNT=%d is more than the %d occurrences allowed", opt.Count, limits.MaxOccurrences)
+	}
+	if err := checkWeekdayOrdinals(opt); err != nil {
+		return err
+	}
+	if err := checkTimesOfDayReachable(opt, start); err != nil {
+		return err
+	}
+
+	s, err := newSeries(*opt, start)
+	if err != nil {
+		return err
+	}
+
+	first, ok := s.next()
+	if !ok || first.After(start.Add(limits.Horizon)) {
+		return fmt.Errorf("no occurrence within %d days of the start", int(limits.Horizon.Hours()/24))
+	}
+	if !s.ends() {
+		return nil
+	}
+
+	last, occurrences := first, 1
+	for occurrence, ok := s.next(); ok; occurrence, ok = s.next() {
+		last = occurrence
+		occurrences++
+		if occurrences > limits.MaxOccurrences {
+			return fmt.Errorf("the rule has more than the %d occurrences allowed", limits.MaxOccurrences)
+		}
+	}
+	if s.truncated(occurrences) || last.After(start.AddDate(MaxSpanYears, 0, 0)) {
+		return fmt.Errorf("the rule runs for more than %d years", MaxSpanYears)
+	}
+	return nil
+}
+
+// Bounds returns the first and last occurrences of the series starting at
+// start. last is nil when the series never ends, or runs on for longer than
+// can be expanded, and both are nil when it has no occurrences.
+func Bounds(rruleStr string, start time.Time) (first, last *time.Time, err error) {
+	s, err := parseSeries(rruleStr, start)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	firstOccurrence, ok := s.next()
+	if !ok {
+		return nil, nil, nil
+	}
+	if !s.ends() {
+		return &firstOccurrence, nil, nil
+	}
+
+	lastOccurrence, occurrences := firstOccurrence, 1
+	for occurrence, ok := s.next(); ok; occurrence, ok = s.next() {
+		lastOccurrence = occurrence
+		occurrences++
+	}
+	if s.truncated(occurrences) {
+		return &firstOccurrence, nil, nil
+	}
+	return &firstOccurrence, &lastOccurrence, nil
+}
+
+// After returns the first occurrence of the series starting at start that
+// is at or after t, or nil when there is none.
+func After(rruleStr string, start, t time.Time) (*time.Time, error) {
+	next, err := From(rruleStr, start, t)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence, ok := next()
+	if !ok {
+		return nil, nil
+	}
+	return &occurrence, nil
+}
+
+// Before returns the last occurrence of the series starting at start that
+// is before t, or nil when there is none. It looks back from t over twice
+// as long each time until it finds one, rather than expanding the series
+// from its start.
+func Before(rruleStr string, start, t time.Time) (*time.Time, error) {
+	opt, err := parseOption(rruleStr, start)
+	if err != nil {
+		return nil, err
+	}
+
+	for days := 1; ; days *= 2 {
+		s, moved, err := seek(*opt, start, t.AddDate(0, 0, -days))
+		if err != nil {
+			return nil, err
+		}
+		var last *time.Time
+		for occurrence, ok := s.next(); ok && occurrence.Before(t); occurrence, ok = s.next() {
+			last = &occurrence
+		}
+		if last != nil || !moved {
+			return last, nil
+		}
+	}
+}
+
+// Next returns the next occurrence of a series, reporting false once there
+// are no more.
+type Next func() (time.Time, bool)
+
+// From returns the occurrences of the series starting at start that are at
+// or after t, earliest first. Series without a COUNT are expanded from the
+// period holding t, so that reaching t takes as long however many
+// occurrences come before it.
+func From(rruleStr string, start, t time.Time) (Next, error) {
+	opt, err := parseOption(rruleStr, start)
+	if err != nil {
+		return nil, err
+	}
+	s, _, err := seek(*opt, start, t)
+	if err != nil {
+		return nil, err
+	}
+
+	first, ok := s.next()
+	for ok && first.Before(t) {
+		first, ok = s.next()
+	}
+	return func() (time.Time, bool) {
+		if !ok {
+			return time.Time{}, false
+		}
+		occurrence := first
+		first, ok = s.next()
+		return occurrence, true
+	}, nil
+}
+
+// seek returns the series starting at start, moved forward by whole
+// intervals to the start of the period holding t when that is later, so
+// that it yields the same occurrences from that period on. The BY rules
+// the start implies are spelled out, as they no longer follow from the new
+// start. Series with a COUNT are not moved, since the occurrences moved
+// past would need counting, nor are those whose new start falls in a
+// daylight saving gap. moved reports whether the series was moved.
+func seek(opt rrule.ROption, start, t time.Time) (s *series, moved bool, err error) {
+	if opt.Count > 0 {
+		s, err = newSeries(opt, start)
+		return s, false, err
+	}
+	periodStart, ok := periodStart(&opt, start, t)
+	if !ok {
+		s, err = newSeries(opt, start)
+		return s, false, err
+	}
+
+	if len(opt.Byweekno) == 0 && len(opt.Byyearday) == 0 && len(opt.Bymonthday) == 0 && len(opt.Byweekday) == 0 && len(opt.Byeaster) == 0 {
+		switch opt.Freq {
+		case rrule.YEARLY:
+			if len(opt.Bymonth) == 0 {
+				opt.Bymonth = []int{int(start.Month())}
+			}
+			opt.Bymonthday = []int{start.Day()}
+		case rrule.MONTHLY:
+			opt.Bymonthday = []int{start.Day()}
+		case rrule.WEEKLY:
+			opt.Byweekday = []rrule.Weekday{weekdays[start.Weekday()]}
+		}
+	}
+	if len(opt.Byhour) == 0 && opt.Freq < rrule.HOURLY {
+		opt.Byhour = []int{start.Hour()}
+	}
+	if len(opt.Byminute) == 0 && opt.Freq < rrule.MINUTELY {
+		opt.Byminute = []int{start.Minute()}
+	}
+	if len(opt.Bysecond) == 0 && opt.Freq < rrule.SECONDLY {
+		opt.Bysecond = []int{start.Second()}
+	}
+
+	s, err = newSeries(opt, periodStart)
+	return s, true, err
+}
+
+// periodStart returns the start of the period of the series holding t, a
+// whole number of intervals after the period holding start, or false when
+// that is not after start. Periods are counted in wall clock time in
+// start's location, as rrule-go steps through them.
+func periodStart(opt *rrule.ROption, start, t time.Time) (time.Time, bool) {
+	interval := max(opt.Interval, 1)
+	from, to := wallClock(start), wallClock(t.In(start.Location()))
+	if !to.After(from) {
+		return time.Time{}, false
+	}
+
+	const secondsPerDay = 24 * 60 * 60
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	var period time.Time
+	switch opt.Freq {
+	case rrule.YEARLY:
+		years := (to.Year() - from.Year()) / interval * interval
+		period = time.Date(from.Year()+years, time.January, 1, 0, 0, 0, 0, time.UTC)
+	case rrule.MONTHLY:
+		months := (12*(to.Year()-from.Year()) + int(to.Month()-from.Month())) / interval * interval
+		period = time.Date(from.Year(), from.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	case rrule.WEEKLY:
+		// Weeks begin on WKST, Monday unless the rule says otherwise.
+		week := day.AddDate(0, 0, -((int(from.Weekday())+6)%7-opt.Wkst.Day()+7)%7)
+		weeks := int(to.Unix()-week.Unix()) / (7 * secondsPerDay) / interval * interval
+		period = week.AddDate(0, 0, 7*weeks)
+	case rrule.DAILY:
+		days := int(to.Unix()-day.Unix()) / secondsPerDay / interval * interval
+		period = day.AddDate(0, 0, days)
+	default:
+		unit := map[rrule.Frequency]int{rrule.HOURLY: 60 * 60, rrule.MINUTELY: 60, rrule.SECONDLY: 1}[opt.Freq]
+		steps := int(to.Unix()-from.Unix()) / (unit * interval) * interval
+		period = time.Unix(from.Unix()+int64(steps*unit), 0).UTC()
+	}
+	if !period.After(from) {
+		return time.Time{}, false
+	}
+
+	moved := time.Date(period.Year(), period.Month(), period.Day(), period.Hour(), period.Minute(), period.Second(), 0, start.Location())
+	if !wallClock(moved).Equal(period) {
+		return time.Time{}, false
+	}
+	return moved, true
+}
+
+// wallClock returns t's date and time of day, to the second, as a UTC time
+// so that they can be counted without daylight saving changes.
+func wallClock(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// weekdays are the rrule-go weekdays of each time.Weekday.
+var weekdays = []rrule.Weekday{rrule.SU, rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR, rrule.SA}
+
+// series iterates a rule moved forward by shift years.
+type series struct {
+	opt      rrule.ROption
+	rule     *rrule.RRule
+	iterator rrule.Next
+	shift    int
+}
+
+// parseSeries parses a rule that Check may not have seen, rejecting those
+// rrule-go cannot expand safely.
+func parseSeries(rruleStr string, start time.Time) (*series, error) {
+	opt, err := parseOption(rruleStr, start)
+	if err != nil {
+		return nil, err
+	}
+	return newSeries(*opt, start)
+}
+
+// parseOption parses a rule as parseSeries does, without expanding it.
+func parseOption(rruleStr string, start time.Time) (*rrule.ROption, error) {
+	opt, err := rrule.StrToROption(rruleStr)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkWeekdayOrdinals(opt); err != nil {
+		return nil, err
+	}
+	if err := checkTimesOfDayReachable(opt, start); err != nil {
+		return nil, err
+	}
+	return opt, nil
+}
+
+func newSeries(opt rrule.ROption, start time.Time) (*series, error) {
+	shift := 0
+	if latest := rrule.MAXYEAR - MaxSpanYears; start.Year() < latest {
+		shift = (latest - start.Year()) / cycleYears * cycleYears
+	}
+
+	opt.Dtstart = start.AddDate(shift, 0, 0)
+	if !opt.Until.IsZero() {
+		opt.Until = opt.Until.AddDate(shift, 0, 0)
+	}
+	rule, err := rrule.NewRRule(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &series{opt: opt, rule: rule, iterator: rule.Iterator(), shift: shift}, nil
+}
+
+func (s *series) next() (time.Time, bool) {
+	occurrence, ok := s.iterator()
+	if !ok {
+		return time.Time{}, false
+	}
+	return occurrence.AddDate(-s.shift, 0, 0), true
+}
+
+// ends reports whether the rule has a COUNT or an UNTIL.
+func (s *series) ends() bool {
+	return s.opt.Count > 0 || !s.opt.Until.IsZero()
+}
+
+// truncated reports whether the series stopped at year 9999 rather than at
+// its COUNT or UNTIL, having produced occurrences.
+func (s *series) truncated(occurrences int) bool {
+	if s.opt.Count > 0 && occurrences >= s.opt.Count {
+		return false
+	}
+	return s.opt.Until.IsZero() || s.opt.Until.Year() > rrule.MAXYEAR
+}
+
+// checkWeekdayOrdinals rejects BYDAY ordinals past the fifth weekday of a
+// month, such as 11FR, which RFC 5545 disallows and rrule-go panics on.
+func checkWeekdayOrdinals(opt *rrule.ROption) error {
+	if opt.Freq != rrule.MONTHLY && !(opt.Freq == rrule.YEARLY && len(opt.Bymonth) > 0) {
+		return nil
+	}
+	for _, weekday := range opt.Byweekday {
+		if n := weekday.N(); n < -5 || n > 5 {
+			return fmt.Errorf("BYDAY=%s is past the fifth weekday of a month", weekday)
+		}
+	}
+	return nil
+}
+
+// checkTimesOfDayReachable rejects sub-daily rules whose BYHOUR, BYMINUTE
+// or BYSECOND times of day are never reached stepping by INTERVAL from the
+// start, such as FREQ=HOURLY;INTERVAL=2;BYHOUR=3 from an even hour, for
+// which rrule-go searches forever.
+func checkTimesOfDayReachable(opt *rrule.ROption, start time.Time) error {
+	var unit int
+	var hours, minutes, seconds []int
+	switch opt.Freq {
+	case rrule.HOURLY:
+		unit = 3600
+		hours, minutes, seconds = orAll(opt.Byhour, 24), []int{0}, []int{0}
+	case rrule.MINUTELY:
+		unit = 60
+		hours, minutes, seconds = orAll(opt.Byhour, 24), orAll(opt.Byminute, 60), []int{0}
+	case rrule.SECONDLY:
+		unit = 1
+		hours, minutes, seconds = orAll(opt.Byhour, 24), orAll(opt.Byminute, 60), orAll(opt.Bysecond, 60)
+	default:
+		return nil
+	}
+
+	// Stepping by INTERVAL units through a day of steps units reaches the
+	// times whose distance from the start is a multiple of their gcd.
+	steps := 24 * 3600 / unit
+	step := gcd(max(opt.Interval, 1), steps)
+	hour, minute, second := start.Clock()
+	from := (hour*3600 + minute*60 + second) / unit
+
+	for _, h := range hours {
+		for _, m := range minutes {
+			for _, s := range seconds {
+				if ((h*3600+m*60+s)/unit-from)%step == 0 {
+					return nil
+				}
+			}
+		}
+	}
+	return errors.New("the BYHOUR, BYMINUTE and BYSECOND times are never reached at this INTERVAL")
+}
+
+// orAll returns values, or every value below n when there are none.
+func orAll(values []int, n int) []int {
+	if len(values) > 0 {
+		return values
+	}
+	all := make([]int, n)
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,525 @@
+package recurrence
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/teambition/rrule-go"
+)
+
+var start = time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+
+// adversarialRules are accepted by the parser but, expanded naively, take
+// seconds, forever, or more memory than the server has.
+var adversarialRules = []string{
+	"FREQ=HOURLY;INTERVAL=2;BYHOUR=3;COUNT=1",
+	"FREQ=MINUTELY;INTERVAL=2;BYHOUR=1;BYMINUTE=1;COUNT=1",
+	"FREQ=SECONDLY;INTERVAL=7;BYHOUR=1;BYMINUTE=1;BYSECOND=3;BYMONTH=2;BYMONTHDAY=30;COUNT=1",
+	"FREQ=SECONDLY;COUNT=2000000000",
+	"FREQ=SECONDLY;UNTIL=99991231T000000Z",
+	"FREQ=MINUTELY;UNTIL=99991231T000000Z",
+	"FREQ=HOURLY;BYMONTH=2;BYMONTHDAY=30;COUNT=1",
+	"FREQ=MINUTELY;BYMONTH=2;BYMONTHDAY=30",
+	"FREQ=DAILY;BYMONTH=4;BYMONTHDAY=31;COUNT=5",
+	"FREQ=HOURLY;BYMONTH=2;BYMONTHDAY=29;COUNT=10000",
+	"FREQ=YEARLY;COUNT=5000",
+	"FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;BYDAY=MO;COUNT=500",
+}
+
+func TestCheck(t *testing.T) {
+	testCases := []struct {
+		name          string
+		rrule         string
+		limits        Limits
+		expectedError string
+	}{
+		{name: "count", rrule: "FREQ=DAILY;COUNT=5"},
+		{name: "until", rrule: "FREQ=WEEKLY;BYDAY=MO,WE,FR;UNTIL=20240301T000000Z"},
+		{name: "never ends", rrule: "FREQ=HOURLY;INTERVAL=8"},
+		{name: "minutely", rrule: "FREQ=MINUTELY;INTERVAL=30;COUNT=48"},
+		{name: "leap day", rrule: "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;COUNT=3"},
+		{name: "reachable hours", rrule: "FREQ=HOURLY;INTERVAL=2;BYHOUR=8,20;COUNT=4"},
+		{
+			name:          "no occurrences",
+			rrule:         "FREQ=DAILY;UNTIL=20200101T000000Z",
+			expectedError: "no occurrence within",
+		},
+		{
+			name:          "first occurrence beyond the horizon",
+			rrule:         "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;COUNT=1",
+			limits:        Limits{MaxFrequency: rrule.MINUTELY, MaxOccurrences: 10, Horizon: 24 * time.Hour},
+			expectedError: "no occurrence within 1 days",
+		},
+		{
+			name:          "impossible date",
+			rrule:         "FREQ=HOURLY;BYMONTH=2;BYMONTHDAY=30;COUNT=1",
+			expectedError: "no occurrence within",
+		},
+		{
+			name:          "secondly",
+			rrule:         "FREQ=SECONDLY;COUNT=10",
+			expectedError: "FREQ=SECONDLY is more frequent than MINUTELY",
+		},
+		{
+			name:          "hourly when only daily is allowed",
+			rrule:         "FREQ=HOURLY;COUNT=10",
+			limits:        Limits{MaxFrequency: rrule.DAILY, MaxOccurrences: 10, Horizon: 24 * time.Hour},
+			expectedError: "FREQ=HOURLY is more frequent than DAILY",
+		},
+		{
+			name:          "count over the cap",
+			rrule:         "FREQ=DAILY;COUNT=10001",
+			expectedError: "COUNT=10001 is more than the 10000 occurrences allowed",
+		},
+		{
+			name:          "until expanding past the cap",
+			rrule:         "FREQ=MINUTELY;UNTIL=20250101T000000Z",
+			expectedError: "more than the 10000 occurrences allowed",
+		},
+		{
+			name:          "unreachable hours",
+			rrule:         "FREQ=HOURLY;INTERVAL=2;BYHOUR=3;COUNT=1",
+			expectedError: "never reached",
+		},
+		{
+			name:          "unreachable minutes",
+			rrule:         "FREQ=MINUTELY;INTERVAL=10;BYMINUTE=5,15;COUNT=1",
+			expectedError: "never reached",
+		},
+		{
+			name:          "weekday past the fifth of the month",
+			rrule:         "FREQ=MONTHLY;BYDAY=11FR;BYSETPOS=1;COUNT=1",
+			expectedError: "BYDAY=+11FR is past the fifth weekday of a month",
+		},
+		{
+			name:          "runs for centuries",
+			rrule:         "FREQ=YEARLY;COUNT=500",
+			expectedError: "runs for more than 400 years",
+		},
+		{
+			name:          "not a rule",
+			rrule:         "FREQ=FORTNIGHTLY",
+			expectedError: "undefined frequency",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Check(tc.rrule, start, tc.limits)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Errorf("Expected an error containing %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestBounds(t *testing.T) {
+	testCases := []struct {
+		name          string
+		rrule         string
+		start         time.Time
+		expectedFirst *time.Time
+		expectedLast  *time.Time
+	}{
+		{
+			name:          "count",
+			rrule:         "FREQ=DAILY;COUNT=10",
+			start:         start,
+			expectedFirst: ptr(start),
+			expectedLast:  ptr(time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "leap days",
+			rrule:         "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;COUNT=3",
+			start:         start,
+			expectedFirst: ptr(time.Date(2024, 2, 29, 8, 0, 0, 0, time.UTC)),
+			expectedLast:  ptr(time.Date(2032, 2, 29, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "weekdays keep their dates",
+			rrule:         "FREQ=MONTHLY;BYDAY=-1FR;COUNT=2",
+			start:         start,
+			expectedFirst: ptr(time.Date(2024, 1, 26, 8, 0, 0, 0, time.UTC)),
+			expectedLast:  ptr(time.Date(2024, 2, 23, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "until in an offset zone",
+			rrule:         "FREQ=DAILY;UNTIL=20240103T070000Z",
+			start:         time.Date(2024, 1, 1, 8, 0, 0, 0, time.FixedZone("CET", 3600)),
+			expectedFirst: ptr(time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)),
+			expectedLast:  ptr(time.Date(2024, 1, 3, 7, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:          "never ends",
+			rrule:         "FREQ=HOURLY",
+			start:         start,
+			expectedFirst: ptr(start),
+		},
+		{
+			name:          "runs past year 9999",
+			rrule:         "FREQ=YEARLY;COUNT=9000",
+			start:         start,
+			expectedFirst: ptr(start),
+		},
+		{
+			name:  "no occurrences",
+			rrule: "FREQ=DAILY;UNTIL=20231231T000000Z",
+			start: start,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			first, last, err := Bounds(tc.rrule, tc.start)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !equalTimes(first, tc.expectedFirst) {
+				t.Errorf("Expected first occurrence %v, got %v", tc.expectedFirst, first)
+			}
+			if !equalTimes(last, tc.expectedLast) {
+				t.Errorf("Expected last occurrence %v, got %v", tc.expectedLast, last)
+			}
+		})
+	}
+}
+
+func TestAfter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rrule    string
+		after    time.Time
+		expected *time.Time
+	}{
+		{
+			name:     "before the start",
+			rrule:    "FREQ=DAILY;COUNT=3",
+			after:    start.AddDate(0, 0, -1),
+			expected: ptr(start),
+		},
+		{
+			name:     "on an occurrence",
+			rrule:    "FREQ=DAILY;COUNT=3",
+			after:    start.AddDate(0, 0, 1),
+			expected: ptr(start.AddDate(0, 0, 1)),
+		},
+		{
+			name:     "between occurrences",
+			rrule:    "FREQ=WEEKLY",
+			after:    time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			expected: ptr(time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "leap days",
+			rrule:    "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29",
+			after:    time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			expected: ptr(time.Date(2028, 2, 29, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:  "after the last",
+			rrule: "FREQ=DAILY;COUNT=3",
+			after: start.AddDate(0, 0, 3),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			occurrence, err := After(tc.rrule, start, tc.after)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !equalTimes(occurrence, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, occurrence)
+			}
+		})
+	}
+}
+
+func TestBefore(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rrule    string
+		before   time.Time
+		expected *time.Time
+	}{
+		{
+			name:   "before the start",
+			rrule:  "FREQ=DAILY;COUNT=3",
+			before: start,
+		},
+		{
+			name:     "on an occurrence",
+			rrule:    "FREQ=DAILY;COUNT=3",
+			before:   start.AddDate(0, 0, 1),
+			expected: ptr(start),
+		},
+		{
+			name:     "between occurrences",
+			rrule:    "FREQ=WEEKLY",
+			before:   time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			expected: ptr(time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "after the last",
+			rrule:    "FREQ=DAILY;COUNT=3",
+			before:   start.AddDate(1, 0, 0),
+			expected: ptr(start.AddDate(0, 0, 2)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			occurrence, err := Before(tc.rrule, start, tc.before)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !equalTimes(occurrence, tc.expected) {
+				t.Errorf("Expected %v, got %v", tc.expected, occurrence)
+			}
+		})
+	}
+}
+
+func TestFrom(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rrule    string
+		from     time.Time
+		take     int
+		expected []time.Time
+	}{
+		{
+			name:     "before the start",
+			rrule:    "FREQ=DAILY;COUNT=3",
+			from:     start.AddDate(0, 0, -1),
+			take:     5,
+			expected: []time.Time{start, start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)},
+		},
+		{
+			name:     "on an occurrence",
+			rrule:    "FREQ=DAILY;COUNT=3",
+			from:     start.AddDate(0, 0, 1),
+			take:     5,
+			expected: []time.Time{start.AddDate(0, 0, 1), start.AddDate(0, 0, 2)},
+		},
+		{
+			name:     "never ending",
+			rrule:    "FREQ=WEEKLY",
+			from:     time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC),
+			take:     2,
+			expected: []time.Time{time.Date(2026, 10, 26, 8, 0, 0, 0, time.UTC), time.Date(2026, 11, 2, 8, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:  "after the last",
+			rrule: "FREQ=DAILY;COUNT=3",
+			from:  start.AddDate(0, 0, 3),
+			take:  5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next, err := From(tc.rrule, start, tc.from)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var occurrences []time.Time
+			for occurrence, ok := next(); ok && len(occurrences) < tc.take; occurrence, ok = next() {
+				occurrences = append(occurrences, occurrence)
+			}
+			if !slices.EqualFunc(occurrences, tc.expected, time.Time.Equal) {
+				t.Errorf("Expected %v, got %v", tc.expected, occurrences)
+			}
+		})
+	}
+}
+
+// TestBounds_MatchesRRule checks that expanding a rule moved by whole
+// calendar cycles gives the occurrences rrule-go gives in place.
+func TestBounds_MatchesRRule(t *testing.T) {
+	rules := []string{
+		"FREQ=DAILY;BYHOUR=8,20;COUNT=60",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH;COUNT=30",
+		"FREQ=MONTHLY;BYMONTHDAY=31;COUNT=12",
+		"FREQ=MONTHLY;BYDAY=2MO;BYSETPOS=1;COUNT=20",
+		"FREQ=YEARLY;BYWEEKNO=53;BYDAY=TH;COUNT=3",
+		"FREQ=YEARLY;BYYEARDAY=366;COUNT=3",
+		"FREQ=HOURLY;INTERVAL=5;UNTIL=20240110T000000Z",
+	}
+
+	for _, rule := range rules {
+		t.Run(rule, func(t *testing.T) {
+			r, err := rrule.StrToRRule(rule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.DTStart(start)
+			all := r.All()
+
+			first, last, err := Bounds(rule, start)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if first == nil || last == nil || !first.Equal(all[0]) || !last.Equal(all[len(all)-1]) {
+				t.Errorf("Expected %v to %v, got %v to %v", all[0], all[len(all)-1], first, last)
+			}
+		})
+	}
+}
+
+// TestFrom_MatchesRRule checks that a series moved forward to the period
+// holding t gives the occurrences around t that rrule-go gives expanding it
+// from its start.
+func TestFrom_MatchesRRule(t *testing.T) {
+	amsterdam, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		t.Fatal(err)
+	}
+	starts := []time.Time{start, time.Date(2023, 3, 25, 2, 30, 0, 0, amsterdam), time.Date(2024, 1, 31, 23, 45, 10, 0, amsterdam)}
+	rules := []string{
+		"FREQ=DAILY",
+		"FREQ=DAILY;INTERVAL=3;BYHOUR=1,8,20",
+		"FREQ=WEEKLY;INTERVAL=2",
+		"FREQ=WEEKLY;INTERVAL=3;BYDAY=SU,WE;WKST=SU",
+		"FREQ=MONTHLY",
+		"FREQ=MONTHLY;INTERVAL=5;BYDAY=-1FR",
+		"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1",
+		"FREQ=YEARLY;BYMONTH=2,7",
+		"FREQ=YEARLY;INTERVAL=4;BYWEEKNO=1;BYDAY=MO",
+		"FREQ=HOURLY;INTERVAL=7",
+		"FREQ=MINUTELY;INTERVAL=45;BYHOUR=9,10",
+		"FREQ=DAILY;UNTIL=20300101T000000Z",
+	}
+	after := []time.Duration{0, 90 * time.Minute, 40 * 24 * time.Hour, 3 * 365 * 24 * time.Hour, 2000*24*time.Hour + 17*time.Minute}
+
+	for _, start := range starts {
+		for _, rule := range rules {
+			t.Run(start.String()+"/"+rule, func(t *testing.T) {
+				r, err := rrule.StrToRRule(rule)
+				if err != nil {
+					t.Fatal(err)
+				}
+				r.DTStart(start)
+
+				for _, d := range after {
+					at := start.Add(d)
+					wantNext, wantPrevious := r.After(at, true), r.Before(at, false)
+
+					next, err := From(rule, start, at)
+					if err != nil {
+						t.Fatalf("Unexpected error: %v", err)
+					}
+					if got, ok := next(); ok != !wantNext.IsZero() || ok && !got.Equal(wantNext) {
+						t.Errorf("From %v: expected %v, got %v", at, wantNext, got)
+					}
+					if got, _ := After(rule, start, at); !equalTimes(got, timeOrNil(wantNext)) {
+						t.Errorf("After %v: expected %v, got %v", at, wantNext, got)
+					}
+					if got, _ := Before(rule, start, at); !equalTimes(got, timeOrNil(wantPrevious)) {
+						t.Errorf("Before %v: expected %v, got %v", at, wantPrevious, got)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestCheck_Adversarial checks that rules which are slow or never finish
+// expanding are rejected quickly.
+func TestCheck_Adversarial(t *testing.T) {
+	for _, rule := range adversarialRules {
+		t.Run(rule, func(t *testing.T) {
+			checkWithin(t, rule, start, 2*time.Second)
+		})
+	}
+}
+
+// FuzzCheck checks that no rule takes long to check, whatever it is.
+func FuzzCheck(f *testing.F) {
+	for _, rule := range adversarialRules {
+		f.Add(rule, int64(0))
+	}
+	f.Add("FREQ=DAILY;COUNT=5", int64(86399))
+	f.Add("FREQ=MONTHLY;BYDAY=-1FR;BYSETPOS=-1;UNTIL=20300101T000000Z", int64(-1e9))
+
+	f.Fuzz(func(t *testing.T, rule string, offset int64) {
+		checkWithin(t, rule, start.Add(time.Duration(offset)*time.Second), 2*time.Second)
+	})
+}
+
+func checkWithin(t *testing.T, rule string, start time.Time, limit time.Duration) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Check(rule, start, Limits{})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(limit):
+		t.Fatalf("Check(%q, %v) took longer than %v", rule, start, limit)
+	}
+}
+
+func BenchmarkCheck(b *testing.B) {
+	rules := append([]string{
+		"FREQ=DAILY;BYHOUR=8,20;COUNT=60",
+		"FREQ=MINUTELY;INTERVAL=30;COUNT=10000",
+	}, adversarialRules...)
+
+	for _, rule := range rules {
+		b.Run(rule, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				Check(rule, start, Limits{})
+			}
+		})
+	}
+}
+
+// BenchmarkFrom finds the next occurrence of series that started long
+// before, which takes as long however many occurrences came before.
+func BenchmarkFrom(b *testing.B) {
+	rules := []string{"FREQ=DAILY;BYHOUR=8,20", "FREQ=MINUTELY;INTERVAL=15"}
+
+	for _, rule := range rules {
+		for _, years := range []int{1, 10, 100} {
+			b.Run(fmt.Sprintf("%s/years=%d", rule, years), func(b *testing.B) {
+				at := start.AddDate(years, 0, 0)
+				for i := 0; i < b.N; i++ {
+					next, err := From(rule, start, at)
+					if err != nil {
+						b.Fatal(err)
+					}
+					next()
+				}
+			})
+		}
+	}
+}
+
+func ptr(t time.Time) *time.Time { return &t }
+
+func timeOrNil(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
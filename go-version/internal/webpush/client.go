@@ -0,0 +1,114 @@
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultTTL = 24 * time.Hour
+
+	// vapidTokenLifetime must not exceed 24 hours per RFC 8292.
+	vapidTokenLifetime = 12 * time.Hour
+)
+
+type Urgency string
+
+const (
+	UrgencyVeryLow Urgency = "very-low"
+	UrgencyLow     Urgency = "low"
+	UrgencyNormal  Urgency = "normal"
+	UrgencyHigh    Urgency = "high"
+)
+
+type Options struct {
+	TTL     time.Duration
+	Urgency Urgency
+	Topic   string
+}
+
+// Client delivers encrypted messages to push services.
+type Client struct {
+	httpClient *http.Client
+	keys       *VAPIDKeys
+	subject    string
+	now        func() time.Time
+}
+
+// NewClient creates a push client. subject is the contact URI (mailto: or
+// https:) included in the VAPID token so push services can reach the sender.
+func NewClient(keys *VAPIDKeys, subject string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &Client{
+		httpClient: httpClient,
+		keys:       keys,
+		subject:    subject,
+		now:        time.Now,
+	}
+}
+
+func (c *Client) PublicKey() string {
+	return c.keys.PublicKey()
+}
+
+// Send encrypts the payload and posts it to the subscription's push service.
+// A *ErrSubscriptionGone is returned when the push service responds with 404
+// or 410, signalling that the subscription should be removed.
+func (c *Client) Send(ctx context.Context, sub Subscription, payload []byte, opts *Options) error {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	body, err := Encrypt(payload, sub)
+	if err != nil {
+		return err
+	}
+
+	authorization, err := c.keys.authorizationHeader(sub.Endpoint, c.subject, c.now().Add(vapidTokenLifetime))
+	if err != nil {
+		return &ErrInvalidSubscription{Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return &ErrInvalidSubscription{Err: err}
+	}
+
+	ttl := opts.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(ttl.Seconds())))
+	if opts.Urgency != "" {
+		req.Header.Set("Urgency", string(opts.Urgency))
+	}
+	if opts.Topic != "" {
+		req.Header.Set("Topic", opts.Topic)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return &ErrSubscriptionGone{Endpoint: sub.Endpoint, StatusCode: resp.StatusCode}
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return &ErrPushServiceResponse{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"net/url"
+)
+
+const (
+	// recordSize is the aes128gcm record size advertised in the header. The
+	// whole payload is sent as a single record.
+	recordSize = 4096
+
+	headerSize = 16 + 4 + 1 + 65 // salt + rs + idlen + keyid
+	tagSize    = 16
+
+	// MaxPayloadSize is the largest plaintext that fits in a single record
+	// after the padding delimiter and authentication tag.
+	MaxPayloadSize = recordSize - headerSize - tagSize - 1
+)
+
+// Subscription is the part of a browser PushSubscription needed to deliver a
+// message: the push service endpoint and the user agent's keys, both keys
+// base64url encoded as returned by PushSubscription.toJSON().
+type Subscription struct {
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+// Encrypt encrypts the payload for the subscription using the aes128gcm
+// content coding as described in RFC 8291.
+func Encrypt(payload []byte, sub Subscription) ([]byte, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	serverKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return encrypt(payload, sub, serverKey, salt)
+}
+
+func encrypt(payload []byte, sub Subscription, serverKey *ecdh.PrivateKey, salt []byte) ([]byte, error) {
+	if len(payload) > MaxPayloadSize {
+		return nil, &ErrPayloadTooLarge{Size: len(payload)}
+	}
+
+	uaPublicRaw, err := decodeBase64URL(sub.P256dh)
+	if err != nil {
+		return nil, &ErrInvalidSubscription{Err: err}
+	}
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicRaw)
+	if err != nil {
+		return nil, &ErrInvalidSubscription{Err: err}
+	}
+	authSecret, err := decodeBase64URL(sub.Auth)
+	if err != nil {
+		return nil, &ErrInvalidSubscription{Err: err}
+	}
+	if len(authSecret) != 16 {
+		return nil, &ErrInvalidSubscription{Err: errors.New("auth secret must be 16 bytes")}
+	}
+
+	ecdhSecret, err := serverKey.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	serverPublicRaw := serverKey.PublicKey().Bytes()
+	cek, nonce, err := deriveContentKeys(ecdhSecret, authSecret, salt, uaPublicRaw, serverPublicRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// A single, final record: the plaintext followed by the 0x02 delimiter.
+	plaintext := make([]byte, 0, len(payload)+1)
+	plaintext = append(plaintext, payload...)
+	plaintext = append(plaintext, 0x02)
+
+	body := make([]byte, 0, headerSize+len(plaintext)+tagSize)
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(serverPublicRaw)))
+	body = append(body, serverPublicRaw...)
+
+	return gcm.Seal(body, nonce, plaintext, nil), nil
+}
+
+// deriveContentKeys performs the two HKDF stages of RFC 8291 section 3.4 and
+// RFC 8188 section 2.2, returning the content encryption key and nonce.
+func deriveContentKeys(ecdhSecret, authSecret, salt, uaPublic, serverPublic []byte) ([]byte, []byte, error) {
+	prkKey, err := hkdf.Extract(sha256.New, ecdhSecret, authSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyInfo := make([]byte, 0, 14+len(uaPublic)+len(serverPublic))
+	keyInfo = append(keyInfo, "WebPush: info\x00"...)
+	keyInfo = append(keyInfo, uaPublic...)
+	keyInfo = append(keyInfo, serverPublic...)
+
+	ikm, err := hkdf.Expand(sha256.New, prkKey, string(keyInfo), 32)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prk, err := hkdf.Extract(sha256.New, ikm, salt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cek, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: aes128gcm\x00", 16)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nonce, err := hkdf.Expand(sha256.New, prk, "Content-Encoding: nonce\x00", 12)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cek, nonce, nil
+}
+
+// ValidateSubscription checks that the endpoint is an absolute http(s) URL and
+// that the keys decode to a P-256 public key and a 16 byte auth secret.
+func ValidateSubscription(sub Subscription) error {
+	endpointURL, err := url.Parse(sub.Endpoint)
+	if err != nil {
+		return &ErrInvalidSubscription{Err: err}
+	}
+	if (endpointURL.Scheme != "https" && endpointURL.Scheme != "http") || endpointURL.Host == "" {
+		return &ErrInvalidSubscription{Err: errors.New("endpoint must be an absolute http(s) url")}
+	}
+
+	uaPublicRaw, err := decodeBase64URL(sub.P256dh)
+	if err != nil {
+		return &ErrInvalidSubscription{Err: err}
+	}
+	if _, err := ecdh.P256().NewPublicKey(uaPublicRaw); err != nil {
+		return &ErrInvalidSubscription{Err: err}
+	}
+
+	authSecret, err := decodeBase64URL(sub.Auth)
+	if err != nil {
+		return &ErrInvalidSubscription{Err: err}
+	}
+	if len(authSecret) != 16 {
+		return &ErrInvalidSubscription{Err: errors.New("auth secret must be 16 bytes")}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package webpush
+
+import "fmt"
+
+type ErrInvalidVAPIDKeys struct {
+	Err error
+}
+
+func (e *ErrInvalidVAPIDKeys) Error() string {
+	return "invalid vapid keys: " + e.Err.Error()
+}
+
+type ErrInvalidSubscription struct {
+	Err error
+}
+
+func (e *ErrInvalidSubscription) Error() string {
+	return "invalid push subscription: " + e.Err.Error()
+}
+
+type ErrPayloadTooLarge struct {
+	Size int
+}
+
+func (e *ErrPayloadTooLarge) Error() string {
+	return fmt.Sprintf("payload of %d bytes exceeds the maximum of %d bytes", e.Size, MaxPayloadSize)
+}
+
+// ErrSubscriptionGone is returned when the push service reports that the
+// subscription no longer exists (404 or 410) and should be discarded.
+type ErrSubscriptionGone struct {
+	Endpoint   string
+	StatusCode int
+}
+
+func (e *ErrSubscriptionGone) Error() string {
+	return fmt.Sprintf("push subscription is gone (status %d): %s", e.StatusCode, e.Endpoint)
+}
+
+type ErrPushServiceResponse struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *ErrPushServiceResponse) Error() string {
+	return fmt.Sprintf("push service responded with status %d: %s", e.StatusCode, e.Body)
+}
@@ -0,0 +1,91 @@
+package webpush
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// VAPIDKeys is the application server key pair used to identify this server
+// to push services (RFC 8292).
+type VAPIDKeys struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+func GenerateVAPIDKeys() (*VAPIDKeys, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &VAPIDKeys{privateKey: privateKey}, nil
+}
+
+// ParseVAPIDKeys decodes a base64url encoded raw P-256 private key and checks
+// it against the matching uncompressed public key.
+func ParseVAPIDKeys(publicKey, privateKey string) (*VAPIDKeys, error) {
+	rawPrivate, err := decodeBase64URL(privateKey)
+	if err != nil {
+		return nil, &ErrInvalidVAPIDKeys{Err: err}
+	}
+
+	key, err := ecdsa.ParseRawPrivateKey(elliptic.P256(), rawPrivate)
+	if err != nil {
+		return nil, &ErrInvalidVAPIDKeys{Err: err}
+	}
+
+	keys := &VAPIDKeys{privateKey: key}
+	if publicKey != "" && publicKey != keys.PublicKey() {
+		return nil, &ErrInvalidVAPIDKeys{Err: fmt.Errorf("public key does not match private key")}
+	}
+
+	return keys, nil
+}
+
+// PublicKey returns the base64url encoded uncompressed public key, the value
+// browsers expect as the applicationServerKey when subscribing.
+func (k *VAPIDKeys) PublicKey() string {
+	raw, _ := k.privateKey.PublicKey.Bytes()
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func (k *VAPIDKeys) PrivateKey() string {
+	raw, _ := k.privateKey.Bytes()
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// authorizationHeader builds the `vapid` Authorization header value for a
+// request to the given push endpoint.
+func (k *VAPIDKeys) authorizationHeader(endpoint, subject string, expiresAt time.Time) (string, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	claims := jwt.MapClaims{
+		"aud": endpointURL.Scheme + "://" + endpointURL.Host,
+		"exp": expiresAt.Unix(),
+		"sub": subject,
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, claims).SignedString(k.privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("vapid t=%s, k=%s", token, k.PublicKey()), nil
+}
+
+// decodeBase64URL accepts both padded and unpadded base64url, since browsers
+// and libraries disagree on which one to emit.
+func decodeBase64URL(s string) ([]byte, error) {
+	if decoded, err := base64.RawURLEncoding.DecodeString(s); err == nil {
+		return decoded, nil
+	}
+	return base64.URLEncoding.DecodeString(s)
+}
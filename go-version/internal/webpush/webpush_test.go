@@ -0,0 +1,271 @@
+package webpush
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// RFC 8291 Appendix A test vector.
+const (
+	rfcPlaintext  = "When I grow up, I want to be a watermelon"
+	rfcASPrivate  = "yfWPiYE-n46HLnH0KqZOF1fJJU3MYrct3AELtAQ-oRw"
+	rfcUAPublic   = "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"
+	rfcAuthSecret = "BTBZMqHH6r4Tts7J_aSIgg"
+	rfcSalt       = "DGv6ra1nlYgDCS1FRnbzlw"
+	rfcCiphertext = "DGv6ra1nlYgDCS1FRnbzlwAAEABBBP4z9KsN6nGRTbVYI_c7VJSPQTBtkgcy27mlmlMoZIIgDll6e3vCYLocInmYWAmS6TlzAC8wEqKK6PBru3jl7A_yl95bQpu6cVPTpK4Mqgkf1CXztLVBSt2Ks3oZwbuwXPXLWyouBWLVWGNWQexSgSxsj_Qulcy4a-fN"
+)
+
+// testUserAgent plays the browser side of a subscription.
+type testUserAgent struct {
+	privateKey *ecdh.PrivateKey
+	authSecret []byte
+}
+
+func newTestUserAgent(t *testing.T) *testUserAgent {
+	t.Helper()
+	privateKey, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generating user agent key: %v", err)
+	}
+	authSecret := make([]byte, 16)
+	rand.Read(authSecret)
+	return &testUserAgent{privateKey: privateKey, authSecret: authSecret}
+}
+
+func (ua *testUserAgent) subscription(endpoint string) Subscription {
+	return Subscription{
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(ua.privateKey.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(ua.authSecret),
+	}
+}
+
+func (ua *testUserAgent) decrypt(t *testing.T, body []byte) []byte {
+	t.Helper()
+	if len(body) < headerSize {
+		t.Fatalf("body too short: %d bytes", len(body))
+	}
+
+	salt := body[:16]
+	if rs := binary.BigEndian.Uint32(body[16:20]); rs != recordSize {
+		t.Errorf("record size = %d, want %d", rs, recordSize)
+	}
+	idLen := int(body[20])
+	serverPublicRaw := body[21 : 21+idLen]
+
+	serverPublic, err := ecdh.P256().NewPublicKey(serverPublicRaw)
+	if err != nil {
+		t.Fatalf("parsing server key: %v", err)
+	}
+	ecdhSecret, err := ua.privateKey.ECDH(serverPublic)
+	if err != nil {
+		t.Fatalf("ecdh: %v", err)
+	}
+
+	cek, nonce, err := deriveContentKeys(ecdhSecret, ua.authSecret, salt, ua.privateKey.PublicKey().Bytes(), serverPublicRaw)
+	if err != nil {
+		t.Fatalf("deriving keys: %v", err)
+	}
+
+	block, _ := aes.NewCipher(cek)
+	gcm, _ := cipher.NewGCM(block)
+	plaintext, err := gcm.Open(nil, nonce, body[21+idLen:], nil)
+	if err != nil {
+		t.Fatalf("decrypting: %v", err)
+	}
+	if plaintext[len(plaintext)-1] != 0x02 {
+		t.Fatalf("missing final record delimiter")
+	}
+	return plaintext[:len(plaintext)-1]
+}
+
+func TestEncrypt_RFC8291Vector(t *testing.T) {
+	asPrivate, _ := base64.RawURLEncoding.DecodeString(rfcASPrivate)
+	serverKey, err := ecdh.P256().NewPrivateKey(asPrivate)
+	if err != nil {
+		t.Fatalf("parsing server key: %v", err)
+	}
+	salt, _ := base64.RawURLEncoding.DecodeString(rfcSalt)
+
+	body, err := encrypt([]byte(rfcPlaintext), Subscription{P256dh: rfcUAPublic, Auth: rfcAuthSecret}, serverKey, salt)
+	if err != nil {
+		t.Fatalf("encrypt returned error: %v", err)
+	}
+
+	if got := base64.RawURLEncoding.EncodeToString(body); got != rfcCiphertext {
+		t.Errorf("ciphertext mismatch\n got: %s\nwant: %s", got, rfcCiphertext)
+	}
+}
+
+func TestEncrypt_PayloadTooLarge(t *testing.T) {
+	ua := newTestUserAgent(t)
+
+	_, err := Encrypt(make([]byte, MaxPayloadSize+1), ua.subscription("https://push.example.com/x"))
+	var tooLarge *ErrPayloadTooLarge
+	if !errors.As(err, &tooLarge) {
+		t.Errorf("expected ErrPayloadTooLarge, got %v", err)
+	}
+
+	if _, err := Encrypt(make([]byte, MaxPayloadSize), ua.subscription("https://push.example.com/x")); err != nil {
+		t.Errorf("payload of MaxPayloadSize should encrypt, got %v", err)
+	}
+}
+
+func TestParseVAPIDKeys(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatalf("GenerateVAPIDKeys returned error: %v", err)
+	}
+
+	parsed, err := ParseVAPIDKeys(keys.PublicKey(), keys.PrivateKey())
+	if err != nil {
+		t.Fatalf("ParseVAPIDKeys returned error: %v", err)
+	}
+	if parsed.PublicKey() != keys.PublicKey() {
+		t.Errorf("public key changed after round trip")
+	}
+
+	other, _ := GenerateVAPIDKeys()
+	if _, err := ParseVAPIDKeys(other.PublicKey(), keys.PrivateKey()); err == nil {
+		t.Errorf("expected mismatched public key to be rejected")
+	}
+	if _, err := ParseVAPIDKeys("", "not-a-key"); err == nil {
+		t.Errorf("expected malformed private key to be rejected")
+	}
+}
+
+func TestValidateSubscription(t *testing.T) {
+	ua := newTestUserAgent(t)
+	valid := ua.subscription("https://push.example.com/send/abc")
+
+	testCases := []struct {
+		name        string
+		modify      func(s *Subscription)
+		expectError bool
+	}{
+		{"valid subscription", func(s *Subscription) {}, false},
+		{"relative endpoint", func(s *Subscription) { s.Endpoint = "/send/abc" }, true},
+		{"unsupported scheme", func(s *Subscription) { s.Endpoint = "ftp://push.example.com/abc" }, true},
+		{"p256dh not a point", func(s *Subscription) { s.P256dh = "AAAA" }, true},
+		{"auth wrong length", func(s *Subscription) { s.Auth = "AAAA" }, true},
+		{"auth not base64", func(s *Subscription) { s.Auth = "***" }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sub := valid
+			tc.modify(&sub)
+			err := ValidateSubscription(sub)
+			if (err != nil) != tc.expectError {
+				t.Errorf("ValidateSubscription() error = %v; want error: %v", err, tc.expectError)
+			}
+		})
+	}
+}
+
+func TestClient_Send(t *testing.T) {
+	keys, err := GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatalf("GenerateVAPIDKeys returned error: %v", err)
+	}
+	ua := newTestUserAgent(t)
+
+	var received []byte
+	pushService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Encoding") != "aes128gcm" {
+			t.Errorf("Content-Encoding = %q", r.Header.Get("Content-Encoding"))
+		}
+		if r.Header.Get("TTL") == "" {
+			t.Errorf("missing TTL header")
+		}
+		if r.Header.Get("Urgency") != string(UrgencyHigh) {
+			t.Errorf("Urgency = %q", r.Header.Get("Urgency"))
+		}
+
+		// Authorization: vapid t=<jwt>, k=<public key>
+		authorization := strings.TrimPrefix(r.Header.Get("Authorization"), "vapid ")
+		params := map[string]string{}
+		for _, part := range strings.Split(authorization, ",") {
+			kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+			if len(kv) == 2 {
+				params[kv[0]] = kv[1]
+			}
+		}
+		if params["k"] != keys.PublicKey() {
+			t.Errorf("vapid k = %q, want %q", params["k"], keys.PublicKey())
+		}
+		token, err := jwt.Parse(params["t"], func(token *jwt.Token) (interface{}, error) {
+			return &keys.privateKey.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"ES256"}), jwt.WithAudience("http://"+r.Host), jwt.WithExpirationRequired())
+		if err != nil || !token.Valid {
+			t.Errorf("invalid vapid token: %v", err)
+		}
+
+		received, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer pushService.Close()
+
+	client := NewClient(keys, "mailto:test@example.com", pushService.Client())
+	err = client.Send(context.Background(), ua.subscription(pushService.URL+"/send/abc"), []byte(`{"title":"hi"}`), &Options{Urgency: UrgencyHigh})
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	if got := string(ua.decrypt(t, received)); got != `{"title":"hi"}` {
+		t.Errorf("decrypted payload = %q", got)
+	}
+}
+
+func TestClient_SendErrors(t *testing.T) {
+	keys, _ := GenerateVAPIDKeys()
+	ua := newTestUserAgent(t)
+
+	testCases := []struct {
+		name          string
+		status        int
+		validateError func(error) bool
+	}{
+		{"created", http.StatusCreated, func(err error) bool { return err == nil }},
+		{"gone", http.StatusGone, func(err error) bool {
+			var gone *ErrSubscriptionGone
+			return errors.As(err, &gone) && gone.StatusCode == http.StatusGone
+		}},
+		{"not found", http.StatusNotFound, func(err error) bool {
+			var gone *ErrSubscriptionGone
+			return errors.As(err, &gone) && gone.StatusCode == http.StatusNotFound
+		}},
+		{"rate limited", http.StatusTooManyRequests, func(err error) bool {
+			var respErr *ErrPushServiceResponse
+			return errors.As(err, &respErr) && respErr.StatusCode == http.StatusTooManyRequests
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pushService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			}))
+			defer pushService.Close()
+
+			client := NewClient(keys, "mailto:test@example.com", pushService.Client())
+			err := client.Send(context.Background(), ua.subscription(pushService.URL), []byte("payload"), nil)
+			if !tc.validateError(err) {
+				t.Errorf("unexpected error for status %d: %v", tc.status, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_journal_entries_updated_at;
+
+DROP TABLE IF EXISTS journal_measurements;
+DROP TABLE IF EXISTS journal_entry_tags;
+DROP TABLE IF EXISTS journal_entries;
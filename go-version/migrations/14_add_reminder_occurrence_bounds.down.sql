@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_reminders_user_occurrences;
+ALTER TABLE reminders DROP COLUMN last_occurrence_at;
+ALTER TABLE reminders DROP COLUMN first_occurrence_at;
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS reminder_medications_fts_delete;
+DROP TRIGGER IF EXISTS reminder_medications_fts_update;
+DROP TRIGGER IF EXISTS reminder_medications_fts_insert;
+DROP TRIGGER IF EXISTS reminders_fts_delete;
+DROP TRIGGER IF EXISTS reminders_fts_update;
+DROP TRIGGER IF EXISTS reminders_fts_insert;
+DROP TABLE IF EXISTS reminders_fts;
+DROP TABLE IF EXISTS reminder_search_rows;
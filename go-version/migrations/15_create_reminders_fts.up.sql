@@ -0,0 +1,74 @@
+-- A full-text index over reminder descriptions and their medication's name
+-- and instructions, kept in sync with those tables by triggers. FTS5 rows
+-- are keyed by integer rowids, and reminders only have the implicit rowid,
+-- which VACUUM may renumber, so reminder_search_rows gives each reminder a
+-- stable one.
+CREATE TABLE IF NOT EXISTS reminder_search_rows (
+    id INTEGER PRIMARY KEY,
+    reminder_id TEXT NOT NULL UNIQUE
+);
+
+CREATE VIRTUAL TABLE IF NOT EXISTS reminders_fts USING fts5(
+    description,
+    medication_name,
+    medication_instructions,
+    tokenize = 'unicode61 remove_diacritics 2',
+    prefix = '2 3'
+);
+
+INSERT INTO reminder_search_rows (reminder_id) SELECT id FROM reminders;
+
+INSERT INTO reminders_fts (rowid, description, medication_name, medication_instructions)
+SELECT s.id, COALESCE(r.description, ''), COALESCE(m.name, ''), COALESCE(m.instructions, '')
+FROM reminder_search_rows s
+JOIN reminders r ON r.id = s.reminder_id
+LEFT JOIN reminder_medications m ON m.reminder_id = r.id;
+
+CREATE TRIGGER reminders_fts_insert
+    AFTER INSERT ON reminders
+    FOR EACH ROW
+BEGIN
+    INSERT INTO reminder_search_rows (reminder_id) VALUES (NEW.id);
+    INSERT INTO reminders_fts (rowid, description, medication_name, medication_instructions)
+    VALUES ((SELECT id FROM reminder_search_rows WHERE reminder_id = NEW.id), COALESCE(NEW.description, ''), '', '');
+END;
+
+CREATE TRIGGER reminders_fts_update
+    AFTER UPDATE OF description ON reminders
+    FOR EACH ROW
+BEGIN
+    UPDATE reminders_fts SET description = COALESCE(NEW.description, '')
+    WHERE rowid = (SELECT id FROM reminder_search_rows WHERE reminder_id = NEW.id);
+END;
+
+CREATE TRIGGER reminders_fts_delete
+    AFTER DELETE ON reminders
+    FOR EACH ROW
+BEGIN
+    DELETE FROM reminders_fts WHERE rowid = (SELECT id FROM reminder_search_rows WHERE reminder_id = OLD.id);
+    DELETE FROM reminder_search_rows WHERE reminder_id = OLD.id;
+END;
+
+CREATE TRIGGER reminder_medications_fts_insert
+    AFTER INSERT ON reminder_medications
+    FOR EACH ROW
+BEGIN
+    UPDATE reminders_fts SET medication_name = NEW.name, medication_instructions = COALESCE(NEW.instructions, '')
+    WHERE rowid = (SELECT id FROM reminder_search_rows WHERE reminder_id = NEW.reminder_id);
+END;
+
+CREATE TRIGGER reminder_medications_fts_update
+    AFTER UPDATE OF name, instructions ON reminder_medications
+    FOR EACH ROW
+BEGIN
+    UPDATE reminders_fts SET medication_name = NEW.name, medication_instructions = COALESCE(NEW.instructions, '')
+    WHERE rowid = (SELECT id FROM reminder_search_rows WHERE reminder_id = NEW.reminder_id);
+END;
+
+CREATE TRIGGER reminder_medications_fts_delete
+    AFTER DELETE ON reminder_medications
+    FOR EACH ROW
+BEGIN
+    UPDATE reminders_fts SET medication_name = '', medication_instructions = ''
+    WHERE rowid = (SELECT id FROM reminder_search_rows WHERE reminder_id = OLD.reminder_id);
+END;
@@ -0,0 +1,5 @@
+-- A counter bumped by every update of a reminder, so that a conditional
+-- write such as a CalDAV PUT with If-Match can check, in the statement that
+-- makes it, that the reminder is still the one the precondition was
+-- evaluated against.
+ALTER TABLE reminders ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,3 @@
+-- The backfilled bounds are left in place, as they are what the store
+-- would have stored.
+SELECT 1;
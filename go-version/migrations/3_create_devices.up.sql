@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS devices (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    platform TEXT NOT NULL,
+    token TEXT NOT NULL,
+    app_version TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (platform, token),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_devices_user_id ON devices(user_id);
+
+CREATE TRIGGER update_devices_updated_at
+    AFTER UPDATE ON devices
+    FOR EACH ROW
+BEGIN
+    UPDATE devices SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
@@ -0,0 +1,75 @@
+CREATE TABLE IF NOT EXISTS contacts (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    email TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_contacts_user_id ON contacts(user_id);
+
+CREATE TABLE IF NOT EXISTS reminder_acknowledgements (
+    id TEXT PRIMARY KEY,
+    reminder_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    occurrence_at DATETIME NOT NULL,
+    acknowledged_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (reminder_id, occurrence_at),
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS escalation_policies (
+    id TEXT PRIMARY KEY,
+    reminder_id TEXT NOT NULL UNIQUE,
+    user_id TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS escalation_steps (
+    policy_id TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    delay_minutes INTEGER NOT NULL,
+    contact_id TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    PRIMARY KEY (policy_id, position),
+    FOREIGN KEY (policy_id) REFERENCES escalation_policies(id) ON DELETE CASCADE,
+    FOREIGN KEY (contact_id) REFERENCES contacts(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS escalation_events (
+    id TEXT PRIMARY KEY,
+    policy_id TEXT NOT NULL,
+    reminder_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    step_position INTEGER NOT NULL,
+    contact_id TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    occurrence_at DATETIME NOT NULL,
+    status TEXT NOT NULL,
+    error TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (reminder_id, step_position, occurrence_at),
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_escalation_events_user_id ON escalation_events(user_id, reminder_id);
+
+CREATE TRIGGER update_contacts_updated_at
+    AFTER UPDATE ON contacts
+    FOR EACH ROW
+BEGIN
+    UPDATE contacts SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
+
+CREATE TRIGGER update_escalation_policies_updated_at
+    AFTER UPDATE ON escalation_policies
+    FOR EACH ROW
+BEGIN
+    UPDATE escalation_policies SET updated_at = CURRENT_TIMESTAMP WHERE id = NEW.id;
+END;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS quiet_hours (
+    user_id TEXT PRIMARY KEY,
+    start_time TEXT NOT NULL,
+    end_time TEXT NOT NULL,
+    time_zone TEXT NOT NULL,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE reminders ADD COLUMN critical BOOLEAN NOT NULL DEFAULT 0;
+
+CREATE TRIGGER update_quiet_hours_updated_at
+    AFTER UPDATE ON quiet_hours
+    FOR EACH ROW
+BEGIN
+    UPDATE quiet_hours SET updated_at = CURRENT_TIMESTAMP WHERE user_id = NEW.user_id;
+END;
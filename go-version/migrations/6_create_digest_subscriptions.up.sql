@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS digest_subscriptions (
+    user_id TEXT PRIMARY KEY,
+    frequency TEXT NOT NULL,
+    send_time TEXT NOT NULL,
+    weekday TEXT NOT NULL DEFAULT '',
+    time_zone TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    last_sent_at DATETIME,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_digest_subscriptions_updated_at
+    AFTER UPDATE ON digest_subscriptions
+    FOR EACH ROW
+BEGIN
+    UPDATE digest_subscriptions SET updated_at = CURRENT_TIMESTAMP WHERE user_id = NEW.user_id;
+END;
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_reminder_medications_updated_at;
+DROP INDEX IF EXISTS idx_reminder_medications_user_name;
+DROP TABLE IF EXISTS reminder_medications;
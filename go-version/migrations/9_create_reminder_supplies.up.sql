@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS reminder_supplies (
+    reminder_id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    quantity REAL NOT NULL,
+    dose_per_occurrence REAL NOT NULL DEFAULT 1,
+    refill_threshold_days INTEGER NOT NULL DEFAULT 7,
+    refill_reminder_id TEXT,
+    created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (refill_reminder_id) REFERENCES reminders(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS dose_logs (
+    id TEXT PRIMARY KEY,
+    reminder_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    quantity REAL NOT NULL,
+    taken_at DATETIME NOT NULL,
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_dose_logs_reminder_id ON dose_logs(reminder_id, taken_at);
+
+CREATE TRIGGER update_reminder_supplies_updated_at
+    AFTER UPDATE ON reminder_supplies
+    FOR EACH ROW
+BEGIN
+    UPDATE reminder_supplies SET updated_at = CURRENT_TIMESTAMP WHERE reminder_id = NEW.reminder_id;
+END;
@@ -0,0 +1,16 @@
+// Package migrations embeds the SQL schema migrations so that the binary can
+// apply them itself. Each version has an up and a down file named
+// <version>_<name>.up.sql and <version>_<name>.down.sql.
+package migrations
+
+import "embed"
+
+// SQLite holds the SQLite migrations.
+//
+//go:embed *.sql
+var SQLite embed.FS
+
+// Postgres holds the PostgreSQL migrations, under postgres/.
+//
+//go:embed postgres/*.sql
+var Postgres embed.FS
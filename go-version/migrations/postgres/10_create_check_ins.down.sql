@@ -0,0 +1,6 @@
+DROP TRIGGER IF EXISTS update_check_in_questionnaires_updated_at ON check_in_questionnaires;
+
+DROP TABLE IF EXISTS check_in_answers;
+DROP TABLE IF EXISTS check_in_responses;
+DROP TABLE IF EXISTS check_in_questions;
+DROP TABLE IF EXISTS check_in_questionnaires;
@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS check_in_questionnaires (
+    id TEXT PRIMARY KEY,
+    reminder_id TEXT NOT NULL UNIQUE,
+    user_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS check_in_questions (
+    questionnaire_id TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    key TEXT NOT NULL,
+    type TEXT NOT NULL,
+    prompt TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    min DOUBLE PRECISION,
+    max DOUBLE PRECISION,
+    unit TEXT,
+    PRIMARY KEY (questionnaire_id, position),
+    UNIQUE (questionnaire_id, key),
+    FOREIGN KEY (questionnaire_id) REFERENCES check_in_questionnaires(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS check_in_responses (
+    id TEXT PRIMARY KEY,
+    reminder_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    occurrence_at TIMESTAMPTZ NOT NULL,
+    submitted_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (reminder_id, occurrence_at),
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS check_in_answers (
+    response_id TEXT NOT NULL,
+    question_key TEXT NOT NULL,
+    value_number DOUBLE PRECISION,
+    value_text TEXT,
+    value_boolean BOOLEAN,
+    PRIMARY KEY (response_id, question_key),
+    FOREIGN KEY (response_id) REFERENCES check_in_responses(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_check_in_responses_user_id ON check_in_responses(user_id, reminder_id, occurrence_at);
+
+CREATE TRIGGER update_check_in_questionnaires_updated_at
+    BEFORE UPDATE ON check_in_questionnaires
+    FOR EACH ROW
+    EXECUTE FUNCTION set_updated_at();
@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    text TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS journal_entry_tags (
+    entry_id TEXT NOT NULL,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (entry_id, tag),
+    FOREIGN KEY (entry_id) REFERENCES journal_entries(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS journal_measurements (
+    entry_id TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    value DOUBLE PRECISION NOT NULL,
+    unit TEXT,
+    PRIMARY KEY (entry_id, position),
+    FOREIGN KEY (entry_id) REFERENCES journal_entries(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_entries_user_id ON journal_entries(user_id, occurred_at);
+CREATE INDEX IF NOT EXISTS idx_journal_entry_tags_tag ON journal_entry_tags(tag);
+
+CREATE TRIGGER update_journal_entries_updated_at
+    BEFORE UPDATE ON journal_entries
+    FOR EACH ROW
+    EXECUTE FUNCTION set_updated_at();
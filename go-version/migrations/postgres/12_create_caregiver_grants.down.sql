@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS caregiver_actions;
+DROP TABLE IF EXISTS caregiver_grant_reminders;
+DROP TABLE IF EXISTS caregiver_grants;
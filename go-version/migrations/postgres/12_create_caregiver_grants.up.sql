@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS caregiver_grants (
+    id TEXT PRIMARY KEY,
+    owner_id TEXT NOT NULL,
+    email TEXT NOT NULL,
+    caregiver_id TEXT,
+    permission TEXT NOT NULL,
+    all_reminders BOOLEAN NOT NULL DEFAULT FALSE,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    accepted_at TIMESTAMPTZ,
+    UNIQUE (owner_id, email),
+    FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (caregiver_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS caregiver_grant_reminders (
+    grant_id TEXT NOT NULL,
+    reminder_id TEXT NOT NULL,
+    PRIMARY KEY (grant_id, reminder_id),
+    FOREIGN KEY (grant_id) REFERENCES caregiver_grants(id) ON DELETE CASCADE,
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS caregiver_actions (
+    id TEXT PRIMARY KEY,
+    owner_id TEXT NOT NULL,
+    caregiver_id TEXT NOT NULL,
+    reminder_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (owner_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_caregiver_grants_caregiver_id ON caregiver_grants(caregiver_id);
+CREATE INDEX IF NOT EXISTS idx_caregiver_grants_email ON caregiver_grants(email);
+CREATE INDEX IF NOT EXISTS idx_caregiver_actions_owner_id ON caregiver_actions(owner_id, created_at);
@@ -0,0 +1,8 @@
+-- The first and last occurrences of each reminder's series, kept up to date
+-- by the store so that range queries can skip series that have finished or
+-- not yet begun. last_occurrence_at is NULL for series without an end.
+-- Reminders created before this migration get theirs from migration 18.
+ALTER TABLE reminders ADD COLUMN first_occurrence_at TIMESTAMPTZ;
+ALTER TABLE reminders ADD COLUMN last_occurrence_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_reminders_user_occurrences ON reminders(user_id, last_occurrence_at, first_occurrence_at);
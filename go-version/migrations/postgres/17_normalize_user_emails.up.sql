@@ -0,0 +1,8 @@
+-- Emails are compared without regard to case, so that an invitation sent
+-- to an address reaches only the account registered with it. Existing
+-- addresses are lowercased as new ones are, and the index keeps two
+-- accounts from differing only in case. This fails if such accounts
+-- already exist; they have to be merged by hand first.
+UPDATE users SET email = lower(trim(email));
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email_lower ON users(lower(email));
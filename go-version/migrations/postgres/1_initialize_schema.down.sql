@@ -0,0 +1,7 @@
+DROP TRIGGER IF EXISTS update_users_updated_at ON users;
+DROP TRIGGER IF EXISTS update_reminders_updated_at ON reminders;
+
+DROP TABLE IF EXISTS reminders;
+DROP TABLE IF EXISTS users;
+
+DROP FUNCTION IF EXISTS set_updated_at();
@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_push_subscriptions_updated_at ON push_subscriptions;
+
+DROP TABLE IF EXISTS vapid_keys;
+DROP TABLE IF EXISTS push_subscriptions;
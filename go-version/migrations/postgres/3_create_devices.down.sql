@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_devices_updated_at ON devices;
+
+DROP TABLE IF EXISTS devices;
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS update_escalation_policies_updated_at ON escalation_policies;
+DROP TRIGGER IF EXISTS update_contacts_updated_at ON contacts;
+
+DROP TABLE IF EXISTS escalation_events;
+DROP TABLE IF EXISTS escalation_steps;
+DROP TABLE IF EXISTS escalation_policies;
+DROP TABLE IF EXISTS reminder_acknowledgements;
+DROP TABLE IF EXISTS contacts;
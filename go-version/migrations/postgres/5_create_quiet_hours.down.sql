@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS update_quiet_hours_updated_at ON quiet_hours;
+
+ALTER TABLE reminders DROP COLUMN critical;
+
+DROP TABLE IF EXISTS quiet_hours;
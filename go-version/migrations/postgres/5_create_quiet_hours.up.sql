@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS quiet_hours (
+    user_id TEXT PRIMARY KEY,
+    start_time TEXT NOT NULL,
+    end_time TEXT NOT NULL,
+    time_zone TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+ALTER TABLE reminders ADD COLUMN critical BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TRIGGER update_quiet_hours_updated_at
+    BEFORE UPDATE ON quiet_hours
+    FOR EACH ROW
+    EXECUTE FUNCTION set_updated_at();
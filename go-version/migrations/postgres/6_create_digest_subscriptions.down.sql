@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS update_digest_subscriptions_updated_at ON digest_subscriptions;
+
+DROP TABLE IF EXISTS digest_subscriptions;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS digest_subscriptions (
+    user_id TEXT PRIMARY KEY,
+    frequency TEXT NOT NULL,
+    send_time TEXT NOT NULL,
+    weekday TEXT NOT NULL DEFAULT '',
+    time_zone TEXT NOT NULL,
+    channel TEXT NOT NULL,
+    last_sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_digest_subscriptions_updated_at
+    BEFORE UPDATE ON digest_subscriptions
+    FOR EACH ROW
+    EXECUTE FUNCTION set_updated_at();
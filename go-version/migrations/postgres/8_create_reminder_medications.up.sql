@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS reminder_medications (
+    reminder_id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    strength TEXT,
+    dose_quantity DOUBLE PRECISION,
+    dose_unit TEXT,
+    route TEXT,
+    instructions TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reminder_medications_user_name ON reminder_medications(user_id, name);
+
+CREATE TRIGGER update_reminder_medications_updated_at
+    BEFORE UPDATE ON reminder_medications
+    FOR EACH ROW
+    EXECUTE FUNCTION set_updated_at();
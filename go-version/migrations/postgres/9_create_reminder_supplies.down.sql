@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS update_reminder_supplies_updated_at ON reminder_supplies;
+
+DROP TABLE IF EXISTS dose_logs;
+DROP TABLE IF EXISTS reminder_supplies;
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS reminder_supplies (
+    reminder_id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL,
+    dose_per_occurrence DOUBLE PRECISION NOT NULL DEFAULT 1,
+    refill_threshold_days INTEGER NOT NULL DEFAULT 7,
+    refill_reminder_id TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (refill_reminder_id) REFERENCES reminders(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS dose_logs (
+    id TEXT PRIMARY KEY,
+    reminder_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    quantity DOUBLE PRECISION NOT NULL,
+    taken_at TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY (reminder_id) REFERENCES reminders(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_dose_logs_reminder_id ON dose_logs(reminder_id, taken_at);
+
+CREATE TRIGGER update_reminder_supplies_updated_at
+    BEFORE UPDATE ON reminder_supplies
+    FOR EACH ROW
+    EXECUTE FUNCTION set_updated_at();